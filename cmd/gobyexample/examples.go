@@ -0,0 +1,91 @@
+package main
+
+// Every example registers itself with the examples package from init, so
+// importing it here is enough to make it available to the runner.
+import (
+	_ "github.com/saqib77official/go-by-example/examples/arrays"
+	_ "github.com/saqib77official/go-by-example/examples/atomic-counters"
+	_ "github.com/saqib77official/go-by-example/examples/base64-encoding"
+	_ "github.com/saqib77official/go-by-example/examples/channel-buffering"
+	_ "github.com/saqib77official/go-by-example/examples/channel-directions"
+	_ "github.com/saqib77official/go-by-example/examples/channel-synchronization"
+	_ "github.com/saqib77official/go-by-example/examples/channels"
+	_ "github.com/saqib77official/go-by-example/examples/closing-channels"
+	_ "github.com/saqib77official/go-by-example/examples/closures"
+	_ "github.com/saqib77official/go-by-example/examples/command-line-arguments"
+	_ "github.com/saqib77official/go-by-example/examples/command-line-flags"
+	_ "github.com/saqib77official/go-by-example/examples/command-line-subcommands"
+	_ "github.com/saqib77official/go-by-example/examples/constants"
+	_ "github.com/saqib77official/go-by-example/examples/context"
+	_ "github.com/saqib77official/go-by-example/examples/custom-errors"
+	_ "github.com/saqib77official/go-by-example/examples/defer"
+	_ "github.com/saqib77official/go-by-example/examples/directories"
+	_ "github.com/saqib77official/go-by-example/examples/embed-directive"
+	_ "github.com/saqib77official/go-by-example/examples/enums"
+	_ "github.com/saqib77official/go-by-example/examples/environment-variables"
+	_ "github.com/saqib77official/go-by-example/examples/epoch"
+	_ "github.com/saqib77official/go-by-example/examples/errors"
+	_ "github.com/saqib77official/go-by-example/examples/execing-processes"
+	_ "github.com/saqib77official/go-by-example/examples/exit"
+	_ "github.com/saqib77official/go-by-example/examples/file-paths"
+	_ "github.com/saqib77official/go-by-example/examples/for"
+	_ "github.com/saqib77official/go-by-example/examples/functions"
+	_ "github.com/saqib77official/go-by-example/examples/generics"
+	_ "github.com/saqib77official/go-by-example/examples/goroutines"
+	_ "github.com/saqib77official/go-by-example/examples/hello"
+	_ "github.com/saqib77official/go-by-example/examples/http-client"
+	_ "github.com/saqib77official/go-by-example/examples/http-server"
+	_ "github.com/saqib77official/go-by-example/examples/if-else"
+	_ "github.com/saqib77official/go-by-example/examples/interfaces"
+	_ "github.com/saqib77official/go-by-example/examples/json"
+	_ "github.com/saqib77official/go-by-example/examples/line-filters"
+	_ "github.com/saqib77official/go-by-example/examples/logging"
+	_ "github.com/saqib77official/go-by-example/examples/maps"
+	_ "github.com/saqib77official/go-by-example/examples/methods"
+	_ "github.com/saqib77official/go-by-example/examples/multiple-return-values"
+	_ "github.com/saqib77official/go-by-example/examples/mutexes"
+	_ "github.com/saqib77official/go-by-example/examples/non-blocking-channel-operations"
+	_ "github.com/saqib77official/go-by-example/examples/number-parsing"
+	_ "github.com/saqib77official/go-by-example/examples/panic"
+	_ "github.com/saqib77official/go-by-example/examples/pointers"
+	_ "github.com/saqib77official/go-by-example/examples/random-numbers"
+	_ "github.com/saqib77official/go-by-example/examples/range-over-built-in-types"
+	_ "github.com/saqib77official/go-by-example/examples/range-over-channels"
+	_ "github.com/saqib77official/go-by-example/examples/range-over-iterators"
+	_ "github.com/saqib77official/go-by-example/examples/rate-limiting"
+	_ "github.com/saqib77official/go-by-example/examples/reading-files"
+	_ "github.com/saqib77official/go-by-example/examples/recover"
+	_ "github.com/saqib77official/go-by-example/examples/recursion"
+	_ "github.com/saqib77official/go-by-example/examples/regular-expressions"
+	_ "github.com/saqib77official/go-by-example/examples/select"
+	_ "github.com/saqib77official/go-by-example/examples/sha256-hashes"
+	_ "github.com/saqib77official/go-by-example/examples/signals"
+	_ "github.com/saqib77official/go-by-example/examples/slices"
+	_ "github.com/saqib77official/go-by-example/examples/sorting"
+	_ "github.com/saqib77official/go-by-example/examples/sorting-by-functions"
+	_ "github.com/saqib77official/go-by-example/examples/spawning-processes"
+	_ "github.com/saqib77official/go-by-example/examples/stateful-goroutines"
+	_ "github.com/saqib77official/go-by-example/examples/string-formatting"
+	_ "github.com/saqib77official/go-by-example/examples/string-functions"
+	_ "github.com/saqib77official/go-by-example/examples/strings-and-runes"
+	_ "github.com/saqib77official/go-by-example/examples/struct-embedding"
+	_ "github.com/saqib77official/go-by-example/examples/structs"
+	_ "github.com/saqib77official/go-by-example/examples/switch"
+	_ "github.com/saqib77official/go-by-example/examples/tcp-server"
+	_ "github.com/saqib77official/go-by-example/examples/temporary-files-and-directories"
+	_ "github.com/saqib77official/go-by-example/examples/testing-and-benchmarking"
+	_ "github.com/saqib77official/go-by-example/examples/text-templates"
+	_ "github.com/saqib77official/go-by-example/examples/tickers"
+	_ "github.com/saqib77official/go-by-example/examples/time"
+	_ "github.com/saqib77official/go-by-example/examples/time-formatting-parsing"
+	_ "github.com/saqib77official/go-by-example/examples/timeouts"
+	_ "github.com/saqib77official/go-by-example/examples/timers"
+	_ "github.com/saqib77official/go-by-example/examples/url-parsing"
+	_ "github.com/saqib77official/go-by-example/examples/values"
+	_ "github.com/saqib77official/go-by-example/examples/variables"
+	_ "github.com/saqib77official/go-by-example/examples/variadic-functions"
+	_ "github.com/saqib77official/go-by-example/examples/waitgroups"
+	_ "github.com/saqib77official/go-by-example/examples/worker-pools"
+	_ "github.com/saqib77official/go-by-example/examples/writing-files"
+	_ "github.com/saqib77official/go-by-example/examples/xml"
+)
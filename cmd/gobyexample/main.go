@@ -0,0 +1,369 @@
+// Command gobyexample lists, searches, shows and runs the Go by Example
+// programs from a single binary.
+//
+//	gobyexample list [--category=Beginner]
+//	gobyexample run [--section=<n|title>] [--fake-clock] [--seed=<n>] <name>[#<n>] [args...]
+//	gobyexample sections <name>
+//	gobyexample search <keyword>
+//	gobyexample source <name>
+//	gobyexample golden [--update] [name...]
+//	gobyexample readme [--check]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
+	"github.com/saqib77official/go-by-example/internal/golden"
+	"github.com/saqib77official/go-by-example/internal/random"
+	"github.com/saqib77official/go-by-example/internal/readme"
+)
+
+func main() {
+	// Check if any arguments provided
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	command := os.Args[1]
+	args := os.Args[2:]
+
+	// Route to appropriate subcommand
+	switch command {
+	case "list":
+		handleList(args)
+	case "run":
+		handleRun(args)
+	case "sections":
+		handleSections(args)
+	case "search":
+		handleSearch(args)
+	case "source":
+		handleSource(args)
+	case "golden":
+		handleGolden(args)
+	case "readme":
+		handleReadme(args)
+	case "help", "-h", "--help":
+		printUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", command)
+		printUsage()
+		os.Exit(2)
+	}
+}
+
+func handleList(args []string) {
+	listFlags := flag.NewFlagSet("list", flag.ExitOnError)
+	category := listFlags.String("category", "", "Only list examples in this category")
+
+	listFlags.Parse(args)
+
+	var current examples.Category = -1
+	for _, e := range examples.All() {
+		if *category != "" && !strings.EqualFold(e.Category.String(), *category) {
+			continue
+		}
+		if e.Category != current {
+			if current != -1 {
+				fmt.Println()
+			}
+			fmt.Printf("%s:\n", e.Category)
+			current = e.Category
+		}
+		fmt.Printf("  %-34s %s\n", e.Name, e.Description)
+	}
+}
+
+func handleRun(args []string) {
+	runFlags := flag.NewFlagSet("run", flag.ExitOnError)
+	section := runFlags.String("section", "", "Run only the section with this number or title")
+	fakeClock := runFlags.Bool("fake-clock", false, "Run on a simulated clock, so waits finish instantly")
+	seed := runFlags.Uint64("seed", 0, "Seed random numbers so the run can be repeated")
+	runFlags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gobyexample run [--section=<n|title>] [--fake-clock] [--seed=<n>] <name>[#<n>] [args...]")
+		runFlags.PrintDefaults()
+	}
+
+	runFlags.Parse(args)
+
+	if runFlags.NArg() < 1 {
+		runFlags.Usage()
+		os.Exit(2)
+	}
+
+	name, selector, _ := strings.Cut(runFlags.Arg(0), "#")
+	rest := runFlags.Args()[1:]
+
+	// Also accept --section right after the name, since that is how
+	// people tend to type it
+	if len(rest) > 0 && *section == "" {
+		switch {
+		case (rest[0] == "--section" || rest[0] == "-section") && len(rest) > 1:
+			*section, rest = rest[1], rest[2:]
+		case strings.HasPrefix(rest[0], "--section="), strings.HasPrefix(rest[0], "-section="):
+			_, *section, _ = strings.Cut(rest[0], "=")
+			rest = rest[1:]
+		}
+	}
+	if *section != "" {
+		if selector != "" {
+			fmt.Fprintln(os.Stderr, "Error: use either <name>#<n> or --section, not both")
+			os.Exit(2)
+		}
+		selector = *section
+	}
+
+	e := mustLookup(name)
+	run := e.Run
+	if selector != "" {
+		s, err := e.Section(selector)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Use 'gobyexample sections %s' to see available sections\n", e.Name)
+			os.Exit(1)
+		}
+		run = s.Run
+	}
+
+	if *fakeClock {
+		fake := clock.NewFake(clock.Epoch)
+		defer fake.AutoAdvance()()
+		clock.Default = fake
+	}
+	runFlags.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			random.SetSeed(*seed)
+		}
+	})
+
+	// Examples read os.Args and flag.CommandLine as if they were run on
+	// their own, so hand them their name and the remaining arguments.
+	os.Args = append([]string{e.Name}, rest...)
+	run()
+}
+
+func handleSections(args []string) {
+	sectionsFlags := flag.NewFlagSet("sections", flag.ExitOnError)
+
+	sectionsFlags.Parse(args)
+
+	if sectionsFlags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: gobyexample sections <name>")
+		os.Exit(2)
+	}
+
+	e := mustLookup(sectionsFlags.Arg(0))
+	if len(e.Sections) == 0 {
+		fmt.Printf("%s has no numbered sections; run it as a whole\n", e.Name)
+		return
+	}
+
+	fmt.Printf("%s - %s:\n", e.Name, e.Description)
+	for _, s := range e.Sections {
+		fmt.Printf("  %2d. %s\n", s.Number, s.Title)
+	}
+}
+
+func handleSearch(args []string) {
+	searchFlags := flag.NewFlagSet("search", flag.ExitOnError)
+
+	searchFlags.Parse(args)
+
+	if searchFlags.NArg() < 1 {
+		fmt.Fprintln(os.Stderr, "Usage: gobyexample search <keyword>")
+		os.Exit(2)
+	}
+
+	keyword := strings.Join(searchFlags.Args(), " ")
+	matches := examples.Search(keyword)
+	if len(matches) == 0 {
+		fmt.Printf("No examples match %q\n", keyword)
+		os.Exit(1)
+	}
+
+	for _, e := range matches {
+		fmt.Printf("  %-34s %-12s %s\n", e.Name, e.Category, e.Description)
+	}
+}
+
+func handleSource(args []string) {
+	sourceFlags := flag.NewFlagSet("source", flag.ExitOnError)
+
+	sourceFlags.Parse(args)
+
+	if sourceFlags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: gobyexample source <name>")
+		os.Exit(2)
+	}
+
+	e := mustLookup(sourceFlags.Arg(0))
+	src, err := e.Source()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading source for %s: %v\n", e.Name, err)
+		os.Exit(1)
+	}
+	fmt.Print(src)
+}
+
+func handleGolden(args []string) {
+	goldenFlags := flag.NewFlagSet("golden", flag.ExitOnError)
+	update := goldenFlags.Bool("update", false, "Rewrite the golden files instead of comparing")
+	dir := goldenFlags.String("dir", "testdata/golden", "Directory holding the golden files")
+	timeout := goldenFlags.Duration("timeout", time.Minute, "How long a single example may run")
+	parallel := goldenFlags.Int("parallel", runtime.NumCPU(), "How many examples to run at once")
+	goldenFlags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gobyexample golden [--update] [--dir=testdata/golden] [name...]")
+		goldenFlags.PrintDefaults()
+	}
+
+	goldenFlags.Parse(args)
+
+	binary, err := os.Executable()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error locating gobyexample binary: %v\n", err)
+		os.Exit(1)
+	}
+	runner := golden.Runner{Binary: binary, Dir: *dir, Timeout: *timeout}
+
+	var names []string
+	if goldenFlags.NArg() > 0 {
+		for _, name := range goldenFlags.Args() {
+			names = append(names, mustLookup(name).Name)
+		}
+	} else {
+		for _, e := range examples.All() {
+			names = append(names, e.Name)
+		}
+	}
+
+	// Run examples concurrently but report them in order
+	results := make([]golden.Result, len(names))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(*parallel, 1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if *update {
+					results[i] = runner.Update(names[i])
+				} else {
+					results[i] = runner.Check(names[i])
+				}
+			}
+		}()
+	}
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := 0
+	for _, res := range results {
+		switch {
+		case res.Skipped != "":
+			fmt.Printf("skip  %s (%s)\n", res.Name, res.Skipped)
+		case res.Err != nil:
+			fmt.Printf("FAIL  %s: %v\n", res.Name, res.Err)
+		case res.Diff != "":
+			fmt.Printf("FAIL  %s\n%s", res.Name, res.Diff)
+		case res.Updated:
+			fmt.Printf("wrote %s\n", runner.Path(res.Name))
+		default:
+			fmt.Printf("ok    %s\n", res.Name)
+		}
+		if !res.OK() {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("\n%d of %d examples failed\n", failed, len(results))
+		if !*update {
+			fmt.Println("If the change is intended, run 'gobyexample golden --update'")
+		}
+		os.Exit(1)
+	}
+}
+
+func handleReadme(args []string) {
+	readmeFlags := flag.NewFlagSet("readme", flag.ExitOnError)
+	check := readmeFlags.Bool("check", false, "Fail if the file is out of date instead of rewriting it")
+	file := readmeFlags.String("file", "README.md", "README file to regenerate")
+
+	readmeFlags.Parse(args)
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading README: %v\n", err)
+		os.Exit(1)
+	}
+	old := string(data)
+
+	updated, err := readme.Update(old, examples.All())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating %s: %v\n", *file, err)
+		os.Exit(1)
+	}
+
+	switch {
+	case updated == old:
+		fmt.Printf("%s is up to date\n", *file)
+	case *check:
+		fmt.Print(golden.Diff(*file, "generated", old, updated))
+		fmt.Printf("\n%s is out of date; run 'gobyexample readme' to regenerate it\n", *file)
+		os.Exit(1)
+	default:
+		if err := os.WriteFile(*file, []byte(updated), 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing README: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("wrote %s\n", *file)
+	}
+}
+
+func mustLookup(name string) examples.Example {
+	e, ok := examples.Lookup(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown example: %s\n", name)
+		fmt.Fprintln(os.Stderr, "Use 'gobyexample list' to see available examples")
+		os.Exit(1)
+	}
+	return e
+}
+
+func printUsage() {
+	fmt.Println("Usage: gobyexample <command> [options]")
+	fmt.Println("\nAvailable commands:")
+	fmt.Println("  list      List examples grouped by category")
+	fmt.Println("  run       Run an example, or one of its sections")
+	fmt.Println("  sections  List the numbered sections of an example")
+	fmt.Println("  search    Find examples by keyword")
+	fmt.Println("  source    Print the source of an example")
+	fmt.Println("  golden    Check example output against testdata/golden")
+	fmt.Println("  readme    Regenerate the examples overview in README.md")
+	fmt.Println("  help      Show this help")
+	fmt.Println("\nExamples:")
+	fmt.Println("  gobyexample list --category=beginner")
+	fmt.Println("  gobyexample run worker-pools")
+	fmt.Println("  gobyexample run worker-pools#12")
+	fmt.Println("  gobyexample run worker-pools --section \"circuit breaker\"")
+	fmt.Println("  gobyexample sections select")
+	fmt.Println("  gobyexample run command-line-flags --name=Gopher")
+	fmt.Println("  gobyexample run --fake-clock timers")
+	fmt.Println("  gobyexample search mutex")
+	fmt.Println("  gobyexample source closures")
+	fmt.Println("  gobyexample golden")
+	fmt.Println("  gobyexample golden --update timers")
+	fmt.Println("  gobyexample readme --check")
+}
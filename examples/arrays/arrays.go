@@ -1,12 +1,28 @@
-package main
-
-import "fmt"
+package arrays
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "arrays",
+		Category:    examples.Beginner,
+		Description: "Array Operations",
+		Icon:        "📦",
+		Run:         Run,
+	})
+}
 
-func main() {
+func Run() {
 	fmt.Println("=== Arrays Examples ===")
 
 	// Array declaration with specified size and initialization
+	// readme:begin
 	var numbers [5]int = [5]int{1, 2, 3, 4, 5}
+	// readme:end
 	fmt.Printf("Numbers array: %v\n", numbers)
 	fmt.Printf("Length: %d\n", len(numbers))
 
@@ -1,16 +1,30 @@
-package main
+package base64encoding
 
 import (
 	"encoding/base64"
 	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "base64-encoding",
+		Category:    examples.Practical,
+		Description: "Base64 Encoding",
+		Icon:        "📦",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Base64 Encoding ===")
 
 	// Encode string
+	// readme:begin
 	text := "Hello, World!"
 	encoded := base64.StdEncoding.EncodeToString([]byte(text))
+	// readme:end
 	fmt.Printf("Encoded: %s\n", encoded)
 
 	// Decode
@@ -1,175 +1,244 @@
-package main
+package channeldirections
 
-import "fmt"
+import (
+	"fmt"
 
-func main() {
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "channel-directions",
+		Category:    examples.Expert,
+		Description: "Channel Directions",
+		Icon:        "🎯",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Bidirectional channel (default)", Run: bidirectionalChannel},
+			{Number: 2, Title: "Send-only channel", Run: sendOnlyChannel},
+			{Number: 3, Title: "Receive-only channel", Run: receiveOnlyChannel},
+			{Number: 4, Title: "Function with send-only parameter", Run: functionWithSendOnlyParameter},
+			{Number: 5, Title: "Function with receive-only parameter", Run: functionWithReceiveOnlyParameter},
+			{Number: 6, Title: "Function with both send-only and receive-only parameters", Run: functionWithBothSendOnlyAndReceiveOnlyParameters},
+			{Number: 7, Title: "Channel directions in struct fields", Run: channelDirectionsInStructFields},
+			{Number: 8, Title: "Returning directional channels", Run: returningDirectionalChannels},
+			{Number: 9, Title: "Channel conversion", Run: channelConversion},
+			{Number: 10, Title: "Practical example: Producer-Consumer with directions", Run: practicalExampleProducerConsumerWithDirections},
+			{Number: 11, Title: "Pipeline with directional channels", Run: pipelineWithDirectionalChannels},
+			{Number: 12, Title: "Fan-out with directional channels", Run: fanOutWithDirectionalChannels},
+			{Number: 13, Title: "Type safety with directions", Run: typeSafetyWithDirections},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Channel Directions Examples ===")
 
-	// 1. Bidirectional channel (default)
+	bidirectionalChannel()
+	sendOnlyChannel()
+	receiveOnlyChannel()
+	functionWithSendOnlyParameter()
+	functionWithReceiveOnlyParameter()
+	functionWithBothSendOnlyAndReceiveOnlyParameters()
+	channelDirectionsInStructFields()
+	returningDirectionalChannels()
+	channelConversion()
+	practicalExampleProducerConsumerWithDirections()
+	pipelineWithDirectionalChannels()
+	fanOutWithDirectionalChannels()
+	typeSafetyWithDirections()
+
+	fmt.Println("All channel direction examples completed!")
+}
+
+// 1. Bidirectional channel (default)
+func bidirectionalChannel() {
 	fmt.Println("\n1. Bidirectional channel:")
 	bidirectional := make(chan int)
 	fmt.Printf("Bidirectional channel type: %T\n", bidirectional)
-	
+
 	// Can send and receive
 	go func() {
 		bidirectional <- 42
 	}()
 	value := <-bidirectional
 	fmt.Printf("Received from bidirectional: %d\n", value)
+}
 
-	// 2. Send-only channel
+// 2. Send-only channel
+func sendOnlyChannel() {
 	fmt.Println("\n2. Send-only channel:")
+	// readme:begin
+	bidirectional := make(chan int)
 	var sendOnly chan<- int = bidirectional
+	// readme:end
 	fmt.Printf("Send-only channel type: %T\n", sendOnly)
-	
+
 	// Can only send
 	go func() {
 		sendOnly <- 100
 		// sendOnly <- 200 // This would work
 		// value := <-sendOnly // This would cause compile error
 	}()
-	
+
 	received := <-bidirectional // Receive from original bidirectional
 	fmt.Printf("Received: %d\n", received)
+}
 
-	// 3. Receive-only channel
+// 3. Receive-only channel
+func receiveOnlyChannel() {
 	fmt.Println("\n3. Receive-only channel:")
+	bidirectional := make(chan int)
 	var receiveOnly <-chan int = bidirectional
 	fmt.Printf("Receive-only channel type: %T\n", receiveOnly)
-	
+
 	// Send to bidirectional, receive from receive-only
 	go func() {
 		bidirectional <- 200
 	}()
-	
-	received = <-receiveOnly
+
+	received := <-receiveOnly
 	fmt.Printf("Received from receive-only: %d\n", received)
-	
+
 	// receiveOnly <- 300 // This would cause compile error
+}
 
-	// 4. Function with send-only parameter
+// 4. Function with send-only parameter
+func functionWithSendOnlyParameter() {
 	fmt.Println("\n4. Function with send-only parameter:")
-	
+
 	sendData := func(ch chan<- int, data int) {
 		ch <- data
 		fmt.Printf("Sent %d to send-only channel\n", data)
 	}
-	
+
 	sendChannel := make(chan int)
 	go sendData(sendChannel, 300)
-	
-	received = <-sendChannel
+
+	received := <-sendChannel
 	fmt.Printf("Received: %d\n", received)
+}
 
-	// 5. Function with receive-only parameter
+// 5. Function with receive-only parameter
+func functionWithReceiveOnlyParameter() {
 	fmt.Println("\n5. Function with receive-only parameter:")
-	
+
 	receiveData := func(ch <-chan int) {
 		data := <-ch
 		fmt.Printf("Received %d from receive-only channel\n", data)
 	}
-	
+
 	receiveChannel := make(chan int)
 	go func() {
 		receiveChannel <- 400
 	}()
-	
+
 	receiveData(receiveChannel)
+}
 
-	// 6. Function with both send-only and receive-only parameters
+// 6. Function with both send-only and receive-only parameters
+func functionWithBothSendOnlyAndReceiveOnlyParameters() {
 	fmt.Println("\n6. Function with both directions:")
-	
+
 	bridgeData := func(input <-chan int, output chan<- int) {
 		data := <-input
 		output <- data * 2
 		fmt.Printf("Bridged %d to %d\n", data, data*2)
 	}
-	
+
 	inputChan := make(chan int)
 	outputChan := make(chan int)
-	
+
 	go func() {
 		inputChan <- 50
 	}()
-	
+
 	go bridgeData(inputChan, outputChan)
-	
+
 	result := <-outputChan
 	fmt.Printf("Final result: %d\n", result)
+}
 
-	// 7. Channel directions in struct fields
+// 7. Channel directions in struct fields
+func channelDirectionsInStructFields() {
 	fmt.Println("\n7. Channel directions in struct:")
-	
+
 	type DataProcessor struct {
 		input  <-chan int
 		output chan<- int
 	}
-	
+
 	newProcessor := func(input <-chan int, output chan<- int) *DataProcessor {
 		return &DataProcessor{
 			input:  input,
 			output: output,
 		}
 	}
-	
+
 	procInput := make(chan int)
 	procOutput := make(chan int)
 	processor := newProcessor(procInput, procOutput)
-	
+
 	go func() {
 		procInput <- 75
 	}()
-	
+
 	go func() {
 		data := <-processor.input
 		processor.output <- data + 25
 	}()
-	
+
 	finalResult := <-procOutput
 	fmt.Printf("Processor result: %d\n", finalResult)
+}
 
-	// 8. Returning directional channels
+// 8. Returning directional channels
+func returningDirectionalChannels() {
 	fmt.Println("\n8. Returning directional channels:")
-	
+
 	createChannels := func() (<-chan int, chan<- int) {
 		ch := make(chan int)
 		return ch, ch
 	}
-	
+
 	readOnly, writeOnly := createChannels()
-	
+
 	go func() {
 		writeOnly <- 500
 	}()
-	
+
 	readValue := <-readOnly
 	fmt.Printf("Read value: %d\n", readValue)
-	
+
 	// writeOnly <- 600 // This would work
 	// readOnly <- 700   // This would cause compile error
+}
 
-	// 9. Channel conversion
+// 9. Channel conversion
+func channelConversion() {
 	fmt.Println("\n9. Channel conversion:")
-	
+
 	// Start with bidirectional
 	bidirectionalChan := make(chan string)
-	
+
 	// Convert to send-only
 	var sendOnlyChan chan<- string = bidirectionalChan
-	
+
 	// Convert to receive-only
 	var receiveOnlyChan <-chan string = bidirectionalChan
-	
+
 	go func() {
 		sendOnlyChan <- "Hello from send-only"
 	}()
-	
+
 	message := <-receiveOnlyChan
 	fmt.Printf("Message: %s\n", message)
+}
 
-	// 10. Practical example: Producer-Consumer with directions
+// 10. Practical example: Producer-Consumer with directions
+func practicalExampleProducerConsumerWithDirections() {
 	fmt.Println("\n10. Producer-Consumer with directions:")
-	
+
 	producer := func(output chan<- int) {
 		for i := 1; i <= 3; i++ {
 			output <- i
@@ -177,40 +246,42 @@ func main() {
 		}
 		close(output)
 	}
-	
+
 	consumer := func(input <-chan int) {
 		for value := range input {
 			fmt.Printf("Consumed: %d\n", value)
 		}
 	}
-	
+
 	prodConsChan := make(chan int)
-	
+
 	go producer(prodConsChan)
 	consumer(prodConsChan)
+}
 
-	// 11. Pipeline with directional channels
+// 11. Pipeline with directional channels
+func pipelineWithDirectionalChannels() {
 	fmt.Println("\n11. Pipeline with directions:")
-	
+
 	stage1 := func(input <-chan int, output chan<- int) {
 		for value := range input {
 			output <- value * 2
 		}
 		close(output)
 	}
-	
+
 	stage2 := func(input <-chan int, output chan<- int) {
 		for value := range input {
 			output <- value + 10
 		}
 		close(output)
 	}
-	
+
 	// Create pipeline
 	pipe1 := make(chan int)
 	pipe2 := make(chan int)
 	pipe3 := make(chan int)
-	
+
 	// Input stage
 	go func() {
 		defer close(pipe1)
@@ -218,19 +289,21 @@ func main() {
 			pipe1 <- i
 		}
 	}()
-	
+
 	// Processing stages
 	go stage1(pipe1, pipe2)
 	go stage2(pipe2, pipe3)
-	
+
 	// Collect results
 	for result := range pipe3 {
 		fmt.Printf("Pipeline result: %d\n", result)
 	}
+}
 
-	// 12. Fan-out with directional channels
+// 12. Fan-out with directional channels
+func fanOutWithDirectionalChannels() {
 	fmt.Println("\n12. Fan-out with directional channels:")
-	
+
 	distributor := func(input <-chan int, outputs []chan<- int) {
 		for value := range input {
 			for _, output := range outputs {
@@ -241,7 +314,7 @@ func main() {
 			close(output)
 		}
 	}
-	
+
 	worker := func(id int, input <-chan int, results chan<- int) {
 		for value := range input {
 			result := value * id
@@ -249,23 +322,27 @@ func main() {
 			fmt.Printf("Worker %d: %d -> %d\n", id, value, result)
 		}
 	}
-	
+
 	// Setup fan-out
 	input := make(chan int)
 	outputs := make([]chan int, 3)
 	for i := range outputs {
 		outputs[i] = make(chan int)
 	}
-	
-	// Start distributor
-	go distributor(input, outputs)
-	
+
+	// Start distributor with send-only views of the outputs
+	sendOutputs := make([]chan<- int, len(outputs))
+	for i, output := range outputs {
+		sendOutputs[i] = output
+	}
+	go distributor(input, sendOutputs)
+
 	// Start workers
 	results := make(chan int, 9)
 	for i := 0; i < 3; i++ {
 		go worker(i+1, outputs[i], results)
 	}
-	
+
 	// Send input data
 	go func() {
 		defer close(input)
@@ -273,34 +350,34 @@ func main() {
 			input <- i
 		}
 	}()
-	
+
 	// Collect results
 	for i := 0; i < 9; i++ {
 		result := <-results
 		fmt.Printf("Final result: %d\n", result)
 	}
+}
 
-	// 13. Type safety with directions
+// 13. Type safety with directions
+func typeSafetyWithDirections() {
 	fmt.Println("\n13. Type safety demonstration:")
-	
+
 	// This function enforces that you can only send to the channel
 	safeSender := func(ch chan<- int) {
 		ch <- 999
 		fmt.Println("Successfully sent to send-only channel")
 		// value := <-ch // This would cause compile error
 	}
-	
+
 	// This function enforces that you can only receive from the channel
 	safeReceiver := func(ch <-chan int) {
 		value := <-ch
 		fmt.Printf("Successfully received %d from receive-only channel\n", value)
 		// ch <- 888 // This would cause compile error
 	}
-	
+
 	safeChan := make(chan int)
-	
+
 	go safeSender(safeChan)
 	safeReceiver(safeChan)
-
-	fmt.Println("All channel direction examples completed!")
 }
@@ -1,74 +1,126 @@
-package main
+package channelsynchronization
 
 import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "channel-synchronization",
+		Category:    examples.Expert,
+		Description: "Channel Synchronization",
+		Icon:        "🔄",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic synchronization with channels", Run: basicSynchronizationWithChannels},
+			{Number: 2, Title: "Synchronizing multiple goroutines", Run: synchronizingMultipleGoroutines},
+			{Number: 3, Title: "Using channels for coordination", Run: usingChannelsForCoordination},
+			{Number: 4, Title: "Pipeline synchronization", Run: pipelineSynchronization},
+			{Number: 5, Title: "Fan-in synchronization", Run: fanInSynchronization},
+			{Number: 6, Title: "Synchronization with struct", Run: synchronizationWithStruct},
+			{Number: 7, Title: "Synchronization with timeout", Run: synchronizationWithTimeout},
+			{Number: 8, Title: "Barrier synchronization pattern", Run: barrierSynchronizationPattern},
+			{Number: 9, Title: "Synchronization with WaitGroup and channels", Run: synchronizationWithWaitGroupAndChannels},
+			{Number: 10, Title: "Producer-consumer synchronization", Run: producerConsumerSynchronization},
+			{Number: 11, Title: "Synchronization for resource access", Run: synchronizationForResourceAccess},
+			{Number: 12, Title: "Synchronization with context signaling", Run: synchronizationWithContextSignaling},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Channel Synchronization Examples ===")
 
-	// 1. Basic synchronization with channels
+	basicSynchronizationWithChannels()
+	synchronizingMultipleGoroutines()
+	usingChannelsForCoordination()
+	pipelineSynchronization()
+	fanInSynchronization()
+	synchronizationWithStruct()
+	synchronizationWithTimeout()
+	barrierSynchronizationPattern()
+	synchronizationWithWaitGroupAndChannels()
+	producerConsumerSynchronization()
+	synchronizationForResourceAccess()
+	synchronizationWithContextSignaling()
+
+	fmt.Println("All synchronization examples completed!")
+}
+
+// 1. Basic synchronization with channels
+func basicSynchronizationWithChannels() {
 	fmt.Println("\n1. Basic synchronization:")
+	// readme:begin
 	done := make(chan bool)
-	
+
 	go func() {
 		fmt.Println("Worker started")
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		fmt.Println("Worker finished")
 		done <- true
 	}()
-	
+
 	fmt.Println("Main waiting for worker...")
 	<-done
+	// readme:end
 	fmt.Println("Main received completion signal")
+}
 
-	// 2. Synchronizing multiple goroutines
+// 2. Synchronizing multiple goroutines
+func synchronizingMultipleGoroutines() {
 	fmt.Println("\n2. Multiple goroutine synchronization:")
 	numWorkers := 3
 	doneChan := make(chan bool, numWorkers)
-	
+
 	for i := 1; i <= numWorkers; i++ {
 		go func(id int) {
 			fmt.Printf("Worker %d started\n", id)
-			time.Sleep(time.Duration(id*100) * time.Millisecond)
+			clock.Sleep(time.Duration(id*100) * time.Millisecond)
 			fmt.Printf("Worker %d finished\n", id)
 			doneChan <- true
 		}(i)
 	}
-	
+
 	// Wait for all workers
 	for i := 0; i < numWorkers; i++ {
 		<-doneChan
 	}
 	fmt.Println("All workers completed")
+}
 
-	// 3. Using channels for coordination
+// 3. Using channels for coordination
+func usingChannelsForCoordination() {
 	fmt.Println("\n3. Coordination pattern:")
 	startChan := make(chan struct{})
 	workerDone := make(chan struct{})
-	
+
 	go func() {
 		<-startChan // Wait for start signal
 		fmt.Println("Worker received start signal")
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		fmt.Println("Worker completed work")
 		workerDone <- struct{}{}
 	}()
-	
+
 	fmt.Println("Main sending start signal")
 	close(startChan) // Broadcast start signal
-	
+
 	<-workerDone
 	fmt.Println("Main received completion signal")
+}
 
-	// 4. Pipeline synchronization
+// 4. Pipeline synchronization
+func pipelineSynchronization() {
 	fmt.Println("\n4. Pipeline synchronization:")
 	stage1 := make(chan int, 3)
 	stage2 := make(chan int, 3)
 	stage3 := make(chan int, 3)
-	
+
 	// Stage 1: Generate numbers
 	go func() {
 		defer close(stage1)
@@ -77,7 +129,7 @@ func main() {
 			fmt.Printf("Stage 1 generated: %d\n", i)
 		}
 	}()
-	
+
 	// Stage 2: Process numbers
 	go func() {
 		defer close(stage2)
@@ -87,7 +139,7 @@ func main() {
 			fmt.Printf("Stage 2 processed: %d -> %d\n", num, result)
 		}
 	}()
-	
+
 	// Stage 3: Final processing
 	go func() {
 		defer close(stage3)
@@ -97,17 +149,19 @@ func main() {
 			fmt.Printf("Stage 3 processed: %d -> %d\n", num, result)
 		}
 	}()
-	
+
 	// Collect results
 	for result := range stage3 {
 		fmt.Printf("Final result: %d\n", result)
 	}
+}
 
-	// 5. Fan-in synchronization
+// 5. Fan-in synchronization
+func fanInSynchronization() {
 	fmt.Println("\n5. Fan-in synchronization:")
 	input := make(chan int)
 	output := make(chan int)
-	
+
 	// Multiple workers processing same input
 	worker := func(id int, input <-chan int, output chan<- int) {
 		for num := range input {
@@ -116,12 +170,12 @@ func main() {
 			fmt.Printf("Worker %d processed: %d -> %d\n", id, num, result)
 		}
 	}
-	
+
 	// Start 3 workers
 	for i := 1; i <= 3; i++ {
 		go worker(i, input, output)
 	}
-	
+
 	// Send input data
 	go func() {
 		defer close(input)
@@ -129,14 +183,16 @@ func main() {
 			input <- i
 		}
 	}()
-	
+
 	// Collect results
-	for i := 0; i < 15; i++ { // 5 inputs * 3 workers
+	for i := 0; i < 5; i++ { // each input is handled by exactly one worker
 		result := <-output
 		fmt.Printf("Received: %d\n", result)
 	}
+}
 
-	// 6. Synchronization with struct
+// 6. Synchronization with struct
+func synchronizationWithStruct() {
 	fmt.Println("\n6. Struct-based synchronization:")
 	type Worker struct {
 		id     int
@@ -144,15 +200,15 @@ func main() {
 		output chan int
 		done   chan bool
 	}
-	
+
 	newWorker := func(id int) *Worker {
 		w := &Worker{
 			id:     id,
 			input:  make(chan int),
-			output: make(chan int),
+			output: make(chan int, 3), // nobody reads results here, so leave room for them
 			done:   make(chan bool),
 		}
-		
+
 		go func() {
 			for num := range w.input {
 				result := num * w.id
@@ -161,12 +217,12 @@ func main() {
 			}
 			w.done <- true
 		}()
-		
+
 		return w
 	}
-	
+
 	workers := []*Worker{newWorker(2), newWorker(3)}
-	
+
 	// Send work to all workers
 	go func() {
 		for _, w := range workers {
@@ -178,82 +234,90 @@ func main() {
 			}(w)
 		}
 	}()
-	
+
 	// Wait for all workers to complete
 	for _, w := range workers {
 		<-w.done
 		fmt.Printf("Worker %d completed\n", w.id)
 	}
+}
 
-	// 7. Synchronization with timeout
+// 7. Synchronization with timeout
+func synchronizationWithTimeout() {
 	fmt.Println("\n7. Synchronization with timeout:")
 	syncChan := make(chan bool)
-	
+
 	go func() {
-		time.Sleep(200 * time.Millisecond)
+		clock.Sleep(200 * time.Millisecond)
 		syncChan <- true
 	}()
-	
+
 	select {
 	case <-syncChan:
 		fmt.Println("Operation completed successfully")
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Operation timed out")
 	}
+}
 
-	// 8. Barrier synchronization pattern
+// 8. Barrier synchronization pattern
+func barrierSynchronizationPattern() {
 	fmt.Println("\n8. Barrier pattern:")
 	barrier := make(chan struct{})
 	workerCount := 3
-	
+
 	for i := 1; i <= workerCount; i++ {
 		go func(id int) {
 			fmt.Printf("Worker %d phase 1\n", id)
-			time.Sleep(time.Duration(id*50) * time.Millisecond)
-			
+			clock.Sleep(time.Duration(id*50) * time.Millisecond)
+
 			// Wait at barrier
 			<-barrier
 			fmt.Printf("Worker %d phase 2\n", id)
 		}(i)
 	}
-	
+
 	// Open barrier after all workers reach it
-	time.Sleep(200 * time.Millisecond)
+	clock.Sleep(200 * time.Millisecond)
 	close(barrier)
-	time.Sleep(100 * time.Millisecond)
+	clock.Sleep(100 * time.Millisecond)
+}
 
-	// 9. Synchronization with WaitGroup and channels
+// 9. Synchronization with WaitGroup and channels
+func synchronizationWithWaitGroupAndChannels() {
 	fmt.Println("\n9. WaitGroup + channels:")
 	var wg sync.WaitGroup
 	results := make(chan int, 10)
-	
+
 	for i := 1; i <= 5; i++ {
 		wg.Add(1)
 		go func(id int) {
 			defer wg.Done()
-			time.Sleep(time.Duration(id*50) * time.Millisecond)
+			clock.Sleep(time.Duration(id*50) * time.Millisecond)
 			result := id * 10
 			results <- result
 			fmt.Printf("Worker %d produced: %d\n", id, result)
 		}(i)
 	}
-	
+
 	// Close results channel when all workers done
 	go func() {
 		wg.Wait()
 		close(results)
 	}()
-	
+
 	// Collect results
 	for result := range results {
 		fmt.Printf("Collected: %d\n", result)
 	}
+}
 
-	// 10. Producer-consumer synchronization
+// 10. Producer-consumer synchronization
+func producerConsumerSynchronization() {
 	fmt.Println("\n10. Producer-consumer:")
 	producerChan := make(chan int, 5)
 	consumerDone := make(chan bool)
-	
+
 	// Producer
 	go func() {
 		defer close(producerChan)
@@ -263,46 +327,53 @@ func main() {
 		}
 		fmt.Println("Producer finished")
 	}()
-	
+
 	// Consumer
 	go func() {
 		for item := range producerChan {
 			fmt.Printf("Consumed: %d\n", item)
-			time.Sleep(50 * time.Millisecond)
+			clock.Sleep(50 * time.Millisecond)
 		}
 		consumerDone <- true
 	}()
-	
+
 	<-consumerDone
 	fmt.Println("Consumer finished")
+}
 
-	// 11. Synchronization for resource access
+// 11. Synchronization for resource access
+func synchronizationForResourceAccess() {
 	fmt.Println("\n11. Resource access synchronization:")
 	resourceChan := make(chan struct{}, 1) // Semaphore
-	
+
 	accessResource := func(id int) {
-		resourceChan <- struct{}{} // Acquire
+		resourceChan <- struct{}{}        // Acquire
 		defer func() { <-resourceChan }() // Release
-		
+
 		fmt.Printf("Goroutine %d accessing resource\n", id)
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		fmt.Printf("Goroutine %d finished accessing resource\n", id)
 	}
-	
+
 	// Multiple goroutines accessing shared resource
 	for i := 1; i <= 3; i++ {
 		go accessResource(i)
 	}
-	
-	time.Sleep(500 * time.Millisecond)
 
-	// 12. Synchronization with context signaling
+	clock.Sleep(500 * time.Millisecond)
+}
+
+// 12. Synchronization with context signaling
+func synchronizationWithContextSignaling() {
 	fmt.Println("\n12. Context signaling:")
 	stopChan := make(chan struct{})
+	stopped := make(chan struct{})
 	dataChan := make(chan int)
-	
-	// Data producer
+
+	// Data producer owns dataChan, so it is the one to close it
 	go func() {
+		defer close(stopped)
+		defer close(dataChan)
 		i := 1
 		for {
 			select {
@@ -312,11 +383,11 @@ func main() {
 			case dataChan <- i:
 				fmt.Printf("Produced: %d\n", i)
 				i++
-				time.Sleep(50 * time.Millisecond)
+				clock.Sleep(50 * time.Millisecond)
 			}
 		}
 	}()
-	
+
 	// Consumer with stop condition
 	go func() {
 		count := 0
@@ -328,10 +399,7 @@ func main() {
 				break
 			}
 		}
-		close(dataChan)
 	}()
-	
-	time.Sleep(400 * time.Millisecond)
 
-	fmt.Println("All synchronization examples completed!")
+	<-stopped
 }
@@ -1,42 +1,102 @@
-package main
+package channels
 
 import (
 	"fmt"
 	"math/rand"
 	"sync"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "channels",
+		Category:    examples.Advanced,
+		Description: "Channel Communication",
+		Icon:        "📡",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic channel operations", Run: basicChannelOperations},
+			{Number: 2, Title: "Channel with direction", Run: channelWithDirection},
+			{Number: 3, Title: "Multiple goroutines communicating", Run: multipleGoroutinesCommunicating},
+			{Number: 4, Title: "Channel as function parameter", Run: channelAsFunctionParameter},
+			{Number: 5, Title: "Select statement with channels", Run: selectStatementWithChannels},
+			{Number: 6, Title: "Timeout with select", Run: timeoutWithSelect},
+			{Number: 7, Title: "Non-blocking channel operations", Run: nonBlockingChannelOperations},
+			{Number: 8, Title: "Closing channels", Run: closingChannels},
+			{Number: 9, Title: "Range over channel", Run: rangeOverChannel},
+			{Number: 10, Title: "Worker pool with channels", Run: workerPoolWithChannels},
+			{Number: 11, Title: "Fan-in pattern", Run: fanInPattern},
+			{Number: 12, Title: "Fan-out pattern", Run: fanOutPattern},
+			{Number: 13, Title: "Channel for signaling", Run: channelForSignaling},
+			{Number: 14, Title: "Channel for cancellation", Run: channelForCancellation},
+			{Number: 15, Title: "Channel with struct for complex data", Run: channelWithStructForComplexData},
+			{Number: 16, Title: "Channel pipeline", Run: channelPipeline},
+			{Number: 17, Title: "Channel for rate limiting", Run: channelForRateLimiting},
+			{Number: 18, Title: "Channel with timeout pattern", Run: channelWithTimeoutPattern},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Channels Examples ===")
 
-	// 1. Basic channel operations
+	basicChannelOperations()
+	channelWithDirection()
+	multipleGoroutinesCommunicating()
+	channelAsFunctionParameter()
+	selectStatementWithChannels()
+	timeoutWithSelect()
+	nonBlockingChannelOperations()
+	closingChannels()
+	rangeOverChannel()
+	workerPoolWithChannels()
+	fanInPattern()
+	fanOutPattern()
+	channelForSignaling()
+	channelForCancellation()
+	channelWithStructForComplexData()
+	channelPipeline()
+	channelForRateLimiting()
+	channelWithTimeoutPattern()
+
+	fmt.Println("All channel examples completed!")
+}
+
+// 1. Basic channel operations
+func basicChannelOperations() {
 	fmt.Println("\n1. Basic channel operations:")
 	ch := make(chan string)
-	
+
 	// Send data in goroutine
 	go func() {
 		ch <- "Hello from goroutine!"
 	}()
-	
+
 	// Receive data
 	message := <-ch
 	fmt.Printf("Received: %s\n", message)
+}
 
-	// 2. Channel with direction
+// 2. Channel with direction
+func channelWithDirection() {
 	fmt.Println("\n2. Directional channels:")
 	// Send-only channel
 	sendOnly := make(chan<- int)
 	// Receive-only channel
 	receiveOnly := make(<-chan int)
-	
+
 	fmt.Printf("Send-only channel type: %T\n", sendOnly)
 	fmt.Printf("Receive-only channel type: %T\n", receiveOnly)
+}
 
-	// 3. Multiple goroutines communicating
+// 3. Multiple goroutines communicating
+func multipleGoroutinesCommunicating() {
 	fmt.Println("\n3. Multiple goroutines communication:")
 	ch2 := make(chan int)
-	
+
 	// Producer
 	go func() {
 		for i := 1; i <= 5; i++ {
@@ -45,37 +105,41 @@ func main() {
 		}
 		close(ch2)
 	}()
-	
+
 	// Consumer
 	for value := range ch2 {
 		fmt.Printf("Received: %d\n", value)
 	}
+}
 
-	// 4. Channel as function parameter
+// 4. Channel as function parameter
+func channelAsFunctionParameter() {
 	fmt.Println("\n4. Channel as function parameter:")
 	ch3 := make(chan string)
-	
+
 	go sendData(ch3, "Hello", "World", "Go")
-	
+
 	for msg := range ch3 {
 		fmt.Printf("Got: %s\n", msg)
 	}
+}
 
-	// 5. Select statement with channels
+// 5. Select statement with channels
+func selectStatementWithChannels() {
 	fmt.Println("\n5. Select statement:")
 	ch4 := make(chan string)
 	ch5 := make(chan int)
-	
+
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		ch4 <- "From channel 4"
 	}()
-	
+
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch5 <- 42
 	}()
-	
+
 	select {
 	case msg := <-ch4:
 		fmt.Printf("Received from ch4: %s\n", msg)
@@ -84,52 +148,60 @@ func main() {
 	default:
 		fmt.Println("No data available")
 	}
+}
 
-	// 6. Timeout with select
+// 6. Timeout with select
+func timeoutWithSelect() {
 	fmt.Println("\n6. Timeout with select:")
 	ch6 := make(chan string)
-	
+
 	go func() {
-		time.Sleep(200 * time.Millisecond)
+		clock.Sleep(200 * time.Millisecond)
 		ch6 <- "Delayed message"
 	}()
-	
+
+	// readme:begin
 	select {
 	case msg := <-ch6:
 		fmt.Printf("Received: %s\n", msg)
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Timeout occurred")
 	}
+	// readme:end
+}
 
-	// 7. Non-blocking channel operations
+// 7. Non-blocking channel operations
+func nonBlockingChannelOperations() {
 	fmt.Println("\n7. Non-blocking operations:")
 	ch7 := make(chan int)
-	
+
 	select {
 	case val := <-ch7:
 		fmt.Printf("Received: %d\n", val)
 	default:
 		fmt.Println("No data to receive")
 	}
-	
+
 	select {
 	case ch7 <- 42:
 		fmt.Println("Sent data")
 	default:
 		fmt.Println("Could not send data (channel blocking)")
 	}
+}
 
-	// 8. Closing channels
+// 8. Closing channels
+func closingChannels() {
 	fmt.Println("\n8. Closing channels:")
 	ch8 := make(chan int)
-	
+
 	go func() {
 		for i := 1; i <= 3; i++ {
 			ch8 <- i
 		}
 		close(ch8)
 	}()
-	
+
 	for {
 		value, ok := <-ch8
 		if !ok {
@@ -138,11 +210,13 @@ func main() {
 		}
 		fmt.Printf("Received: %d\n", value)
 	}
+}
 
-	// 9. Range over channel
+// 9. Range over channel
+func rangeOverChannel() {
 	fmt.Println("\n9. Range over channel:")
 	ch9 := make(chan string)
-	
+
 	go func() {
 		defer close(ch9)
 		items := []string{"apple", "banana", "cherry"}
@@ -150,46 +224,50 @@ func main() {
 			ch9 <- item
 		}
 	}()
-	
+
 	for item := range ch9 {
 		fmt.Printf("Item: %s\n", item)
 	}
+}
 
-	// 10. Worker pool with channels
+// 10. Worker pool with channels
+func workerPoolWithChannels() {
 	fmt.Println("\n10. Worker pool:")
 	jobs := make(chan int, 10)
 	results := make(chan int, 10)
-	
+
 	// Start workers
 	var wg sync.WaitGroup
 	for i := 1; i <= 3; i++ {
 		wg.Add(1)
 		go poolWorker(i, jobs, results, &wg)
 	}
-	
+
 	// Send jobs
 	for j := 1; j <= 5; j++ {
 		jobs <- j
 	}
 	close(jobs)
-	
+
 	// Wait for workers to finish
 	go func() {
 		wg.Wait()
 		close(results)
 	}()
-	
+
 	// Collect results
 	for result := range results {
 		fmt.Printf("Result: %d\n", result)
 	}
+}
 
-	// 11. Fan-in pattern
+// 11. Fan-in pattern
+func fanInPattern() {
 	fmt.Println("\n11. Fan-in pattern:")
 	input1 := make(chan int)
 	input2 := make(chan int)
 	output := make(chan int)
-	
+
 	// Producers
 	go func() {
 		defer close(input1)
@@ -197,14 +275,14 @@ func main() {
 			input1 <- i
 		}
 	}()
-	
+
 	go func() {
 		defer close(input2)
 		for i := 4; i <= 6; i++ {
 			input2 <- i
 		}
 	}()
-	
+
 	// Fan-in
 	go func() {
 		defer close(output)
@@ -228,16 +306,18 @@ func main() {
 			}
 		}
 	}()
-	
+
 	for val := range output {
 		fmt.Printf("Fan-in result: %d\n", val)
 	}
+}
 
-	// 12. Fan-out pattern
+// 12. Fan-out pattern
+func fanOutPattern() {
 	fmt.Println("\n12. Fan-out pattern:")
 	input := make(chan int)
 	outputs := []chan int{make(chan int), make(chan int), make(chan int)}
-	
+
 	// Distribute to multiple workers
 	for i, output := range outputs {
 		go func(id int, out chan int) {
@@ -248,7 +328,7 @@ func main() {
 			close(out)
 		}(i+1, output)
 	}
-	
+
 	// Send input data
 	go func() {
 		defer close(input)
@@ -256,7 +336,7 @@ func main() {
 			input <- i
 		}
 	}()
-	
+
 	// Collect from all outputs
 	for i, output := range outputs {
 		fmt.Printf("Worker %d results: ", i+1)
@@ -265,30 +345,34 @@ func main() {
 		}
 		fmt.Println()
 	}
+}
 
-	// 13. Channel for signaling
+// 13. Channel for signaling
+func channelForSignaling() {
 	fmt.Println("\n13. Channel for signaling:")
 	done := make(chan bool)
-	
+
 	go func() {
 		fmt.Println("Worker started")
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		fmt.Println("Worker finished")
 		done <- true
 	}()
-	
+
 	fmt.Println("Waiting for worker...")
 	<-done
 	fmt.Println("Worker completed")
+}
 
-	// 14. Channel for cancellation
+// 14. Channel for cancellation
+func channelForCancellation() {
 	fmt.Println("\n14. Channel for cancellation:")
 	stop := make(chan bool)
-	
+
 	go func() {
-		ticker := time.NewTicker(50 * time.Millisecond)
+		ticker := clock.NewTicker(50 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		for {
 			select {
 			case <-ticker.C:
@@ -299,39 +383,43 @@ func main() {
 			}
 		}
 	}()
-	
-	time.Sleep(200 * time.Millisecond)
+
+	clock.Sleep(200 * time.Millisecond)
 	stop <- true
-	time.Sleep(50 * time.Millisecond)
+	clock.Sleep(50 * time.Millisecond)
+}
 
-	// 15. Channel with struct for complex data
+// 15. Channel with struct for complex data
+func channelWithStructForComplexData() {
 	fmt.Println("\n15. Channel with struct data:")
 	type Message struct {
 		ID      int
 		Content string
 		Time    time.Time
 	}
-	
+
 	msgCh := make(chan Message, 3)
-	
+
 	go func() {
 		defer close(msgCh)
 		messages := []Message{
-			{ID: 1, Content: "First message", Time: time.Now()},
-			{ID: 2, Content: "Second message", Time: time.Now()},
-			{ID: 3, Content: "Third message", Time: time.Now()},
+			{ID: 1, Content: "First message", Time: clock.Now()},
+			{ID: 2, Content: "Second message", Time: clock.Now()},
+			{ID: 3, Content: "Third message", Time: clock.Now()},
 		}
-		
+
 		for _, msg := range messages {
 			msgCh <- msg
 		}
 	}()
-	
+
 	for msg := range msgCh {
 		fmt.Printf("Message %d: %s at %v\n", msg.ID, msg.Content, msg.Time.Format("15:04:05"))
 	}
+}
 
-	// 16. Channel pipeline
+// 16. Channel pipeline
+func channelPipeline() {
 	fmt.Println("\n16. Channel pipeline:")
 	// Stage 1: Generate numbers
 	numbers := make(chan int)
@@ -341,7 +429,7 @@ func main() {
 			numbers <- i
 		}
 	}()
-	
+
 	// Stage 2: Square numbers
 	squares := make(chan int)
 	go func() {
@@ -350,7 +438,7 @@ func main() {
 			squares <- num * num
 		}
 	}()
-	
+
 	// Stage 3: Add 10
 	results := make(chan int)
 	go func() {
@@ -359,50 +447,54 @@ func main() {
 			results <- square + 10
 		}
 	}()
-	
+
 	// Collect final results
 	for result := range results {
 		fmt.Printf("Pipeline result: %d\n", result)
 	}
+}
 
-	// 17. Channel for rate limiting
+// 17. Channel for rate limiting
+func channelForRateLimiting() {
 	fmt.Println("\n17. Rate limiting with channel:")
 	requests := make(chan int, 5)
-	
+
 	// Simulate incoming requests
 	for i := 1; i <= 5; i++ {
 		requests <- i
 	}
 	close(requests)
-	
+
 	// Rate limiter
-	limiter := time.NewTicker(100 * time.Millisecond)
+	limiter := clock.NewTicker(100 * time.Millisecond)
 	defer limiter.Stop()
-	
+
 	for req := range requests {
 		<-limiter.C // Wait for ticker
-		fmt.Printf("Processing request %d at %v\n", req, time.Now().Format("15:04:05.000"))
+		fmt.Printf("Processing request %d at %v\n", req, clock.Now().Format("15:04:05.000"))
 	}
+}
 
-	// 18. Channel with timeout pattern
+// 18. Channel with timeout pattern
+func channelWithTimeoutPattern() {
 	fmt.Println("\n18. Timeout pattern:")
 	processWithTimeout := func() (string, error) {
 		ch := make(chan string)
-		
+
 		go func() {
 			// Simulate work that might take time
-			time.Sleep(time.Duration(rand.Intn(200)) * time.Millisecond)
+			clock.Sleep(time.Duration(rand.Intn(200)) * time.Millisecond)
 			ch <- "Work completed"
 		}()
-		
+
 		select {
 		case result := <-ch:
 			return result, nil
-		case <-time.After(100 * time.Millisecond):
+		case <-clock.After(100 * time.Millisecond):
 			return "", fmt.Errorf("operation timed out")
 		}
 	}
-	
+
 	for i := 1; i <= 3; i++ {
 		result, err := processWithTimeout()
 		if err != nil {
@@ -411,8 +503,6 @@ func main() {
 			fmt.Printf("Attempt %d: %s\n", i, result)
 		}
 	}
-
-	fmt.Println("All channel examples completed!")
 }
 
 // Helper functions
@@ -426,10 +516,10 @@ func sendData(ch chan<- string, messages ...string) {
 
 func poolWorker(id int, jobs <-chan int, results chan<- int, wg *sync.WaitGroup) {
 	defer wg.Done()
-	
+
 	for job := range jobs {
 		fmt.Printf("Worker %d processing job %d\n", id, job)
-		time.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
+		clock.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
 		result := job * 2
 		results <- result
 		fmt.Printf("Worker %d completed job %d\n", id, job)
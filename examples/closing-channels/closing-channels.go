@@ -1,17 +1,67 @@
-package main
+package closingchannels
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "closing-channels",
+		Category:    examples.Expert,
+		Description: "Channel Closing",
+		Icon:        "🔒",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic channel closing", Run: basicChannelClosing},
+			{Number: 2, Title: "Checking if channel is closed", Run: checkingIfChannelIsClosed},
+			{Number: 3, Title: "Closing multiple channels", Run: closingMultipleChannels},
+			{Number: 4, Title: "Closing buffered channels", Run: closingBufferedChannels},
+			{Number: 5, Title: "Panic on closed channel", Run: panicOnClosedChannel},
+			{Number: 6, Title: "Closing channels from receiver side", Run: closingChannelsFromReceiverSide},
+			{Number: 7, Title: "Closing with select", Run: closingWithSelect},
+			{Number: 8, Title: "Channel closing patterns", Run: channelClosingPatterns},
+			{Number: 9, Title: "Closing channels in fan-out", Run: closingChannelsInFanOut},
+			{Number: 10, Title: "Graceful shutdown with channel closing", Run: gracefulShutdownWithChannelClosing},
+			{Number: 11, Title: "Channel closing with resource cleanup", Run: channelClosingWithResourceCleanup},
+			{Number: 12, Title: "Detecting closed channel without receiving", Run: detectingClosedChannelWithoutReceiving},
+			{Number: 13, Title: "Channel closing with error handling", Run: channelClosingWithErrorHandling},
+			{Number: 14, Title: "Channel closing with timeout", Run: channelClosingWithTimeout},
+			{Number: 15, Title: "Channel closing statistics", Run: channelClosingStatistics},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Closing Channels Examples ===")
 
-	// 1. Basic channel closing
+	basicChannelClosing()
+	checkingIfChannelIsClosed()
+	closingMultipleChannels()
+	closingBufferedChannels()
+	panicOnClosedChannel()
+	closingChannelsFromReceiverSide()
+	closingWithSelect()
+	channelClosingPatterns()
+	closingChannelsInFanOut()
+	gracefulShutdownWithChannelClosing()
+	channelClosingWithResourceCleanup()
+	detectingClosedChannelWithoutReceiving()
+	channelClosingWithErrorHandling()
+	channelClosingWithTimeout()
+	channelClosingStatistics()
+
+	fmt.Println("All channel closing examples completed!")
+}
+
+// 1. Basic channel closing
+func basicChannelClosing() {
 	fmt.Println("\n1. Basic channel closing:")
 	ch := make(chan int)
-	
+
 	go func() {
 		for i := 1; i <= 3; i++ {
 			ch <- i
@@ -20,23 +70,25 @@ func main() {
 		close(ch)
 		fmt.Println("Channel closed")
 	}()
-	
+
 	// Receive until closed
 	for value := range ch {
 		fmt.Printf("Received: %d\n", value)
 	}
 	fmt.Println("Range completed")
+}
 
-	// 2. Checking if channel is closed
+// 2. Checking if channel is closed
+func checkingIfChannelIsClosed() {
 	fmt.Println("\n2. Checking if channel is closed:")
 	ch2 := make(chan string)
-	
+
 	go func() {
 		ch2 <- "hello"
 		ch2 <- "world"
 		close(ch2)
 	}()
-	
+
 	// Receive with ok check
 	for {
 		value, ok := <-ch2
@@ -46,12 +98,14 @@ func main() {
 		}
 		fmt.Printf("Received: %s\n", value)
 	}
+}
 
-	// 3. Closing multiple channels
+// 3. Closing multiple channels
+func closingMultipleChannels() {
 	fmt.Println("\n3. Closing multiple channels:")
 	ch3 := make(chan int)
 	ch4 := make(chan string)
-	
+
 	go func() {
 		for i := 1; i <= 3; i++ {
 			ch3 <- i
@@ -60,7 +114,7 @@ func main() {
 		close(ch3)
 		close(ch4)
 	}()
-	
+
 	// Receive from both channels
 	ch3Open, ch4Open := true, true
 	for ch3Open || ch4Open {
@@ -71,6 +125,7 @@ func main() {
 			} else {
 				fmt.Println("ch3 closed")
 				ch3Open = false
+				ch3 = nil // A nil channel is never selected again
 			}
 		case str, ok := <-ch4:
 			if ok {
@@ -78,81 +133,90 @@ func main() {
 			} else {
 				fmt.Println("ch4 closed")
 				ch4Open = false
+				ch4 = nil // A nil channel is never selected again
 			}
 		}
 	}
+}
 
-	// 4. Closing buffered channels
+// 4. Closing buffered channels
+func closingBufferedChannels() {
 	fmt.Println("\n4. Closing buffered channels:")
 	buffered := make(chan int, 3)
-	
+
 	// Fill buffer
 	buffered <- 1
 	buffered <- 2
 	buffered <- 3
-	
+
 	fmt.Printf("Buffer length before close: %d\n", len(buffered))
 	close(buffered)
-	
+
 	// Can still receive buffered items after close
 	for len(buffered) > 0 {
 		value := <-buffered
 		fmt.Printf("Received from closed buffered: %d\n", value)
 	}
-	
+
 	// Receive from closed empty channel
 	value, ok := <-buffered
 	fmt.Printf("Receive from closed empty: %v, %t\n", value, ok)
+}
 
-	// 5. Panic on closed channel
+// 5. Panic on closed channel
+func panicOnClosedChannel() {
 	fmt.Println("\n5. Panic on closed channel:")
 	ch5 := make(chan int)
-	
+
 	go func() {
 		ch5 <- 42
 		close(ch5)
 	}()
-	
+
 	// Safe receive
 	value, ok := <-ch5
 	fmt.Printf("Safe receive: %d, %t\n", value, ok)
-	
+
 	// This would panic (commented out)
 	// ch5 <- 100 // Panic: send on closed channel
+}
 
-	// 6. Closing channels from receiver side
+// 6. Closing channels from receiver side
+func closingChannelsFromReceiverSide() {
 	fmt.Println("\n6. Closing from receiver side:")
 	ch6 := make(chan int)
-	
+
 	go func() {
 		// Producer
 		for i := 1; i <= 5; i++ {
 			select {
 			case ch6 <- i:
 				fmt.Printf("Sent: %d\n", i)
-			case <-time.After(10 * time.Millisecond):
+			case <-clock.After(10 * time.Millisecond):
 				fmt.Printf("Send timeout for %d\n", i)
 			}
 		}
 	}()
-	
+
 	// Receiver that decides when to close
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		close(ch6)
 		fmt.Println("Receiver closed channel")
 	}()
-	
+
 	// Receive until closed
 	for value := range ch6 {
 		fmt.Printf("Received: %d\n", value)
 	}
+}
 
-	// 7. Closing with select
+// 7. Closing with select
+func closingWithSelect() {
 	fmt.Println("\n7. Closing with select:")
 	ch7 := make(chan string)
 	stopChan := make(chan bool)
-	
+
 	go func() {
 		for i := 1; i <= 5; i++ {
 			select {
@@ -163,23 +227,25 @@ func main() {
 				close(ch7)
 				return
 			}
-			time.Sleep(20 * time.Millisecond)
+			clock.Sleep(20 * time.Millisecond)
 		}
 		close(ch7)
 	}()
-	
+
 	// Stop after 3 messages
-	time.Sleep(70 * time.Millisecond)
+	clock.Sleep(70 * time.Millisecond)
 	stopChan <- true
-	
+
 	// Receive remaining messages
 	for msg := range ch7 {
 		fmt.Printf("Received: %s\n", msg)
 	}
+}
 
-	// 8. Channel closing patterns
+// 8. Channel closing patterns
+func channelClosingPatterns() {
 	fmt.Println("\n8. Channel closing patterns:")
-	
+
 	// Pattern 1: Producer closes
 	producerCloses := func() <-chan int {
 		ch := make(chan int)
@@ -191,48 +257,51 @@ func main() {
 		}()
 		return ch
 	}
-	
+
 	// Pattern 2: Separate done channel
 	producerWithDone := func() (<-chan int, <-chan struct{}) {
 		ch := make(chan int)
 		done := make(chan struct{})
-		
+
 		go func() {
 			defer close(ch)
 			for i := 1; i <= 3; i++ {
 				ch <- i
 			}
 		}()
-		
+
 		return ch, done
 	}
-	
+
 	// Test pattern 1
 	fmt.Println("Pattern 1 - Producer closes:")
 	ch8 := producerCloses()
 	for value := range ch8 {
 		fmt.Printf("Received: %d\n", value)
 	}
-	
+
 	// Test pattern 2
 	fmt.Println("Pattern 2 - Separate done channel:")
 	ch9, done := producerWithDone()
-	
+
+pattern2:
 	for {
 		select {
 		case value, ok := <-ch9:
 			if !ok {
 				fmt.Println("Channel closed")
-				return
+				break pattern2
 			}
 			fmt.Printf("Received: %d\n", value)
 		case <-done:
 			fmt.Println("Done signal received")
-			return
+			break pattern2
 		}
 	}
+}
 
-	// 9. Closing channels in fan-out
+// 9. Closing channels in fan-out
+func closingChannelsInFanOut() {
 	fmt.Println("\n9. Closing channels in fan-out:")
 	input := make(chan int)
 	outputs := []chan int{
@@ -240,7 +309,7 @@ func main() {
 		make(chan int),
 		make(chan int),
 	}
-	
+
 	// Distributor
 	go func() {
 		defer close(input)
@@ -248,20 +317,22 @@ func main() {
 			input <- i
 		}
 	}()
-	
+
 	// Workers
 	for i, output := range outputs {
 		go func(id int, out chan int) {
+			// readme:begin
 			defer close(out)
 			for value := range input {
 				out <- value * id
 				fmt.Printf("Worker %d: %d -> %d\n", id, value, value*id)
 			}
+			// readme:end
 		}(i+1, output)
 	}
-	
+
 	// Collect from all outputs
-	for i := 0; i < 6*3; i++ { // 6 inputs * 3 workers
+	for i := 0; i < 6; i++ { // each input is handled by exactly one worker
 		select {
 		case value := <-outputs[0]:
 			fmt.Printf("From worker 1: %d\n", value)
@@ -271,68 +342,74 @@ func main() {
 			fmt.Printf("From worker 3: %d\n", value)
 		}
 	}
+}
 
-	// 10. Graceful shutdown with channel closing
+// 10. Graceful shutdown with channel closing
+func gracefulShutdownWithChannelClosing() {
 	fmt.Println("\n10. Graceful shutdown:")
 	workChan := make(chan int)
 	shutdownChan := make(chan struct{})
-	
+
 	// Worker
 	go func() {
 		for {
 			select {
 			case work := <-workChan:
 				fmt.Printf("Processing work: %d\n", work)
-				time.Sleep(30 * time.Millisecond)
+				clock.Sleep(30 * time.Millisecond)
 			case <-shutdownChan:
 				fmt.Println("Received shutdown signal")
 				return
 			}
 		}
 	}()
-	
+
 	// Send work
 	for i := 1; i <= 5; i++ {
 		workChan <- i
 	}
-	
+
 	// Initiate graceful shutdown
 	close(shutdownChan)
-	time.Sleep(50 * time.Millisecond)
+	clock.Sleep(50 * time.Millisecond)
 	close(workChan)
+}
 
-	// 11. Channel closing with resource cleanup
+// 11. Channel closing with resource cleanup
+func channelClosingWithResourceCleanup() {
 	fmt.Println("\n11. Channel closing with cleanup:")
 	resourceChan := make(chan string)
-	
+
 	// Resource manager
 	go func() {
 		defer fmt.Println("Resource cleaned up")
-		
+
 		for resource := range resourceChan {
 			fmt.Printf("Using resource: %s\n", resource)
-			time.Sleep(30 * time.Millisecond)
+			clock.Sleep(30 * time.Millisecond)
 		}
 	}()
-	
+
 	// Send resources
 	resources := []string{"file1", "file2", "file3"}
 	for _, resource := range resources {
 		resourceChan <- resource
 	}
-	
+
 	close(resourceChan) // Trigger cleanup
-	time.Sleep(50 * time.Millisecond)
+	clock.Sleep(50 * time.Millisecond)
+}
 
-	// 12. Detecting closed channel without receiving
+// 12. Detecting closed channel without receiving
+func detectingClosedChannelWithoutReceiving() {
 	fmt.Println("\n12. Detecting closed channel without receiving:")
 	ch10 := make(chan int)
-	
+
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		close(ch10)
 	}()
-	
+
 	// Check if channel is closed without consuming data
 	for i := 0; i < 5; i++ {
 		select {
@@ -341,9 +418,9 @@ func main() {
 		default:
 			fmt.Printf("Channel appears open (check %d)\n", i+1)
 		}
-		time.Sleep(20 * time.Millisecond)
+		clock.Sleep(20 * time.Millisecond)
 	}
-	
+
 	// Final check
 	select {
 	case <-ch10:
@@ -351,19 +428,21 @@ func main() {
 	default:
 		fmt.Println("Channel appears open")
 	}
+}
 
-	// 13. Channel closing with error handling
+// 13. Channel closing with error handling
+func channelClosingWithErrorHandling() {
 	fmt.Println("\n13. Channel closing with error handling:")
 	type Result struct {
 		Value int
 		Error error
 	}
-	
+
 	resultChan := make(chan Result)
-	
+
 	go func() {
 		defer close(resultChan)
-		
+
 		// Simulate some work with potential errors
 		for i := 1; i <= 5; i++ {
 			if i == 3 {
@@ -373,7 +452,7 @@ func main() {
 			resultChan <- Result{Value: i * 10}
 		}
 	}()
-	
+
 	// Process results
 	for result := range resultChan {
 		if result.Error != nil {
@@ -382,19 +461,21 @@ func main() {
 			fmt.Printf("Success: %d\n", result.Value)
 		}
 	}
+}
 
-	// 14. Channel closing with timeout
+// 14. Channel closing with timeout
+func channelClosingWithTimeout() {
 	fmt.Println("\n14. Channel closing with timeout:")
 	ch11 := make(chan int)
-	
+
 	go func() {
-		time.Sleep(200 * time.Millisecond)
+		clock.Sleep(200 * time.Millisecond)
 		close(ch11)
 	}()
-	
+
 	// Wait for close with timeout
-	timeout := time.After(100 * time.Millisecond)
-	
+	timeout := clock.After(100 * time.Millisecond)
+
 Loop:
 	for {
 		select {
@@ -409,30 +490,30 @@ Loop:
 			break Loop
 		}
 	}
+}
 
-	// 15. Channel closing statistics
+// 15. Channel closing statistics
+func channelClosingStatistics() {
 	fmt.Println("\n15. Channel closing statistics:")
 	statsChan := make(chan int)
-	
+
 	go func() {
 		defer close(statsChan)
 		sent := 0
 		for i := 1; i <= 10; i++ {
 			statsChan <- i
 			sent++
-			time.Sleep(10 * time.Millisecond)
+			clock.Sleep(10 * time.Millisecond)
 		}
 		fmt.Printf("Sent %d items before closing\n", sent)
 	}()
-	
+
 	// Count received
 	received := 0
 	for value := range statsChan {
 		received++
 		fmt.Printf("Received: %d\n", value)
 	}
-	
-	fmt.Printf("Total received: %d\n", received)
 
-	fmt.Println("All channel closing examples completed!")
+	fmt.Printf("Total received: %d\n", received)
 }
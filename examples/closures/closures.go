@@ -1,6 +1,10 @@
-package main
+package closures
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
 
 // Function that returns a closure
 func getAdder() func(int) int {
@@ -9,6 +13,8 @@ func getAdder() func(int) int {
 	}
 }
 
+// readme:begin
+
 // Function that returns a closure with captured variable
 func makeMultiplier(factor int) func(int) int {
 	return func(x int) int {
@@ -16,6 +22,8 @@ func makeMultiplier(factor int) func(int) int {
 	}
 }
 
+// readme:end
+
 // Function that returns a closure with multiple captured variables
 func calculator() func(string, int) int {
 	sum := 0
@@ -115,26 +123,71 @@ func memoize(fn func(int) int) func(int) int {
 	}
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "closures",
+		Category:    examples.Beginner,
+		Description: "Closures and Anonymous Functions",
+		Icon:        "🎯",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic closure", Run: basicClosure},
+			{Number: 2, Title: "Closure with captured variable", Run: closureWithCapturedVariable},
+			{Number: 3, Title: "Closure maintaining state", Run: closureMaintainingState},
+			{Number: 4, Title: "Counter closure", Run: counterClosure},
+			{Number: 5, Title: "Greeter closure", Run: greeterClosure},
+			{Number: 6, Title: "Filter closure", Run: filterClosure},
+			{Number: 7, Title: "Accumulator closure", Run: accumulatorClosure},
+			{Number: 8, Title: "Closure with deferred execution", Run: closureWithDeferredExecution},
+			{Number: 9, Title: "Fibonacci generator closure", Run: fibonacciGeneratorClosure},
+			{Number: 10, Title: "Validator closure", Run: validatorClosure},
+			{Number: 11, Title: "Memoization closure", Run: memoizationClosure},
+			{Number: 12, Title: "Closure in loops (common pitfall and solution)", Run: closureInLoops},
+			{Number: 13, Title: "Closure as method-like function", Run: closureAsMethodLikeFunction},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Closures Examples ===")
 
-	// 1. Basic closure
+	basicClosure()
+	closureWithCapturedVariable()
+	closureMaintainingState()
+	counterClosure()
+	greeterClosure()
+	filterClosure()
+	accumulatorClosure()
+	closureWithDeferredExecution()
+	fibonacciGeneratorClosure()
+	validatorClosure()
+	memoizationClosure()
+	closureInLoops()
+	closureAsMethodLikeFunction()
+}
+
+// 1. Basic closure
+func basicClosure() {
 	fmt.Println("\n1. Basic closure:")
 	adder := getAdder()
 	result := adder(5)
 	fmt.Printf("5 + 1 = %d\n", result)
+}
 
-	// 2. Closure with captured variable
+// 2. Closure with captured variable
+func closureWithCapturedVariable() {
 	fmt.Println("\n2. Closure with captured variable:")
 	times2 := makeMultiplier(2)
 	times3 := makeMultiplier(3)
 	times5 := makeMultiplier(5)
-	
+
 	fmt.Printf("10 * 2 = %d\n", times2(10))
 	fmt.Printf("10 * 3 = %d\n", times3(10))
 	fmt.Printf("10 * 5 = %d\n", times5(10))
+}
 
-	// 3. Closure maintaining state
+// 3. Closure maintaining state
+func closureMaintainingState() {
 	fmt.Println("\n3. Closure maintaining state:")
 	calc := calculator()
 	fmt.Printf("Initial: %d\n", calc("reset", 0))
@@ -142,51 +195,63 @@ func main() {
 	fmt.Printf("Add 5: %d\n", calc("add", 5))
 	fmt.Printf("Subtract 3: %d\n", calc("subtract", 3))
 	fmt.Printf("Multiply 2: %d\n", calc("multiply", 2))
+}
 
-	// 4. Counter closure
+// 4. Counter closure
+func counterClosure() {
 	fmt.Println("\n4. Counter closure:")
 	count1 := counter()
 	count2 := counter()
-	
+
 	fmt.Printf("Counter 1: %d\n", count1())
 	fmt.Printf("Counter 1: %d\n", count1())
 	fmt.Printf("Counter 2: %d\n", count2())
 	fmt.Printf("Counter 1: %d\n", count1())
 	fmt.Printf("Counter 2: %d\n", count2())
+}
 
-	// 5. Greeter closure
+// 5. Greeter closure
+func greeterClosure() {
 	fmt.Println("\n5. Greeter closure:")
 	helloGreeter := makeGreeter("Hello")
 	hiGreeter := makeGreeter("Hi")
-	
+
 	fmt.Printf("%s\n", helloGreeter("Alice"))
 	fmt.Printf("%s\n", hiGreeter("Bob"))
 	fmt.Printf("%s\n", helloGreeter("Charlie"))
+}
 
-	// 6. Filter closure
+// 6. Filter closure
+func filterClosure() {
 	fmt.Println("\n6. Filter closure:")
 	evenFilter := makeFilter(func(n int) bool { return n%2 == 0 })
 	positiveFilter := makeFilter(func(n int) bool { return n > 0 })
-	
+
 	numbers := []int{-2, -1, 0, 1, 2, 3, 4, 5}
 	fmt.Printf("Original: %v\n", numbers)
 	fmt.Printf("Even: %v\n", evenFilter(numbers))
 	fmt.Printf("Positive: %v\n", positiveFilter(numbers))
+}
 
-	// 7. Accumulator closure
+// 7. Accumulator closure
+func accumulatorClosure() {
 	fmt.Println("\n7. Accumulator closure:")
 	acc := accumulator()
 	fmt.Printf("Add 10: %d\n", acc(10))
 	fmt.Printf("Add 5: %d\n", acc(5))
 	fmt.Printf("Add 15: %d\n", acc(15))
+}
 
-	// 8. Closure with deferred execution
+// 8. Closure with deferred execution
+func closureWithDeferredExecution() {
 	fmt.Println("\n8. Deferred closure:")
 	deferred := deferExample()
 	fmt.Println("Creating deferred closure...")
 	deferred() // Executes when called
+}
 
-	// 9. Fibonacci generator closure
+// 9. Fibonacci generator closure
+func fibonacciGeneratorClosure() {
 	fmt.Println("\n9. Fibonacci generator:")
 	fib := fibonacciGenerator()
 	fmt.Print("First 10 Fibonacci numbers: ")
@@ -194,48 +259,55 @@ func main() {
 		fmt.Printf("%d ", fib())
 	}
 	fmt.Println()
+}
 
-	// 10. Validator closure
+// 10. Validator closure
+func validatorClosure() {
 	fmt.Println("\n10. Validator closure:")
 	validateAge := makeValidator(0, 120)
 	validateScore := makeValidator(0, 100)
-	
+
 	if valid, msg := validateAge(25); valid {
 		fmt.Printf("✓ %s\n", msg)
 	} else {
 		fmt.Printf("✗ %s\n", msg)
 	}
-	
+
 	if valid, msg := validateAge(150); valid {
 		fmt.Printf("✓ %s\n", msg)
 	} else {
 		fmt.Printf("✗ %s\n", msg)
 	}
-	
+
 	if valid, msg := validateScore(85); valid {
 		fmt.Printf("✓ %s\n", msg)
 	} else {
 		fmt.Printf("✗ %s\n", msg)
 	}
+}
 
-	// 11. Memoization closure
+// 11. Memoization closure
+func memoizationClosure() {
 	fmt.Println("\n11. Memoization closure:")
-	slowFunction := func(n int) int {
+	var slowFunction func(int) int
+	slowFunction = func(n int) int {
 		fmt.Printf("Computing factorial(%d)...\n", n)
 		if n <= 1 {
 			return 1
 		}
 		return n * slowFunction(n-1)
 	}
-	
+
 	memoizedFactorial := memoize(slowFunction)
 	fmt.Printf("First call: %d\n", memoizedFactorial(5))
 	fmt.Printf("Second call (cached): %d\n", memoizedFactorial(5))
 	fmt.Printf("Third call (cached): %d\n", memoizedFactorial(5))
+}
 
-	// 12. Closure in loops (common pitfall and solution)
+// 12. Closure in loops (common pitfall and solution)
+func closureInLoops() {
 	fmt.Println("\n12. Closure in loops:")
-	
+
 	// Wrong way - captures the same variable
 	var wrongFuncs []func() int
 	for i := 0; i < 3; i++ {
@@ -248,7 +320,7 @@ func main() {
 		fmt.Printf("%d ", f())
 	}
 	fmt.Println()
-	
+
 	// Correct way - capture loop variable
 	var correctFuncs []func() int
 	for i := 0; i < 3; i++ {
@@ -262,20 +334,22 @@ func main() {
 		fmt.Printf("%d ", f())
 	}
 	fmt.Println()
+}
 
-	// 13. Closure as method-like function
+// 13. Closure as method-like function
+func closureAsMethodLikeFunction() {
 	fmt.Println("\n13. Closure as method-like function:")
 	type Person struct {
 		Name string
 		Age  int
 	}
-	
+
 	makePersonGreeter := func(p Person) func(string) string {
 		return func(title string) string {
 			return fmt.Sprintf("%s %s, age %d", title, p.Name, p.Age)
 		}
 	}
-	
+
 	person := Person{Name: "Alice", Age: 25}
 	greeter := makePersonGreeter(person)
 	fmt.Printf("%s\n", greeter("Mr."))
@@ -1,15 +1,29 @@
-package main
+package commandlinearguments
 
 import (
 	"fmt"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "command-line-arguments",
+		Category:    examples.Practical,
+		Description: "Command Line Arguments",
+		Icon:        "⚙️",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Command Line Arguments ===")
 
 	// Get all arguments
+	// readme:begin
 	args := os.Args
+	// readme:end
 	fmt.Printf("Program name: %s\n", args[0])
 	fmt.Printf("Number of arguments: %d\n", len(args)-1)
 
@@ -25,7 +39,7 @@ func main() {
 		for i := 1; i < len(args); i++ {
 			arg := args[i]
 			fmt.Printf("  Arg %d: %s\n", i, arg)
-			
+
 			// Handle different argument types
 			switch arg {
 			case "-h", "--help":
@@ -67,7 +81,7 @@ func main() {
 	// Count specific argument types
 	flagCount := 0
 	fileCount := 0
-	
+
 	for i := 1; i < len(args); i++ {
 		if args[i][0] == '-' {
 			flagCount++
@@ -75,6 +89,6 @@ func main() {
 			fileCount++
 		}
 	}
-	
+
 	fmt.Printf("Flags: %d, Files: %d\n", flagCount, fileCount)
 }
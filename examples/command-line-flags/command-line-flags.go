@@ -1,17 +1,31 @@
-package main
+package commandlineflags
 
 import (
 	"flag"
 	"fmt"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "command-line-flags",
+		Category:    examples.Practical,
+		Description: "Command Line Flags",
+		Icon:        "🚩",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Command Line Flags ===")
 
 	// Define flags
+	// readme:begin
 	name := flag.String("name", "World", "Name to greet")
 	age := flag.Int("age", 0, "Your age")
+	// readme:end
 	verbose := flag.Bool("verbose", false, "Enable verbose output")
 	count := flag.Int("count", 1, "Number of times to repeat")
 	help := flag.Bool("help", false, "Show help")
@@ -32,7 +46,7 @@ func main() {
 
 	// Use flag values
 	fmt.Printf("Hello, %s!\n", *name)
-	
+
 	if *age > 0 {
 		fmt.Printf("You are %d years old\n", *age)
 	}
@@ -47,8 +61,8 @@ func main() {
 		}
 	}
 
-	if *output != "" {
-		fmt.Printf("Output will be written to: %s\n", *output)
+	if output != "" {
+		fmt.Printf("Output will be written to: %s\n", output)
 	}
 
 	// Show remaining arguments
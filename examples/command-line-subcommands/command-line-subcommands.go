@@ -1,12 +1,24 @@
-package main
+package commandlinesubcommands
 
 import (
 	"flag"
 	"fmt"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "command-line-subcommands",
+		Category:    examples.Practical,
+		Description: "Command Line Subcommands",
+		Icon:        "📋",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Command Line Subcommands ===")
 
 	// Check if any arguments provided
@@ -36,44 +48,46 @@ func main() {
 
 func handleAdd(args []string) {
 	fmt.Println("=== Add Command ===")
-	
+
+	// readme:begin
 	addFlags := flag.NewFlagSet("add", flag.ExitOnError)
 	name := addFlags.String("name", "", "Item name")
+	// readme:end
 	value := addFlags.Int("value", 0, "Item value")
-	
+
 	addFlags.Parse(args)
-	
+
 	if *name == "" {
 		fmt.Println("Error: --name is required")
 		addFlags.PrintDefaults()
 		return
 	}
-	
+
 	fmt.Printf("Added item: %s with value: %d\n", *name, *value)
 }
 
 func handleList(args []string) {
 	fmt.Println("=== List Command ===")
-	
+
 	listFlags := flag.NewFlagSet("list", flag.ExitOnError)
 	all := listFlags.Bool("all", false, "List all items")
 	count := listFlags.Int("count", 10, "Number of items to show")
-	
+
 	listFlags.Parse(args)
-	
+
 	if *all {
 		fmt.Println("Listing all items:")
 	} else {
 		fmt.Printf("Listing %d items:\n", *count)
 	}
-	
+
 	// Simulate listing items
 	items := []string{"Item1", "Item2", "Item3", "Item4", "Item5"}
 	limit := len(items)
 	if !*all && *count < limit {
 		limit = *count
 	}
-	
+
 	for i := 0; i < limit; i++ {
 		fmt.Printf("  %d. %s\n", i+1, items[i])
 	}
@@ -81,19 +95,19 @@ func handleList(args []string) {
 
 func handleDelete(args []string) {
 	fmt.Println("=== Delete Command ===")
-	
+
 	deleteFlags := flag.NewFlagSet("delete", flag.ExitOnError)
 	id := deleteFlags.Int("id", 0, "Item ID to delete")
 	force := deleteFlags.Bool("force", false, "Force deletion")
-	
+
 	deleteFlags.Parse(args)
-	
+
 	if *id == 0 {
 		fmt.Println("Error: --id is required")
 		deleteFlags.PrintDefaults()
 		return
 	}
-	
+
 	if *force {
 		fmt.Printf("Force deleted item with ID: %d\n", *id)
 	} else {
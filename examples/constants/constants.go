@@ -1,6 +1,10 @@
-package main
+package constants
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
 
 // Constants declared at package level
 const PI = 3.14159
@@ -14,6 +18,8 @@ const (
 	STATUS_PENDING  = "pending"
 )
 
+// readme:begin
+
 // Enumerated constants using iota
 const (
 	RED = iota
@@ -25,6 +31,8 @@ const (
 	VIOLET
 )
 
+// readme:end
+
 // Typed constants
 const (
 	SECONDS_IN_MINUTE int = 60
@@ -32,7 +40,17 @@ const (
 	HOURS_IN_DAY      int = 24
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "constants",
+		Category:    examples.Beginner,
+		Description: "Constants and Enumerations",
+		Icon:        "🔢",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	// Local constants
 	const TAX_RATE = 0.08
 	const MIN_AGE = 18
@@ -43,7 +61,7 @@ func main() {
 	fmt.Printf("Max users: %d\n", MAX_USERS)
 	fmt.Printf("Status values: %s, %s, %s\n", STATUS_ACTIVE, STATUS_INACTIVE, STATUS_PENDING)
 	fmt.Printf("Color values: %d, %d, %d, %d, %d, %d, %d\n", RED, ORANGE, YELLOW, GREEN, BLUE, INDIGO, VIOLET)
-	fmt.Printf("Time constants: %d seconds/minute, %d minutes/hour, %d hours/day\n", 
+	fmt.Printf("Time constants: %d seconds/minute, %d minutes/hour, %d hours/day\n",
 		SECONDS_IN_MINUTE, MINUTES_IN_HOUR, HOURS_IN_DAY)
 	fmt.Printf("Tax rate: %.2f, Minimum age: %d\n", TAX_RATE, MIN_AGE)
 
@@ -0,0 +1,186 @@
+package context
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "context",
+		Category:    examples.Practical,
+		Description: "Context",
+		Icon:        "🎯",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Context with Timeout", Run: contextWithTimeout},
+			{Number: 2, Title: "Context with Cancellation", Run: contextWithCancellation},
+			{Number: 3, Title: "Context with Value", Run: contextWithValue},
+			{Number: 4, Title: "Context with Deadline", Run: contextWithDeadline},
+			{Number: 5, Title: "Context Propagation", Run: contextPropagation},
+			{Number: 6, Title: "Context in HTTP Simulation", Run: contextInHTTPSimulation},
+			{Number: 7, Title: "Context Error Types", Run: contextErrorTypes},
+		},
+	})
+}
+
+func Run() {
+	fmt.Println("=== Context ===")
+
+	contextWithTimeout()
+	contextWithCancellation()
+	contextWithValue()
+	contextWithDeadline()
+	contextPropagation()
+	contextInHTTPSimulation()
+	contextErrorTypes()
+}
+
+// Basic context with timeout
+func contextWithTimeout() {
+	fmt.Println("--- Context with Timeout ---")
+	ctx, cancel := clock.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	select {
+	case <-clock.After(3 * time.Second):
+		fmt.Println("Operation completed (this won't print)")
+	case <-ctx.Done():
+		fmt.Printf("Operation cancelled: %v\n", ctx.Err())
+	}
+}
+
+// Context with cancellation
+func contextWithCancellation() {
+	fmt.Println("\n--- Context with Cancellation ---")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		clock.Sleep(1 * time.Second)
+		fmt.Println("Cancelling context...")
+		cancel()
+	}()
+
+	select {
+	case <-clock.After(2 * time.Second):
+		fmt.Println("Operation completed")
+	case <-ctx.Done():
+		fmt.Printf("Operation cancelled: %v\n", ctx.Err())
+	}
+}
+
+// Context with value
+func contextWithValue() {
+	fmt.Println("\n--- Context with Value ---")
+	ctx := context.WithValue(context.Background(), "userID", 12345)
+	ctx = context.WithValue(ctx, "role", "admin")
+
+	userID := ctx.Value("userID")
+	role := ctx.Value("role")
+
+	fmt.Printf("User ID: %v\n", userID)
+	fmt.Printf("Role: %v\n", role)
+}
+
+// Context with deadline
+func contextWithDeadline() {
+	fmt.Println("\n--- Context with Deadline ---")
+	deadline := clock.Now().Add(3 * time.Second)
+	ctx, cancel := clock.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	fmt.Printf("Deadline: %v\n", deadline)
+	if d, ok := ctx.Deadline(); ok {
+		fmt.Printf("Time until deadline: %v\n", clock.Until(d))
+	}
+
+	select {
+	case <-clock.After(1 * time.Second):
+		fmt.Println("Operation completed before deadline")
+	case <-ctx.Done():
+		fmt.Printf("Operation cancelled: %v\n", ctx.Err())
+	}
+}
+
+// Context propagation
+func contextPropagation() {
+	fmt.Println("\n--- Context Propagation ---")
+	parentCtx, parentCancel := clock.WithTimeout(context.Background(), 2*time.Second)
+	defer parentCancel()
+
+	// Create child context
+	childCtx, childCancel := context.WithCancel(parentCtx)
+	defer childCancel()
+
+	// Simulate work
+	go func() {
+		select {
+		case <-clock.After(1 * time.Second):
+			fmt.Println("Child operation completed")
+		case <-childCtx.Done():
+			fmt.Printf("Child cancelled: %v\n", childCtx.Err())
+		}
+	}()
+
+	select {
+	case <-clock.After(3 * time.Second):
+		fmt.Println("Parent operation completed")
+	case <-parentCtx.Done():
+		fmt.Printf("Parent cancelled: %v\n", parentCtx.Err())
+	}
+}
+
+// Context in HTTP requests (simulation)
+func contextInHTTPSimulation() {
+	fmt.Println("\n--- Context in HTTP Simulation ---")
+	// readme:begin
+	requestCtx := context.WithValue(context.Background(), "requestID", "req-123")
+	requestCtx, cancelRequest := clock.WithTimeout(requestCtx, 1*time.Second)
+	// readme:end
+	defer cancelRequest()
+
+	// Simulate HTTP handler
+	handleRequest := func(ctx context.Context) {
+		requestID := ctx.Value("requestID")
+		fmt.Printf("Handling request %s\n", requestID)
+
+		select {
+		case <-clock.After(500 * time.Millisecond):
+			fmt.Printf("Request %s completed\n", requestID)
+		case <-ctx.Done():
+			fmt.Printf("Request %s cancelled: %v\n", requestID, ctx.Err())
+		}
+	}
+
+	handleRequest(requestCtx)
+}
+
+// Context error types
+func contextErrorTypes() {
+	fmt.Println("\n--- Context Error Types ---")
+	testContextError := func(err error) {
+		switch err {
+		case context.Canceled:
+			fmt.Printf("Error: Context was cancelled\n")
+		case context.DeadlineExceeded:
+			fmt.Printf("Error: Context deadline exceeded\n")
+		default:
+			fmt.Printf("Error: %v\n", err)
+		}
+	}
+
+	// Test cancelled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	testContextError(ctx.Err())
+
+	// Test deadline exceeded context
+	ctx, cancel = clock.WithTimeout(context.Background(), 1*time.Nanosecond)
+	defer cancel()
+	clock.Sleep(1 * time.Millisecond)
+	testContextError(ctx.Err())
+}
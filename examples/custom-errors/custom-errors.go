@@ -1,14 +1,17 @@
-package main
+package customerrors
 
 import (
 	"errors"
 	"fmt"
-	"net"
 	"runtime"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 // 1. Basic custom error type
+// readme:begin
+
 type AppError struct {
 	Code    int
 	Message string
@@ -19,6 +22,8 @@ func (ae *AppError) Error() string {
 	return fmt.Sprintf("App Error %d: %s - %s", ae.Code, ae.Message, ae.Details)
 }
 
+// readme:end
+
 func (ae *AppError) Is(target error) bool {
 	if targetApp, ok := target.(*AppError); ok {
 		return ae.Code == targetApp.Code
@@ -54,22 +59,27 @@ func (ve *ValidationError) Error() string {
 }
 
 func (ve *ValidationError) Details() string {
-	return fmt.Sprintf("Field: %s, Value: %v, Rule: %s, Message: %s", 
+	return fmt.Sprintf("Field: %s, Value: %v, Rule: %s, Message: %s",
 		ve.Field, ve.Value, ve.Rule, ve.Message)
 }
 
 // 4. Network error with retry information
 type NetworkError struct {
-	Operation string
-	URL       string
+	Operation  string
+	URL        string
 	StatusCode int
-	Retryable bool
+	Retryable  bool
 	RetryCount int
-	Cause     error
+	Cause      error
 }
 
 func (ne *NetworkError) Error() string {
-	return fmt.Sprintf("network error during %s to %s: status %d", 
+	// No status means no response, so the cause says more
+	if ne.StatusCode == 0 && ne.Cause != nil {
+		return fmt.Sprintf("network error during %s to %s: %v",
+			ne.Operation, ne.URL, ne.Cause)
+	}
+	return fmt.Sprintf("network error during %s to %s: status %d",
 		ne.Operation, ne.URL, ne.StatusCode)
 }
 
@@ -136,22 +146,22 @@ const (
 	SeverityCritical
 )
 
-type SeverityError struct {
+type LeveledError struct {
 	Severity Severity
 	Message  string
 	Cause    error
 	Context  map[string]interface{}
 }
 
-func (se *SeverityError) Error() string {
+func (se *LeveledError) Error() string {
 	return fmt.Sprintf("[%s] %s", se.SeverityString(), se.Message)
 }
 
-func (se *SeverityError) Unwrap() error {
+func (se *LeveledError) Unwrap() error {
 	return se.Cause
 }
 
-func (se *SeverityError) SeverityString() string {
+func (se *LeveledError) SeverityString() string {
 	switch se.Severity {
 	case SeverityInfo:
 		return "INFO"
@@ -169,9 +179,9 @@ func (se *SeverityError) SeverityString() string {
 // 8. Error with user-friendly messages
 type UserError struct {
 	TechnicalMessage string
-	UserMessage     string
-	ErrorCode       string
-	Cause           error
+	UserMessage      string
+	ErrorCode        string
+	Cause            error
 }
 
 func (ue *UserError) Error() string {
@@ -191,9 +201,9 @@ func (ue *UserError) GetErrorCode() string {
 
 // 9. Error with recovery suggestions
 type RecoverableError struct {
-	Message      string
+	Message     string
 	Suggestions []string
-	Cause        error
+	Cause       error
 }
 
 func (re *RecoverableError) Error() string {
@@ -243,7 +253,7 @@ type RetryableError struct {
 }
 
 func (re *RetryableError) Error() string {
-	return fmt.Sprintf("operation '%s' failed on attempt %d: %v", 
+	return fmt.Sprintf("operation '%s' failed on attempt %d: %v",
 		re.Operation, re.Attempt, re.LastError)
 }
 
@@ -252,7 +262,7 @@ func (re *RetryableError) ShouldRetry() bool {
 }
 
 func (re *RetryableError) NextDelay() time.Duration {
-	delay := time.Duration(float64(re.RetryPolicy.InitialDelay) * 
+	delay := time.Duration(float64(re.RetryPolicy.InitialDelay) *
 		pow(re.RetryPolicy.BackoffFactor, float64(re.Attempt)))
 	if delay > re.RetryPolicy.MaxDelay {
 		return re.RetryPolicy.MaxDelay
@@ -317,7 +327,7 @@ func (ea *ErrorAggregator) Error() string {
 	if len(ea.errors) == 0 {
 		return "no errors"
 	}
-	
+
 	result := fmt.Sprintf("%d error(s) occurred:\n", len(ea.errors))
 	for i, err := range ea.errors {
 		result += fmt.Sprintf("  %d: %v\n", i+1, err)
@@ -332,7 +342,7 @@ func (ea *ErrorAggregator) GetErrors() []error {
 // Example functions that create custom errors
 func validateUserInput(name, email string) error {
 	var aggregator ErrorAggregator
-	
+
 	if name == "" {
 		aggregator.Add(&ValidationError{
 			Field:   "name",
@@ -341,7 +351,7 @@ func validateUserInput(name, email string) error {
 			Message: "name cannot be empty",
 		})
 	}
-	
+
 	if len(name) < 2 {
 		aggregator.Add(&ValidationError{
 			Field:   "name",
@@ -350,7 +360,7 @@ func validateUserInput(name, email string) error {
 			Message: "name must be at least 2 characters",
 		})
 	}
-	
+
 	if email == "" {
 		aggregator.Add(&ValidationError{
 			Field:   "email",
@@ -359,7 +369,7 @@ func validateUserInput(name, email string) error {
 			Message: "email cannot be empty",
 		})
 	}
-	
+
 	if !contains(email, "@") {
 		aggregator.Add(&ValidationError{
 			Field:   "email",
@@ -368,11 +378,11 @@ func validateUserInput(name, email string) error {
 			Message: "email must contain @ symbol",
 		})
 	}
-	
+
 	if aggregator.HasErrors() {
 		return &aggregator
 	}
-	
+
 	return nil
 }
 
@@ -386,30 +396,30 @@ func processPayment(amount float64, cardNumber string) error {
 			UserMessage: "Payment amount must be positive",
 		}
 	}
-	
+
 	if len(cardNumber) != 16 {
 		return &UserError{
 			TechnicalMessage: "invalid card number length",
-			UserMessage:     "Please enter a valid 16-digit card number",
-			ErrorCode:       "INVALID_CARD",
+			UserMessage:      "Please enter a valid 16-digit card number",
+			ErrorCode:        "INVALID_CARD",
 		}
 	}
-	
+
 	// Simulate network error
 	return &NetworkError{
-		Operation:   "payment_charge",
-		URL:         "https://api.payment.com/charge",
-		StatusCode:  503,
-		Retryable:   true,
-		RetryCount:  0,
-		Cause:       errors.New("service unavailable"),
+		Operation:  "payment_charge",
+		URL:        "https://api.payment.com/charge",
+		StatusCode: 503,
+		Retryable:  true,
+		RetryCount: 0,
+		Cause:      errors.New("service unavailable"),
 	}
 }
 
 func connectToDatabase() error {
 	// Simulate connection error
 	baseErr := errors.New("connection timeout")
-	
+
 	return &ContextError{
 		Message: "failed to connect to database",
 		Context: map[string]interface{}{
@@ -422,19 +432,64 @@ func connectToDatabase() error {
 	}
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "custom-errors",
+		Category:    examples.Advanced,
+		Description: "Custom Error Types",
+		Icon:        "🚨",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic custom error", Run: basicCustomError},
+			{Number: 2, Title: "Timestamped error", Run: timestampedError},
+			{Number: 3, Title: "Validation error", Run: validationError},
+			{Number: 4, Title: "Network error", Run: networkError},
+			{Number: 5, Title: "Business error", Run: businessError},
+			{Number: 6, Title: "Error with stack trace", Run: errorWithStackTrace},
+			{Number: 7, Title: "Severity error", Run: severityError},
+			{Number: 8, Title: "User error", Run: userError},
+			{Number: 9, Title: "Recoverable error", Run: recoverableError},
+			{Number: 10, Title: "Metadata error", Run: metadataError},
+			{Number: 11, Title: "Retryable error", Run: retryableError},
+			{Number: 12, Title: "Context error", Run: contextError},
+			{Number: 13, Title: "Error aggregator", Run: errorAggregator},
+			{Number: 14, Title: "Real-world examples", Run: realWorldExamples},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Custom Errors Examples ===")
 
-	// 1. Basic custom error
+	basicCustomError()
+	timestampedError()
+	validationError()
+	networkError()
+	businessError()
+	errorWithStackTrace()
+	severityError()
+	userError()
+	recoverableError()
+	metadataError()
+	retryableError()
+	contextError()
+	errorAggregator()
+	realWorldExamples()
+}
+
+// 1. Basic custom error
+func basicCustomError() {
 	fmt.Println("\n1. Basic custom error:")
-	err := &AppError{
+	var err error = &AppError{
 		Code:    1001,
 		Message: "User not found",
 		Details: "User ID 123 does not exist in the system",
 	}
 	fmt.Printf("Error: %v\n", err)
+}
 
-	// 2. Timestamped error
+// 2. Timestamped error
+func timestampedError() {
 	fmt.Println("\n2. Timestamped error:")
 	baseErr := errors.New("file not found")
 	timestampedErr := &TimestampedError{
@@ -444,8 +499,10 @@ func main() {
 	}
 	fmt.Printf("Error: %v\n", timestampedErr)
 	fmt.Printf("Unwrapped: %v\n", errors.Unwrap(timestampedErr))
+}
 
-	// 3. Validation error
+// 3. Validation error
+func validationError() {
 	fmt.Println("\n3. Validation error:")
 	validationErr := &ValidationError{
 		Field:   "email",
@@ -455,8 +512,10 @@ func main() {
 	}
 	fmt.Printf("Error: %v\n", validationErr)
 	fmt.Printf("Details: %s\n", validationErr.Details())
+}
 
-	// 4. Network error
+// 4. Network error
+func networkError() {
 	fmt.Println("\n4. Network error:")
 	netErr := &NetworkError{
 		Operation:  "GET",
@@ -468,21 +527,25 @@ func main() {
 	}
 	fmt.Printf("Error: %v\n", netErr)
 	fmt.Printf("Should retry: %t\n", netErr.ShouldRetry())
+}
 
-	// 5. Business error
+// 5. Business error
+func businessError() {
 	fmt.Println("\n5. Business error:")
 	bizErr := &BusinessError{
 		BusinessRule: "insufficient_balance",
 		Context: map[string]interface{}{
-			"required": 100.0,
+			"required":  100.0,
 			"available": 50.0,
 		},
 		UserMessage: "Insufficient balance for this transaction",
 	}
 	fmt.Printf("Error: %v\n", bizErr)
 	fmt.Printf("User message: %s\n", bizErr.GetUserMessage())
+}
 
-	// 6. Error with stack trace
+// 6. Error with stack trace
+func errorWithStackTrace() {
 	fmt.Println("\n6. Error with stack trace:")
 	stackErr := &StackTraceError{
 		Message: "critical system error",
@@ -490,13 +553,15 @@ func main() {
 	}
 	stackErr.StackTrace = make([]uintptr, 10)
 	runtime.Callers(1, stackErr.StackTrace)
-	
+
 	fmt.Printf("Error: %v\n", stackErr)
 	fmt.Printf("Stack trace:\n%s", stackErr.StackTraceString())
+}
 
-	// 7. Severity error
+// 7. Severity error
+func severityError() {
 	fmt.Println("\n7. Severity error:")
-	sevErr := &SeverityError{
+	sevErr := &LeveledError{
 		Severity: SeverityError,
 		Message:  "Database connection lost",
 		Cause:    errors.New("connection timeout"),
@@ -507,20 +572,24 @@ func main() {
 	}
 	fmt.Printf("Error: %v\n", sevErr)
 	fmt.Printf("Severity: %s\n", sevErr.SeverityString())
+}
 
-	// 8. User error
+// 8. User error
+func userError() {
 	fmt.Println("\n8. User error:")
 	userErr := &UserError{
 		TechnicalMessage: "password hash verification failed",
-		UserMessage:     "Invalid username or password",
-		ErrorCode:       "AUTH_FAILED",
-		Cause:           errors.New("hash mismatch"),
+		UserMessage:      "Invalid username or password",
+		ErrorCode:        "AUTH_FAILED",
+		Cause:            errors.New("hash mismatch"),
 	}
 	fmt.Printf("Technical: %v\n", userErr.Error())
 	fmt.Printf("User: %s\n", userErr.GetUserMessage())
 	fmt.Printf("Code: %s\n", userErr.GetErrorCode())
+}
 
-	// 9. Recoverable error
+// 9. Recoverable error
+func recoverableError() {
 	fmt.Println("\n9. Recoverable error:")
 	recErr := &RecoverableError{
 		Message: "File upload failed",
@@ -536,8 +605,10 @@ func main() {
 	for i, suggestion := range recErr.GetSuggestions() {
 		fmt.Printf("  %d. %s\n", i+1, suggestion)
 	}
+}
 
-	// 10. Metadata error
+// 10. Metadata error
+func metadataError() {
 	fmt.Println("\n10. Metadata error:")
 	metaErr := &MetadataError{
 		BaseError: errors.New("operation failed"),
@@ -552,8 +623,10 @@ func main() {
 	if userID, exists := metaErr.GetMetadata("user_id"); exists {
 		fmt.Printf("User ID: %v\n", userID)
 	}
+}
 
-	// 11. Retryable error
+// 11. Retryable error
+func retryableError() {
 	fmt.Println("\n11. Retryable error:")
 	retryErr := &RetryableError{
 		Operation: "send_email",
@@ -569,8 +642,10 @@ func main() {
 	fmt.Printf("Error: %v\n", retryErr)
 	fmt.Printf("Should retry: %t\n", retryErr.ShouldRetry())
 	fmt.Printf("Next delay: %v\n", retryErr.NextDelay())
+}
 
-	// 12. Context error
+// 12. Context error
+func contextError() {
 	fmt.Println("\n12. Context error:")
 	ctxErr := &ContextError{
 		Message: "API request failed",
@@ -579,26 +654,30 @@ func main() {
 	ctxErr.AddContext("endpoint", "/api/users")
 	ctxErr.AddContext("method", "GET")
 	ctxErr.AddContext("user_id", 12345)
-	
+
 	fmt.Printf("Error: %v\n", ctxErr)
 	if endpoint, exists := ctxErr.GetContext("endpoint"); exists {
 		fmt.Printf("Endpoint: %v\n", endpoint)
 	}
+}
 
-	// 13. Error aggregator
+// 13. Error aggregator
+func errorAggregator() {
 	fmt.Println("\n13. Error aggregator:")
 	aggregator := &ErrorAggregator{}
 	aggregator.Add(errors.New("first error"))
 	aggregator.Add(errors.New("second error"))
 	aggregator.Add(errors.New("third error"))
-	
+
 	if aggregator.HasErrors() {
 		fmt.Printf("Aggregated error:\n%s", aggregator.Error())
 	}
+}
 
-	// 14. Real-world examples
+// 14. Real-world examples
+func realWorldExamples() {
 	fmt.Println("\n14. Real-world validation:")
-	err = validateUserInput("", "invalid-email")
+	err := validateUserInput("", "invalid-email")
 	if err != nil {
 		fmt.Printf("Validation failed:\n%s", err.Error())
 	}
@@ -609,12 +688,12 @@ func main() {
 		var bizErr *BusinessError
 		var userErr *UserError
 		var netErr *NetworkError
-		
+
 		switch {
 		case errors.As(err, &bizErr):
 			fmt.Printf("Business error: %s\n", bizErr.GetUserMessage())
 		case errors.As(err, &userErr):
-			fmt.Printf("User error: %s (Code: %s)\n", 
+			fmt.Printf("User error: %s (Code: %s)\n",
 				userErr.GetUserMessage(), userErr.GetErrorCode())
 		case errors.As(err, &netErr):
 			fmt.Printf("Network error: %v\n", netErr)
@@ -640,11 +719,11 @@ func main() {
 }
 
 func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || 
-		(len(s) > len(substr) && 
-			(s[:len(substr)] == substr || 
-			 s[len(s)-len(substr):] == substr ||
-			 findSubstring(s, substr))))
+	return len(s) >= len(substr) && (s == substr ||
+		(len(s) > len(substr) &&
+			(s[:len(substr)] == substr ||
+				s[len(s)-len(substr):] == substr ||
+				findSubstring(s, substr))))
 }
 
 func findSubstring(s, substr string) bool {
@@ -1,28 +1,67 @@
-package main
+package defers
 
 import (
 	"fmt"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "defer",
+		Category:    examples.Expert,
+		Description: "Defer Statements",
+		Icon:        "⏪",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic defer", Run: basicDefer},
+			{Number: 2, Title: "Multiple defers (LIFO order)", Run: multipleDefers},
+			{Number: 3, Title: "Defer with file operations", Run: deferWithFileOperations},
+			{Number: 4, Title: "Defer with function return", Run: deferWithFunctionReturn},
+			{Number: 5, Title: "Defer with panic recovery", Run: deferWithPanicRecovery},
+			{Number: 6, Title: "Defer with resource cleanup", Run: deferWithResourceCleanup},
+			{Number: 7, Title: "Defer with named return values", Run: deferWithNamedReturnValues},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Defer Examples ===")
 
-	// 1. Basic defer
+	basicDefer()
+	multipleDefers()
+	deferWithFileOperations()
+	deferWithFunctionReturn()
+	deferWithPanicRecovery()
+	deferWithResourceCleanup()
+	deferWithNamedReturnValues()
+
+	fmt.Println("All defer examples completed!")
+}
+
+// 1. Basic defer
+func basicDefer() {
 	fmt.Println("\n1. Basic defer:")
 	fmt.Println("Start")
 	defer fmt.Println("End")
 	fmt.Println("Middle")
+}
 
-	// 2. Multiple defers (LIFO order)
+// 2. Multiple defers (LIFO order)
+func multipleDefers() {
 	fmt.Println("\n2. Multiple defers (LIFO):")
 	fmt.Println("Start")
+	// readme:begin
 	defer fmt.Println("First defer")
 	defer fmt.Println("Second defer")
 	defer fmt.Println("Third defer")
+	// readme:end
 	fmt.Println("Middle")
+}
 
-	// 3. Defer with file operations
+// 3. Defer with file operations
+func deferWithFileOperations() {
 	fmt.Println("\n3. Defer with file operations:")
 	createFile := func() {
 		file, err := os.Create("test.txt")
@@ -31,25 +70,29 @@ func main() {
 			return
 		}
 		defer file.Close()
-		
+
 		fmt.Println("File created")
 		file.WriteString("Hello, defer!")
 		fmt.Println("Data written")
 	}
-	
+
 	createFile()
+}
 
-	// 4. Defer with function return
+// 4. Defer with function return
+func deferWithFunctionReturn() {
 	fmt.Println("\n4. Defer with function return:")
 	deferExample := func() string {
 		defer fmt.Println("Deferred in function")
 		return "Function result"
 	}
-	
+
 	result := deferExample()
 	fmt.Printf("Result: %s\n", result)
+}
 
-	// 5. Defer with panic recovery
+// 5. Defer with panic recovery
+func deferWithPanicRecovery() {
 	fmt.Println("\n5. Defer with panic recovery:")
 	deferWithPanic := func() {
 		defer func() {
@@ -57,43 +100,45 @@ func main() {
 				fmt.Printf("Recovered from panic: %v\n", r)
 			}
 		}()
-		
+
 		fmt.Println("About to panic")
 		// panic("Test panic")
 		fmt.Println("Panic commented out")
 	}
-	
+
 	deferWithPanic()
+}
 
-	// 6. Defer with resource cleanup
+// 6. Defer with resource cleanup
+func deferWithResourceCleanup() {
 	fmt.Println("\n6. Defer with resource cleanup:")
 	resourceManager := func() {
 		fmt.Println("Acquiring resource 1")
 		defer fmt.Println("Releasing resource 1")
-		
+
 		fmt.Println("Acquiring resource 2")
 		defer fmt.Println("Releasing resource 2")
-		
+
 		fmt.Println("Doing work with resources")
 	}
-	
+
 	resourceManager()
+}
 
-	// 7. Defer with named return values
+// 7. Defer with named return values
+func deferWithNamedReturnValues() {
 	fmt.Println("\n7. Defer with named return values:")
 	deferWithNamedReturn := func() (result int) {
 		defer func() {
 			result *= 2
 			fmt.Printf("Deferred doubled result to: %d\n", result)
 		}()
-		
+
 		result = 5
 		fmt.Printf("Initial result: %d\n", result)
 		return
 	}
-	
+
 	finalResult := deferWithNamedReturn()
 	fmt.Printf("Final result: %d\n", finalResult)
-
-	fmt.Println("All defer examples completed!")
 }
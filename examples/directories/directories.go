@@ -1,11 +1,23 @@
-package main
+package directories
 
 import (
 	"fmt"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "directories",
+		Category:    examples.Practical,
+		Description: "Directory Operations",
+		Icon:        "📁",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Directories ===")
 
 	// Create directory
@@ -45,8 +57,10 @@ func main() {
 	}
 
 	// Create directory with files
+	// readme:begin
 	os.Mkdir("withfiles", 0755)
 	os.WriteFile("withfiles/file1.txt", []byte("content1"), 0644)
+	// readme:end
 	os.WriteFile("withfiles/file2.txt", []byte("content2"), 0644)
 
 	// List files in directory
@@ -1,18 +1,34 @@
-package main
+package embeddirective
 
 import (
 	"embed"
 	"fmt"
 	"io/fs"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
+// readme:begin
+
 //go:embed hello.txt
 var helloFile string
 
 //go:embed files/*.txt
 var textFiles embed.FS
 
-func main() {
+// readme:end
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "embed-directive",
+		Category:    examples.Practical,
+		Description: "Embed Directive",
+		Icon:        "📎",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Embed Directive ===")
 
 	// Embed single file as string
@@ -20,7 +36,7 @@ func main() {
 
 	// Read embedded file system
 	fmt.Println("\nReading from embedded FS:")
-	
+
 	// List embedded files
 	entries, err := textFiles.ReadDir("files")
 	if err != nil {
@@ -54,7 +70,7 @@ func main() {
 	})
 
 	// Check if file exists in embedded FS
-	if _, err := textFiles.Stat("files/test.txt"); err == nil {
+	if _, err := fs.Stat(textFiles, "files/test.txt"); err == nil {
 		fmt.Println("files/test.txt exists in embedded FS")
 	}
 
@@ -62,7 +78,7 @@ func main() {
 	file, err := textFiles.Open("files/test.txt")
 	if err == nil {
 		defer file.Close()
-		
+
 		buffer := make([]byte, 100)
 		n, err := file.Read(buffer)
 		if err == nil {
@@ -1,12 +1,24 @@
-package main
+package environmentvariables
 
 import (
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "environment-variables",
+		Category:    examples.Practical,
+		Description: "Environment Variables",
+		Icon:        "🌍",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Environment Variables ===")
 
 	// Get specific environment variable
@@ -23,8 +35,10 @@ func main() {
 	}
 
 	// Set environment variable
+	// readme:begin
 	os.Setenv("MY_APP_VAR", "Hello from Go")
 	fmt.Printf("\nSet MY_APP_VAR: %s\n", os.Getenv("MY_APP_VAR"))
+	// readme:end
 
 	// Check if variable exists
 	if value, exists := os.LookupEnv("NON_EXISTENT"); exists {
@@ -62,7 +76,7 @@ func main() {
 
 	// Common environment variables
 	commonVars := []string{
-		"HOME", "USER", "PATH", "SHELL", "TERM", 
+		"HOME", "USER", "PATH", "SHELL", "TERM",
 		"LANG", "PWD", "GOPATH", "GOROOT",
 	}
 
@@ -79,28 +93,28 @@ func main() {
 	fmt.Println("\nUsing environment variables for configuration:")
 	dbHost := os.Getenv("DB_HOST")
 	dbPort := os.Getenv("DB_PORT")
-	
+
 	if dbHost == "" {
 		dbHost = "localhost"
 	}
 	if dbPort == "" {
 		dbPort = "5432"
 	}
-	
+
 	fmt.Printf("Database: %s:%s\n", dbHost, dbPort)
 
 	// Environment variable validation
 	fmt.Println("\nEnvironment variable validation:")
 	requiredVars := []string{"HOME", "PATH"}
 	allSet := true
-	
+
 	for _, varName := range requiredVars {
 		if os.Getenv(varName) == "" {
 			fmt.Printf("Error: Required variable %s is not set\n", varName)
 			allSet = false
 		}
 	}
-	
+
 	if allSet {
 		fmt.Println("All required environment variables are set")
 	}
@@ -1,18 +1,33 @@
-package main
+package epoch
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "epoch",
+		Category:    examples.Practical,
+		Description: "Epoch Time",
+		Icon:        "🕐",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Epoch Time ===")
 
 	// Current epoch time (seconds since Jan 1, 1970)
-	now := time.Now()
+	// readme:begin
+	now := clock.Now()
 	epochSeconds := now.Unix()
 	epochMillis := now.UnixMilli()
 	epochNanos := now.UnixNano()
+	// readme:end
 
 	fmt.Printf("Current epoch (seconds): %d\n", epochSeconds)
 	fmt.Printf("Current epoch (milliseconds): %d\n", epochMillis)
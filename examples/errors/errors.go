@@ -1,16 +1,62 @@
-package main
+package errors
 
 import (
 	"errors"
 	"fmt"
-	"io"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "errors",
+		Category:    examples.Advanced,
+		Description: "Error Handling",
+		Icon:        "❌",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic error creation and handling", Run: basicErrorCreationAndHandling},
+			{Number: 2, Title: "Using errors.New", Run: usingErrorsNew},
+			{Number: 3, Title: "Using fmt.Errorf", Run: usingFmtErrorf},
+			{Number: 4, Title: "Error wrapping (Go 1.13+)", Run: errorWrapping},
+			{Number: 5, Title: "Error checking with errors.Is", Run: errorCheckingWithErrorsIs},
+			{Number: 6, Title: "Error type checking with errors.As", Run: errorTypeCheckingWithErrorsAs},
+			{Number: 7, Title: "Multiple error handling", Run: multipleErrorHandling},
+			{Number: 8, Title: "Error with additional context", Run: errorWithAdditionalContext},
+			{Number: 9, Title: "Sentinel errors", Run: sentinelErrors},
+			{Number: 10, Title: "Custom error types", Run: customErrorTypes},
+			{Number: 11, Title: "Error handling in functions", Run: errorHandlingInFunctions},
+			{Number: 12, Title: "Panic and recover", Run: panicAndRecover},
+			{Number: 13, Title: "Error handling with defer", Run: errorHandlingWithDefer},
+			{Number: 14, Title: "Error aggregation", Run: errorAggregation},
+			{Number: 15, Title: "Error handling best practices", Run: errorHandlingBestPractices},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Errors Examples ===")
 
-	// 1. Basic error creation and handling
+	basicErrorCreationAndHandling()
+	usingErrorsNew()
+	usingFmtErrorf()
+	errorWrapping()
+	errorCheckingWithErrorsIs()
+	errorTypeCheckingWithErrorsAs()
+	multipleErrorHandling()
+	errorWithAdditionalContext()
+	sentinelErrors()
+	customErrorTypes()
+	errorHandlingInFunctions()
+	panicAndRecover()
+	errorHandlingWithDefer()
+	errorAggregation()
+	errorHandlingBestPractices()
+}
+
+// 1. Basic error creation and handling
+func basicErrorCreationAndHandling() {
 	fmt.Println("\n1. Basic error handling:")
 	result, err := divide(10, 2)
 	if err != nil {
@@ -18,58 +64,70 @@ func main() {
 	} else {
 		fmt.Printf("Result: %.2f\n", result)
 	}
-	
+
 	result, err = divide(10, 0)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	} else {
 		fmt.Printf("Result: %.2f\n", result)
 	}
+}
 
-	// 2. Using errors.New
+// 2. Using errors.New
+func usingErrorsNew() {
 	fmt.Println("\n2. Using errors.New:")
-	err = errors.New("something went wrong")
+	err := errors.New("something went wrong")
 	fmt.Printf("Custom error: %v\n", err)
 	fmt.Printf("Error type: %T\n", err)
+}
 
-	// 3. Using fmt.Errorf
+// 3. Using fmt.Errorf
+func usingFmtErrorf() {
 	fmt.Println("\n3. Using fmt.Errorf:")
 	name := "Alice"
 	age := -5
 	if age < 0 {
-		err = fmt.Errorf("invalid age %d for user %s", age, name)
+		err := fmt.Errorf("invalid age %d for user %s", age, name)
 		fmt.Printf("Formatted error: %v\n", err)
 	}
+}
 
-	// 4. Error wrapping (Go 1.13+)
+// 4. Error wrapping (Go 1.13+)
+func errorWrapping() {
 	fmt.Println("\n4. Error wrapping:")
 	baseErr := errors.New("database connection failed")
 	wrappedErr := fmt.Errorf("failed to save user: %w", baseErr)
-	
+
 	fmt.Printf("Wrapped error: %v\n", wrappedErr)
 	fmt.Printf("Unwrapped error: %v\n", errors.Unwrap(wrappedErr))
 	fmt.Printf("Is database error: %t\n", errors.Is(wrappedErr, baseErr))
+}
 
-	// 5. Error checking with errors.Is
+// 5. Error checking with errors.Is
+func errorCheckingWithErrorsIs() {
 	fmt.Println("\n5. Error checking with errors.Is:")
-	err = processUser("admin")
+	err := processUser("admin")
 	if errors.Is(err, ErrUserNotFound) {
 		fmt.Printf("User not found: %v\n", err)
 	} else if errors.Is(err, ErrPermissionDenied) {
 		fmt.Printf("Permission denied: %v\n", err)
 	}
+}
 
-	// 6. Error type checking with errors.As
+// 6. Error type checking with errors.As
+func errorTypeCheckingWithErrorsAs() {
 	fmt.Println("\n6. Error type checking with errors.As:")
-	err = readFile("nonexistent.txt")
-	
+	err := readFile("nonexistent.txt")
+
 	var pathError *os.PathError
 	if errors.As(err, &pathError) {
-		fmt.Printf("Path error: Op=%s, Path=%s, Err=%v\n", 
+		fmt.Printf("Path error: Op=%s, Path=%s, Err=%v\n",
 			pathError.Op, pathError.Path, pathError.Err)
 	}
+}
 
-	// 7. Multiple error handling
+// 7. Multiple error handling
+func multipleErrorHandling() {
 	fmt.Println("\n7. Multiple error handling:")
 	errs := validateUser("bob@example.com", 15)
 	if len(errs) > 0 {
@@ -78,68 +136,78 @@ func main() {
 			fmt.Printf("  %d: %v\n", i+1, validationErr)
 		}
 	}
+}
 
-	// 8. Error with additional context
+// 8. Error with additional context
+func errorWithAdditionalContext() {
 	fmt.Println("\n8. Error with context:")
-	err = processOrder("order123")
+	err := processOrder("order123")
 	if err != nil {
 		fmt.Printf("Order processing failed: %v\n", err)
-		
+
 		// Get error chain
 		fmt.Println("Error chain:")
 		for err := err; err != nil; err = errors.Unwrap(err) {
 			fmt.Printf("  - %v\n", err)
 		}
 	}
+}
 
-	// 9. Sentinel errors
+// 9. Sentinel errors
+func sentinelErrors() {
 	fmt.Println("\n9. Sentinel errors:")
-	err = checkPermission("guest", "admin")
+	err := checkPermission("guest", "admin")
 	if errors.Is(err, ErrPermissionDenied) {
 		fmt.Printf("Access denied: %v\n", err)
 	}
+}
 
-	// 10. Custom error types
+// 10. Custom error types
+func customErrorTypes() {
 	fmt.Println("\n10. Custom error types:")
-	err = &ValidationError{
+	err := &ValidationError{
 		Field:   "email",
 		Value:   "invalid-email",
 		Message: "invalid email format",
 	}
-	
+
 	var validationErr *ValidationError
 	if errors.As(err, &validationErr) {
 		fmt.Printf("Validation error: %s\n", validationErr.Error())
 		fmt.Printf("Field: %s, Value: %s\n", validationErr.Field, validationErr.Value)
 	}
+}
 
-	// 11. Error handling in functions
+// 11. Error handling in functions
+func errorHandlingInFunctions() {
 	fmt.Println("\n11. Error handling patterns:")
-	
+
 	// Pattern 1: Return error immediately
-	err = saveToDatabase("user123")
+	err := saveToDatabase("user123")
 	if err != nil {
 		fmt.Printf("Database save failed: %v\n", err)
 		return
 	}
-	
+
 	// Pattern 2: Collect multiple errors
-	errs = []error{}
+	errs := []error{}
 	err1 := validateEmail("invalid")
 	err2 := validateAge(-5)
-	
+
 	if err1 != nil {
 		errs = append(errs, err1)
 	}
 	if err2 != nil {
 		errs = append(errs, err2)
 	}
-	
+
 	if len(errs) > 0 {
 		fmt.Printf("Multiple validation errors: %v\n", errs)
 	}
+}
 
-	// 12. Panic and recover
+// 12. Panic and recover
+func panicAndRecover() {
 	fmt.Println("\n12. Panic and recover:")
 	func() {
 		defer func() {
@@ -147,41 +215,50 @@ func main() {
 				fmt.Printf("Recovered from panic: %v\n", r)
 			}
 		}()
-		
+
 		// This will panic
 		causePanic()
 	}()
+}
 
-	// 13. Error handling with defer
+// 13. Error handling with defer
+func errorHandlingWithDefer() {
 	fmt.Println("\n13. Error handling with defer:")
-	err = processFile("test.txt")
+	err := processFile("test.txt")
 	if err != nil {
 		fmt.Printf("File processing error: %v\n", err)
 	}
+}
 
-	// 14. Error aggregation
+// 14. Error aggregation
+func errorAggregation() {
 	fmt.Println("\n14. Error aggregation:")
-	errs = []error{
+	errs := []error{
 		errors.New("first error"),
 		errors.New("second error"),
 		errors.New("third error"),
 	}
-	
+
 	combined := errors.Join(errs...)
 	fmt.Printf("Combined error: %v\n", combined)
-	
+
 	// Check if combined error contains specific error
 	if errors.Is(combined, errors.New("second error")) {
 		fmt.Println("Combined error contains 'second error'")
 	}
+}
 
-	// 15. Error handling best practices
+// 15. Error handling best practices
+func errorHandlingBestPractices() {
 	fmt.Println("\n15. Error handling best practices:")
-	
+
 	// Good: Provide context
-	err = fmt.Errorf("failed to process payment for order %s: %w", "ORD123", baseErr)
+	// readme:begin
+	baseErr := errors.New("database connection failed")
+	err := fmt.Errorf("failed to process payment for order %s: %w", "ORD123", baseErr)
 	fmt.Printf("Good error with context: %v\n", err)
-	
+	// readme:end
+
 	// Bad: Generic error (for demonstration)
 	// err = errors.New("something failed")
 	// fmt.Printf("Bad generic error: %v\n", err)
@@ -206,15 +283,15 @@ func processUser(username string) error {
 		"admin": true,
 		"user":  true,
 	}
-	
+
 	if !users[username] {
 		return ErrUserNotFound
 	}
-	
+
 	if username == "admin" {
 		return nil
 	}
-	
+
 	return ErrPermissionDenied
 }
 
@@ -225,19 +302,19 @@ func readFile(filename string) error {
 
 func validateUser(email string, age int) []error {
 	var errs []error
-	
+
 	if email == "" {
 		errs = append(errs, errors.New("email is required"))
 	} else if !contains(email, "@") {
 		errs = append(errs, errors.New("invalid email format"))
 	}
-	
+
 	if age < 0 {
 		errs = append(errs, errors.New("age cannot be negative"))
 	} else if age < 18 {
 		errs = append(errs, errors.New("user must be at least 18 years old"))
 	}
-	
+
 	return errs
 }
 
@@ -246,7 +323,7 @@ func processOrder(orderID string) error {
 	if orderID == "" {
 		return errors.New("order ID is required")
 	}
-	
+
 	// Simulate database error
 	dbErr := errors.New("database connection failed")
 	return fmt.Errorf("failed to process order %s: %w", orderID, dbErr)
@@ -258,18 +335,18 @@ func checkPermission(role, resource string) error {
 		"user":  {"read", "write"},
 		"guest": {"read"},
 	}
-	
+
 	userPerms, exists := permissions[role]
 	if !exists {
 		return ErrPermissionDenied
 	}
-	
+
 	for _, perm := range userPerms {
 		if perm == resource {
 			return nil
 		}
 	}
-	
+
 	return ErrPermissionDenied
 }
 
@@ -323,18 +400,18 @@ func processFile(filename string) error {
 			fmt.Printf("Warning: failed to close file: %v\n", closeErr)
 		}
 	}()
-	
+
 	// Process file content
 	fmt.Printf("File %s processed successfully\n", filename)
 	return nil
 }
 
 func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || 
-		(len(s) > len(substr) && 
-			(s[:len(substr)] == substr || 
-			 s[len(s)-len(substr):] == substr ||
-			 findSubstring(s, substr))))
+	return len(s) >= len(substr) && (s == substr ||
+		(len(s) > len(substr) &&
+			(s[:len(substr)] == substr ||
+				s[len(s)-len(substr):] == substr ||
+				findSubstring(s, substr))))
 }
 
 func findSubstring(s, substr string) bool {
@@ -0,0 +1,240 @@
+// Package examples holds the registry of runnable Go by Example programs.
+//
+// Every example lives in its own package under this directory and registers
+// itself from an init function, the same way database/sql drivers do. The
+// gobyexample command imports all of them and looks them up by name.
+package examples
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Category groups examples the same way the README learning path does.
+type Category int
+
+const (
+	Beginner Category = iota
+	Intermediate
+	Advanced
+	Expert
+	Practical
+)
+
+// Categories lists every category in learning order.
+var Categories = []Category{Beginner, Intermediate, Advanced, Expert, Practical}
+
+func (c Category) String() string {
+	switch c {
+	case Beginner:
+		return "Beginner"
+	case Intermediate:
+		return "Intermediate"
+	case Advanced:
+		return "Advanced"
+	case Expert:
+		return "Expert"
+	case Practical:
+		return "Practical"
+	default:
+		return fmt.Sprintf("Category(%d)", int(c))
+	}
+}
+
+// Example describes a single runnable example.
+type Example struct {
+	Name        string // file name without .go, e.g. "worker-pools"
+	Category    Category
+	Description string
+	Icon        string // emoji shown next to the example in README.md
+	Run         func()
+	Sections    []Section // numbered sections that can run on their own
+}
+
+// Section is one numbered part of an example, such as
+// "12. Worker pool with circuit breaker" in worker-pools.
+type Section struct {
+	Number int
+	Title  string
+	Run    func()
+}
+
+func (s Section) String() string {
+	return fmt.Sprintf("%d. %s", s.Number, s.Title)
+}
+
+// Section finds a section by number ("12") or by a case-insensitive
+// substring of its title ("circuit breaker"). An exact title match wins
+// over partial ones.
+func (e Example) Section(selector string) (Section, error) {
+	if len(e.Sections) == 0 {
+		return Section{}, fmt.Errorf("%s has no sections", e.Name)
+	}
+
+	if n, err := strconv.Atoi(selector); err == nil {
+		for _, s := range e.Sections {
+			if s.Number == n {
+				return s, nil
+			}
+		}
+		return Section{}, fmt.Errorf("%s has no section %d", e.Name, n)
+	}
+
+	var matches []Section
+	needle := strings.ToLower(selector)
+	for _, s := range e.Sections {
+		if strings.EqualFold(s.Title, selector) {
+			return s, nil
+		}
+		if strings.Contains(strings.ToLower(s.Title), needle) {
+			matches = append(matches, s)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return Section{}, fmt.Errorf("%s has no section matching %q", e.Name, selector)
+	case 1:
+		return matches[0], nil
+	default:
+		titles := make([]string, len(matches))
+		for i, s := range matches {
+			titles[i] = s.String()
+		}
+		return Section{}, fmt.Errorf("%q matches several sections of %s: %s",
+			selector, e.Name, strings.Join(titles, "; "))
+	}
+}
+
+// File returns the path of the example's main source file relative to this
+// package.
+func (e Example) File() string {
+	return e.Name + "/" + e.Name + ".go"
+}
+
+// Files returns the paths of every source file of the example relative to
+// this package, the main file first and the rest by name. Tests are left
+// out.
+func (e Example) Files() ([]string, error) {
+	entries, err := sources.ReadDir(e.Name)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{e.File()}
+	for _, entry := range entries {
+		name := e.Name + "/" + entry.Name()
+		if name != e.File() && !strings.HasSuffix(name, "_test.go") {
+			files = append(files, name)
+		}
+	}
+	return files, nil
+}
+
+// Source returns the embedded source code of the example. Each file starts
+// with a "// File: name/file.go" header, so examples split over several
+// files read as one.
+func (e Example) Source() (string, error) {
+	files, err := e.Files()
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for i, file := range files {
+		src, err := ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "// File: %s\n\n%s", file, src)
+	}
+	return sb.String(), nil
+}
+
+// ReadFile returns the embedded source file at path, as given by File or
+// Files.
+func ReadFile(path string) (string, error) {
+	data, err := sources.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+//go:embed */*.go
+var sources embed.FS
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]Example)
+)
+
+// Register makes an example available by name. It panics if the name is
+// empty, Run is nil, or the name was already registered.
+func Register(e Example) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if e.Name == "" {
+		panic("examples: Register called with empty name")
+	}
+	if e.Run == nil {
+		panic("examples: Register called with nil Run for " + e.Name)
+	}
+	if _, dup := registry[e.Name]; dup {
+		panic("examples: Register called twice for " + e.Name)
+	}
+	registry[e.Name] = e
+}
+
+// Lookup returns the example registered under name.
+func Lookup(name string) (Example, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	e, ok := registry[strings.TrimSuffix(name, ".go")]
+	return e, ok
+}
+
+// All returns every registered example ordered by category, then name.
+func All() []Example {
+	mu.RLock()
+	all := make([]Example, 0, len(registry))
+	for _, e := range registry {
+		all = append(all, e)
+	}
+	mu.RUnlock()
+
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Category != all[j].Category {
+			return all[i].Category < all[j].Category
+		}
+		return all[i].Name < all[j].Name
+	})
+	return all
+}
+
+// Search returns the examples whose name, description or source contain
+// keyword, ignoring case.
+func Search(keyword string) []Example {
+	keyword = strings.ToLower(keyword)
+
+	var matches []Example
+	for _, e := range All() {
+		if strings.Contains(strings.ToLower(e.Name), keyword) ||
+			strings.Contains(strings.ToLower(e.Description), keyword) {
+			matches = append(matches, e)
+			continue
+		}
+		if src, err := e.Source(); err == nil && strings.Contains(strings.ToLower(src), keyword) {
+			matches = append(matches, e)
+		}
+	}
+	return matches
+}
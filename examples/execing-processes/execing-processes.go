@@ -1,30 +1,70 @@
-package main
+package execingprocesses
 
 import (
 	"fmt"
 	"os"
 	"os/exec"
-	"syscall"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "execing-processes",
+		Category:    examples.Practical,
+		Description: "Exec'ing Processes",
+		Icon:        "🔄",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic Exec", Run: basicExec},
+			{Number: 2, Title: "Exec with Syscall", Run: execWithSyscall},
+			{Number: 3, Title: "Exec Look Path", Run: execLookPath},
+			{Number: 4, Title: "Simulating Exec Behavior", Run: simulatingExecBehavior},
+			{Number: 5, Title: "Safe Exec Wrapper", Run: safeExecWrapper},
+			{Number: 6, Title: "Exec with Custom Environment", Run: execWithCustomEnvironment},
+			{Number: 7, Title: "Common Exec Use Cases", Run: commonExecUseCases},
+			{Number: 8, Title: "Exec Error Handling", Run: execErrorHandling},
+			{Number: 9, Title: "Process Replacement Demo", Run: processReplacementDemo},
+			{Number: 10, Title: "Security Considerations", Run: securityConsiderations},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Exec'ing Processes ===")
 
 	// Note: exec examples are commented out to avoid terminating this program
 	// Uncomment to test actual exec behavior
 
+	basicExec()
+	execWithSyscall()
+	execLookPath()
+	simulatingExecBehavior()
+	safeExecWrapper()
+	execWithCustomEnvironment()
+	commonExecUseCases()
+	execErrorHandling()
+	processReplacementDemo()
+	securityConsiderations()
+}
+
+func basicExec() {
 	fmt.Println("--- Basic Exec ---")
 	fmt.Println("// exec.Command(\"echo\", \"This replaces current process\")")
 	fmt.Println("// cmd.Run() // This would replace the current process")
-	
+
 	// exec.Command("echo", "This replaces current process").Run()
+}
 
+func execWithSyscall() {
 	fmt.Println("\n--- Exec with Syscall ---")
 	fmt.Println("// syscall.Exec(\"/bin/echo\", []string{\"echo\", \"hello\"}, os.Environ())")
 	fmt.Println("// This completely replaces the current process")
-	
+
 	// syscall.Exec("/bin/echo", []string{"echo", "hello"}, os.Environ())
+}
 
+func execLookPath() {
 	fmt.Println("\n--- Exec Look Path ---")
 	path, err := exec.LookPath("echo")
 	if err != nil {
@@ -32,50 +72,60 @@ func main() {
 	} else {
 		fmt.Printf("echo found at: %s\n", path)
 	}
+}
 
-	// Simulate exec behavior
+// Simulate exec behavior
+func simulatingExecBehavior() {
 	fmt.Println("\n--- Simulating Exec Behavior ---")
 	fmt.Println("When exec is called:")
 	fmt.Println("1. Current process is completely replaced")
 	fmt.Println("2. New process inherits PID")
 	fmt.Println("3. No code after exec runs")
 	fmt.Println("4. File descriptors are inherited")
+}
 
-	// Safe exec wrapper
+// Safe exec wrapper
+func safeExecWrapper() {
 	fmt.Println("\n--- Safe Exec Wrapper ---")
 	safeExec := func(command string, args []string) error {
+		// readme:begin
 		path, err := exec.LookPath(command)
 		if err != nil {
 			return fmt.Errorf("command not found: %s", command)
 		}
-		
+		// readme:end
+
 		fmt.Printf("Would exec: %s %v\n", path, args)
 		fmt.Printf("Environment: %d variables\n", len(os.Environ()))
-		
+
 		// In real usage, this would be:
 		// return syscall.Exec(path, args, os.Environ())
-		
+
 		return nil
 	}
 
-	err = safeExec("echo", []string{"hello", "world"})
+	err := safeExec("echo", []string{"hello", "world"})
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	}
+}
 
-	// Exec with different environment
+// Exec with different environment
+func execWithCustomEnvironment() {
 	fmt.Println("\n--- Exec with Custom Environment ---")
 	customEnv := append(os.Environ(), "CUSTOM_VAR=value")
-	
+
 	fmt.Printf("Would exec with custom environment (%d vars)\n", len(customEnv))
 	// syscall.Exec("/bin/sh", []string{"sh", "-c", "echo $CUSTOM_VAR"}, customEnv)
+}
 
-	// Exec examples for different scenarios
+// Exec examples for different scenarios
+func commonExecUseCases() {
 	fmt.Println("\n--- Common Exec Use Cases ---")
-	
+
 	examples := []struct {
-		cmd        string
-		args       []string
+		cmd         string
+		args        []string
 		description string
 	}{
 		{"sh", []string{"sh", "-c", "ls -la"}, "Run shell command"},
@@ -87,23 +137,29 @@ func main() {
 	for _, ex := range examples {
 		fmt.Printf("  %s: %s %v\n", ex.description, ex.cmd, ex.args)
 	}
+}
 
-	// Error handling
+// Error handling
+func execErrorHandling() {
 	fmt.Println("\n--- Exec Error Handling ---")
 	fmt.Println("Common exec errors:")
 	fmt.Println("  ENOENT: Command not found")
 	fmt.Println("  EACCES: Permission denied")
 	fmt.Println("  EPERM: Operation not permitted")
 	fmt.Println("  ENOEXEC: Exec format error")
+}
 
-	// Process replacement demonstration
+// Process replacement demonstration
+func processReplacementDemo() {
 	fmt.Println("\n--- Process Replacement Demo ---")
 	fmt.Printf("Current PID: %d\n", os.Getpid())
 	fmt.Println("After exec, new process would have same PID")
 	fmt.Println("Current Go program would terminate")
 	fmt.Println("New program would start immediately")
+}
 
-	// Security considerations
+// Security considerations
+func securityConsiderations() {
 	fmt.Println("\n--- Security Considerations ---")
 	fmt.Println("1. Always validate command arguments")
 	fmt.Println("2. Use absolute paths when possible")
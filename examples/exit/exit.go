@@ -1,14 +1,50 @@
-package main
+package exit
 
 import (
 	"fmt"
-	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "exit",
+		Category:    examples.Practical,
+		Description: "Exit Handling",
+		Icon:        "🚪",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Exit Codes", Run: exitCodes},
+			{Number: 2, Title: "Exit with Success", Run: exitWithSuccess},
+			{Number: 3, Title: "Exit with Error", Run: exitWithError},
+			{Number: 4, Title: "Conditional Exit", Run: conditionalExit},
+			{Number: 5, Title: "Exit with Cleanup", Run: exitWithCleanup},
+			{Number: 6, Title: "Exit from Function", Run: exitFromFunction},
+			{Number: 7, Title: "Panic vs Exit", Run: panicVsExit},
+			{Number: 8, Title: "Exit Status Checking", Run: exitStatusChecking},
+			{Number: 9, Title: "Common Exit Patterns", Run: commonExitPatterns},
+			{Number: 10, Title: "Graceful Shutdown", Run: gracefulShutdown},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Exit ===")
 
-	// Different exit codes
+	exitCodes()
+	exitWithSuccess()
+	exitWithError()
+	conditionalExit()
+	exitWithCleanup()
+	exitFromFunction()
+	panicVsExit()
+	exitStatusChecking()
+	commonExitPatterns()
+	gracefulShutdown()
+}
+
+// Different exit codes
+func exitCodes() {
 	fmt.Println("--- Exit Codes ---")
 	fmt.Println("0: Success")
 	fmt.Println("1: General error")
@@ -18,18 +54,24 @@ func main() {
 	fmt.Println("128: Invalid exit argument")
 	fmt.Println("130: Script terminated by Control-C")
 	fmt.Println("255*: Exit status out of range")
+}
 
-	// Exit with success code
+// Exit with success code
+func exitWithSuccess() {
 	fmt.Println("\n--- Exit with Success ---")
 	fmt.Println("This will exit with code 0 (success)")
 	// os.Exit(0) // Commented out to allow program to continue
+}
 
-	// Exit with error code
+// Exit with error code
+func exitWithError() {
 	fmt.Println("\n--- Exit with Error ---")
 	fmt.Println("This would exit with code 1 (error)")
 	// os.Exit(1) // Commented out to allow program to continue
+}
 
-	// Conditional exit
+// Conditional exit
+func conditionalExit() {
 	fmt.Println("\n--- Conditional Exit ---")
 	shouldExit := false
 	if shouldExit {
@@ -38,32 +80,40 @@ func main() {
 	} else {
 		fmt.Println("Continuing execution")
 	}
+}
 
-	// Exit with cleanup
+// Exit with cleanup
+func exitWithCleanup() {
 	fmt.Println("\n--- Exit with Cleanup ---")
 	fmt.Println("Performing cleanup before exit...")
-	
+
 	// In a real application, you might:
 	// 1. Close database connections
 	// 2. Save state
 	// 3. Release resources
 	// 4. Log shutdown
-	
+
 	fmt.Println("Cleanup completed")
 	// os.Exit(0)
+}
 
-	// Exit from function
+// Exit from function
+func exitFromFunction() {
 	fmt.Println("\n--- Exit from Function ---")
+	// readme:begin
 	exitFromFunction := func(code int) {
 		fmt.Printf("Exiting with code %d from function\n", code)
 		// os.Exit(code)
 		fmt.Println("This line won't be reached after os.Exit")
 	}
-	
+	// readme:end
+
 	exitFromFunction(3)
 	fmt.Println("This also won't be reached")
+}
 
-	// Panic vs Exit
+// Panic vs Exit
+func panicVsExit() {
 	fmt.Println("\n--- Panic vs Exit ---")
 	fmt.Println("Panic:")
 	fmt.Println("  - Shows stack trace")
@@ -74,17 +124,21 @@ func main() {
 	fmt.Println("  - No stack trace")
 	fmt.Println("  - Cannot be recovered")
 	fmt.Println("  - Indicates controlled termination")
+}
 
-	// Exit status checking (shell simulation)
+// Exit status checking (shell simulation)
+func exitStatusChecking() {
 	fmt.Println("\n--- Exit Status Checking ---")
 	fmt.Println("In shell, you can check exit status:")
 	fmt.Println("  echo $?  # Shows last exit status")
 	fmt.Println("  command1 && command2  # Run command2 only if command1 succeeds")
 	fmt.Println("  command1 || command2  # Run command2 only if command1 fails")
+}
 
-	// Common exit patterns
+// Common exit patterns
+func commonExitPatterns() {
 	fmt.Println("\n--- Common Exit Patterns ---")
-	
+
 	patterns := []struct {
 		situation string
 		code      int
@@ -103,8 +157,10 @@ func main() {
 	for _, p := range patterns {
 		fmt.Printf("  %s: %d (%s)\n", p.situation, p.code, p.reason)
 	}
+}
 
-	// Graceful shutdown
+// Graceful shutdown
+func gracefulShutdown() {
 	fmt.Println("\n--- Graceful Shutdown ---")
 	fmt.Println("Best practices for graceful shutdown:")
 	fmt.Println("1. Handle signals (SIGINT, SIGTERM)")
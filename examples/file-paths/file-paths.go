@@ -1,13 +1,25 @@
-package main
+package filepaths
 
 import (
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "file-paths",
+		Category:    examples.Practical,
+		Description: "File Paths",
+		Icon:        "🛤️",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== File Paths ===")
 
 	// Current working directory
@@ -19,10 +31,12 @@ func main() {
 
 	// Path components
 	path := "/home/user/documents/file.txt"
+	// readme:begin
 	dir := filepath.Dir(path)
 	file := filepath.Base(path)
+	// readme:end
 	ext := filepath.Ext(path)
-	
+
 	fmt.Printf("Path: %s\n", path)
 	fmt.Printf("Directory: %s\n", dir)
 	fmt.Printf("File name: %s\n", file)
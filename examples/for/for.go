@@ -0,0 +1,122 @@
+package forloops
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "for",
+		Category:    examples.Beginner,
+		Description: "Loop Constructs",
+		Icon:        "🔄",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic for loop", Run: basicForLoop},
+			{Number: 2, Title: "For loop as while loop", Run: forAsWhileLoop},
+			{Number: 3, Title: "Infinite loop with break", Run: infiniteLoopWithBreak},
+			{Number: 4, Title: "For loop with continue", Run: forWithContinue},
+			{Number: 5, Title: "For range over slice", Run: rangeOverSlice},
+			{Number: 6, Title: "For range over map", Run: rangeOverMap},
+			{Number: 7, Title: "For range over string", Run: rangeOverString},
+			{Number: 8, Title: "Nested for loops", Run: nestedForLoops},
+		},
+	})
+}
+
+func Run() {
+	fmt.Println("=== For Loop Examples ===")
+
+	basicForLoop()
+	forAsWhileLoop()
+	infiniteLoopWithBreak()
+	forWithContinue()
+	rangeOverSlice()
+	rangeOverMap()
+	rangeOverString()
+	nestedForLoops()
+}
+
+// 1. Basic for loop
+func basicForLoop() {
+	fmt.Println("\n1. Basic for loop:")
+	for i := 0; i < 5; i++ {
+		fmt.Printf("Iteration %d\n", i)
+	}
+}
+
+// 2. For loop as while loop
+func forAsWhileLoop() {
+	fmt.Println("\n2. For loop as while loop:")
+	count := 0
+	for count < 3 {
+		fmt.Printf("Count: %d\n", count)
+		count++
+	}
+}
+
+// 3. Infinite loop with break
+func infiniteLoopWithBreak() {
+	fmt.Println("\n3. Infinite loop with break:")
+	num := 0
+	for {
+		if num >= 3 {
+			break
+		}
+		fmt.Printf("Number: %d\n", num)
+		num++
+	}
+}
+
+// 4. For loop with continue
+func forWithContinue() {
+	fmt.Println("\n4. For loop with continue:")
+	for i := 0; i < 10; i++ {
+		if i%2 == 0 {
+			continue // Skip even numbers
+		}
+		fmt.Printf("Odd number: %d\n", i)
+	}
+}
+
+// 5. For range over slice
+func rangeOverSlice() {
+	fmt.Println("\n5. For range over slice:")
+	fruits := []string{"apple", "banana", "orange"}
+	// readme:begin
+	for index, value := range fruits {
+		fmt.Printf("Index: %d, Value: %s\n", index, value)
+	}
+	// readme:end
+}
+
+// 6. For range over map
+func rangeOverMap() {
+	fmt.Println("\n6. For range over map:")
+	ages := map[string]int{"Alice": 25, "Bob": 30, "Charlie": 35}
+	for name, age := range ages {
+		fmt.Printf("%s is %d years old\n", name, age)
+	}
+}
+
+// 7. For range over string
+func rangeOverString() {
+	fmt.Println("\n7. For range over string:")
+	message := "Hello"
+	for index, char := range message {
+		fmt.Printf("Index: %d, Char: %c\n", index, char)
+	}
+}
+
+// 8. Nested for loops
+func nestedForLoops() {
+	fmt.Println("\n8. Nested for loops:")
+	for i := 1; i <= 3; i++ {
+		for j := 1; j <= 3; j++ {
+			fmt.Printf("%d x %d = %d\t", i, j, i*j)
+		}
+		fmt.Println()
+	}
+}
@@ -1,6 +1,10 @@
-package main
+package functions
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
 
 // Basic function without parameters
 func greet() {
@@ -22,6 +26,8 @@ func printDetails(name string, age int, city string) {
 	fmt.Printf("Name: %s, Age: %d, City: %s\n", name, age, city)
 }
 
+// readme:begin
+
 // Function with named return values
 func calculateRectangle(length, width float64) (area, perimeter float64) {
 	area = length * width
@@ -29,6 +35,8 @@ func calculateRectangle(length, width float64) (area, perimeter float64) {
 	return // Naked return - returns named variables
 }
 
+// readme:end
+
 // Function that performs operations
 func isEven(num int) bool {
 	return num%2 == 0
@@ -74,10 +82,10 @@ func factorial(n int) int {
 func performCalculations(x, y int) {
 	sum := add(x, y)
 	fmt.Printf("%d + %d = %d\n", x, y, sum)
-	
+
 	isEvenResult := isEven(sum)
 	fmt.Printf("Is %d even? %t\n", sum, isEvenResult)
-	
+
 	if x > 0 && y > 0 {
 		fact := factorial(sum)
 		fmt.Printf("Factorial of %d is %d\n", sum, fact)
@@ -89,7 +97,7 @@ func getGrade(score int) string {
 	if score < 0 || score > 100 {
 		return "Invalid score"
 	}
-	
+
 	switch {
 	case score >= 90:
 		return "A"
@@ -104,78 +112,143 @@ func getGrade(score int) string {
 	}
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "functions",
+		Category:    examples.Beginner,
+		Description: "Function Definitions",
+		Icon:        "⚙️",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic functions", Run: basicFunctions},
+			{Number: 2, Title: "Function with parameters and return", Run: parametersAndReturn},
+			{Number: 3, Title: "Function with multiple parameters", Run: multipleParameters},
+			{Number: 4, Title: "Function with named return values", Run: namedReturnValues},
+			{Number: 5, Title: "Boolean function", Run: booleanFunction},
+			{Number: 6, Title: "String manipulation", Run: stringManipulation},
+			{Number: 7, Title: "Function modifying slice", Run: modifyingSlice},
+			{Number: 8, Title: "Function with default-like behavior", Run: defaultLikeBehavior},
+			{Number: 9, Title: "Recursive function", Run: recursiveFunction},
+			{Number: 10, Title: "Function composition", Run: functionComposition},
+			{Number: 11, Title: "Function with early return", Run: earlyReturn},
+			{Number: 12, Title: "Function expressions", Run: functionExpressions},
+			{Number: 13, Title: "Higher-order function", Run: higherOrderFunction},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Functions Examples ===")
 
-	// Calling basic functions
+	basicFunctions()
+	parametersAndReturn()
+	multipleParameters()
+	namedReturnValues()
+	booleanFunction()
+	stringManipulation()
+	modifyingSlice()
+	defaultLikeBehavior()
+	recursiveFunction()
+	functionComposition()
+	earlyReturn()
+	functionExpressions()
+	higherOrderFunction()
+}
+
+// 1. Basic functions
+func basicFunctions() {
 	fmt.Println("\n1. Basic functions:")
 	greet()
 	greetPerson("Alice")
+}
 
-	// Function with parameters and return value
+// 2. Function with parameters and return
+func parametersAndReturn() {
 	fmt.Println("\n2. Function with parameters and return:")
 	result := add(5, 3)
 	fmt.Printf("5 + 3 = %d\n", result)
+}
 
-	// Function with multiple parameters
+// 3. Function with multiple parameters
+func multipleParameters() {
 	fmt.Println("\n3. Function with multiple parameters:")
 	printDetails("Bob", 25, "New York")
+}
 
-	// Function with named return values
+// 4. Function with named return values
+func namedReturnValues() {
 	fmt.Println("\n4. Function with named return values:")
 	area, perimeter := calculateRectangle(5.0, 3.0)
 	fmt.Printf("Rectangle 5x3: Area=%.1f, Perimeter=%.1f\n", area, perimeter)
+}
 
-	// Boolean function
+// 5. Boolean function
+func booleanFunction() {
 	fmt.Println("\n5. Boolean function:")
 	fmt.Printf("Is 4 even? %t\n", isEven(4))
 	fmt.Printf("Is 7 even? %t\n", isEven(7))
+}
 
-	// String manipulation function
+// 6. String manipulation
+func stringManipulation() {
 	fmt.Println("\n6. String manipulation:")
 	original := "Hello"
 	reversed := reverseString(original)
 	fmt.Printf("Original: %s, Reversed: %s\n", original, reversed)
+}
 
-	// Function that modifies slice
+// 7. Function modifying slice
+func modifyingSlice() {
 	fmt.Println("\n7. Function modifying slice:")
 	numbers := []int{1, 2, 3, 4, 5}
 	fmt.Printf("Original: %v\n", numbers)
 	doubled := doubleNumbers(numbers)
 	fmt.Printf("Doubled: %v\n", doubled)
+}
 
-	// Function with default-like behavior
+// 8. Function with default-like behavior
+func defaultLikeBehavior() {
 	fmt.Println("\n8. Function with default-like behavior:")
 	user1 := createUser("John", 25, "john@example.com")
 	user2 := createUser("Jane", 0, "") // Using defaults
 	fmt.Printf("%s\n", user1)
 	fmt.Printf("%s\n", user2)
+}
 
-	// Recursive function
+// 9. Recursive function
+func recursiveFunction() {
 	fmt.Println("\n9. Recursive function:")
 	fmt.Printf("Factorial of 5: %d\n", factorial(5))
 	fmt.Printf("Factorial of 6: %d\n", factorial(6))
+}
 
-	// Function that calls other functions
+// 10. Function composition
+func functionComposition() {
 	fmt.Println("\n10. Function composition:")
 	performCalculations(4, 3)
+}
 
-	// Function with early return
+// 11. Function with early return
+func earlyReturn() {
 	fmt.Println("\n11. Function with early return:")
 	fmt.Printf("Score 95: Grade %s\n", getGrade(95))
 	fmt.Printf("Score 75: Grade %s\n", getGrade(75))
 	fmt.Printf("Score -5: Grade %s\n", getGrade(-5))
+}
 
-	// Function expressions (anonymous functions)
+// 12. Function expressions
+func functionExpressions() {
 	fmt.Println("\n12. Function expressions:")
 	add := func(a, b int) int {
 		return a + b
 	}
 	fmt.Printf("Anonymous function result: %d\n", add(10, 20))
+}
 
-	// Higher-order function (function as parameter)
+// 13. Higher-order function
+func higherOrderFunction() {
 	fmt.Println("\n13. Higher-order function:")
-	numbers = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
 	evenNumbers := filter(numbers, isEven)
 	fmt.Printf("Even numbers: %v\n", evenNumbers)
 }
@@ -1,6 +1,11 @@
-package main
+package generics
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
 
 // 1. Basic generic function
 func Print[T any](value T) {
@@ -13,6 +18,8 @@ func Pair[T, U any](first T, second U) {
 }
 
 // 3. Generic function with type constraint
+// readme:begin
+
 type Number interface {
 	int | int64 | float32 | float64
 }
@@ -21,6 +28,8 @@ func Add[T Number](a, b T) T {
 	return a + b
 }
 
+// readme:end
+
 func Max[T Number](a, b T) T {
 	if a > b {
 		return a
@@ -63,7 +72,7 @@ func (s *Stack[T]) Pop() (T, error) {
 	if len(s.items) == 0 {
 		return zero, fmt.Errorf("stack is empty")
 	}
-	
+
 	index := len(s.items) - 1
 	item := s.items[index]
 	s.items = s.items[:index]
@@ -184,7 +193,7 @@ type LinkedList[T any] struct {
 
 func (ll *LinkedList[T]) Append(value T) {
 	newNode := &Node[T]{Value: value}
-	
+
 	if ll.Head == nil {
 		ll.Head = newNode
 	} else {
@@ -249,152 +258,219 @@ func (p Product) String() string {
 	return fmt.Sprintf("%s: $%.2f", p.Name, p.Price)
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "generics",
+		Category:    examples.Advanced,
+		Description: "Generic Programming",
+		Icon:        "🔧",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic generic function", Run: basicGenericFunction},
+			{Number: 2, Title: "Generic function with multiple type parameters", Run: genericFunctionWithMultipleTypeParameters},
+			{Number: 3, Title: "Generic function with type constraint", Run: genericFunctionWithTypeConstraint},
+			{Number: 4, Title: "Generic struct", Run: genericStruct},
+			{Number: 5, Title: "Generic struct with comparable constraint", Run: genericStructWithComparableConstraint},
+			{Number: 6, Title: "Generic function with Stringer constraint", Run: genericFunctionWithStringerConstraint},
+			{Number: 7, Title: "Generic struct with multiple type parameters", Run: genericStructWithMultipleTypeParameters},
+			{Number: 8, Title: "Generic dictionary", Run: genericDictionary},
+			{Number: 9, Title: "Generic slice operations", Run: genericSliceOperations},
+			{Number: 10, Title: "Generic linked list", Run: genericLinkedList},
+			{Number: 11, Title: "Generic constraint with underlying types", Run: genericConstraintWithUnderlyingTypes},
+			{Number: 12, Title: "Generic function with pointer", Run: genericFunctionWithPointer},
+			{Number: 13, Title: "Complex generic operations", Run: complexGenericOperations},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Generics Examples ===")
 
-	// 1. Basic generic function
+	basicGenericFunction()
+	genericFunctionWithMultipleTypeParameters()
+	genericFunctionWithTypeConstraint()
+	genericStruct()
+	genericStructWithComparableConstraint()
+	genericFunctionWithStringerConstraint()
+	genericStructWithMultipleTypeParameters()
+	genericDictionary()
+	genericSliceOperations()
+	genericLinkedList()
+	genericConstraintWithUnderlyingTypes()
+	genericFunctionWithPointer()
+	complexGenericOperations()
+}
+
+// 1. Basic generic function
+func basicGenericFunction() {
 	fmt.Println("\n1. Basic generic function:")
 	Print(42)
 	Print("Hello, Generics!")
 	Print(3.14)
 	Print(Person{Name: "Alice", Age: 30})
+}
 
-	// 2. Generic function with multiple type parameters
+// 2. Generic function with multiple type parameters
+func genericFunctionWithMultipleTypeParameters() {
 	fmt.Println("\n2. Multiple type parameters:")
 	Pair("Name", "Alice")
 	Pair(100, 200)
 	Pair("Age", 25)
+}
 
-	// 3. Generic function with type constraint
+// 3. Generic function with type constraint
+func genericFunctionWithTypeConstraint() {
 	fmt.Println("\n3. Type constraint functions:")
 	fmt.Printf("Add(10, 20) = %d\n", Add(10, 20))
 	fmt.Printf("Add(3.14, 2.86) = %.2f\n", Add(3.14, 2.86))
 	fmt.Printf("Max(15, 25) = %d\n", Max(15, 25))
 	fmt.Printf("Max(3.5, 2.8) = %.1f\n", Max(3.5, 2.8))
+}
 
-	// 4. Generic struct
+// 4. Generic struct
+func genericStruct() {
 	fmt.Println("\n4. Generic struct:")
 	intContainer := Container[int]{}
 	intContainer.Add(10)
 	intContainer.Add(20)
 	intContainer.Add(30)
-	
+
 	fmt.Printf("Int container size: %d\n", intContainer.Size())
 	if value, err := intContainer.Get(1); err == nil {
 		fmt.Printf("Value at index 1: %d\n", value)
 	}
-	
+
 	stringContainer := Container[string]{}
 	stringContainer.Add("Hello")
 	stringContainer.Add("World")
-	
+
 	fmt.Printf("String container size: %d\n", stringContainer.Size())
 	if value, err := stringContainer.Get(0); err == nil {
 		fmt.Printf("Value at index 0: %s\n", value)
 	}
+}
 
-	// 5. Generic struct with comparable constraint
+// 5. Generic struct with comparable constraint
+func genericStructWithComparableConstraint() {
 	fmt.Println("\n5. Stack with comparable constraint:")
 	intStack := Stack[int]{}
 	intStack.Push(10)
 	intStack.Push(20)
 	intStack.Push(30)
-	
+
 	fmt.Printf("Stack contains 20: %t\n", intStack.Contains(20))
-	
+
 	if item, err := intStack.Pop(); err == nil {
 		fmt.Printf("Popped: %d\n", item)
 	}
-	
+
 	if item, err := intStack.Pop(); err == nil {
 		fmt.Printf("Popped: %d\n", item)
 	}
+}
 
-	// 6. Generic function with Stringer constraint
+// 6. Generic function with Stringer constraint
+func genericFunctionWithStringerConstraint() {
 	fmt.Println("\n6. Stringer constraint:")
 	PrintString(Person{Name: "Bob", Age: 25})
 	PrintString(Product{Name: "Laptop", Price: 999.99})
+}
 
-	// 7. Generic struct with multiple type parameters
+// 7. Generic struct with multiple type parameters
+func genericStructWithMultipleTypeParameters() {
 	fmt.Println("\n7. KeyValuePair:")
 	kvp1 := KeyValuePair[string, int]{Key: "age", Value: 25}
 	kvp2 := KeyValuePair[int, string]{Key: 1, Value: "first"}
-	
+
 	fmt.Printf("KVP1: %s\n", kvp1.String())
 	fmt.Printf("KVP2: %s\n", kvp2.String())
+}
 
-	// 8. Generic dictionary
+// 8. Generic dictionary
+func genericDictionary() {
 	fmt.Println("\n8. Generic Dictionary:")
 	stringDict := NewDictionary[string, int]()
 	stringDict.Set("apple", 5)
 	stringDict.Set("banana", 3)
 	stringDict.Set("orange", 8)
-	
+
 	if value, exists := stringDict.Get("apple"); exists {
 		fmt.Printf("Apple count: %d\n", value)
 	}
-	
-	fmt.Printf("All keys: %v\n", stringDict.Keys())
-	
+
+	keys := stringDict.Keys()
+	slices.Sort(keys) // Map order is random
+	fmt.Printf("All keys: %v\n", keys)
+
 	intDict := NewDictionary[int, string]()
 	intDict.Set(1, "first")
 	intDict.Set(2, "second")
-	
+
 	if value, exists := intDict.Get(2); exists {
 		fmt.Printf("Value for key 2: %s\n", value)
 	}
+}
 
-	// 9. Generic slice operations
+// 9. Generic slice operations
+func genericSliceOperations() {
 	fmt.Println("\n9. Generic slice operations:")
 	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	
+
 	// Filter even numbers
 	even := Filter(numbers, func(n int) bool { return n%2 == 0 })
 	fmt.Printf("Even numbers: %v\n", even)
-	
+
 	// Map to squares
 	squares := Map(numbers, func(n int) int { return n * n })
 	fmt.Printf("Squares: %v\n", squares)
-	
+
 	// Reduce to sum
 	sum := Reduce(numbers, 0, func(acc, n int) int { return acc + n })
 	fmt.Printf("Sum: %d\n", sum)
+}
 
-	// 10. Generic linked list
+// 10. Generic linked list
+func genericLinkedList() {
 	fmt.Println("\n10. Generic linked list:")
 	stringList := LinkedList[string]{}
 	stringList.Append("Hello")
 	stringList.Append("World")
 	stringList.Append("Generics")
-	
+
 	fmt.Printf("String list: %v\n", stringList.ToSlice())
-	
+
 	intList := LinkedList[int]{}
 	intList.Append(10)
 	intList.Append(20)
 	intList.Append(30)
-	
+
 	fmt.Printf("Int list: %v\n", intList.ToSlice())
+}
 
-	// 11. Generic constraint with underlying types
+// 11. Generic constraint with underlying types
+func genericConstraintWithUnderlyingTypes() {
 	fmt.Println("\n11. Integer constraint:")
-	var int8Values []int8 = {1, 2, 3, 4, 5}
-	var uintValues []uint = {10, 20, 30}
-	
+	int8Values := []int8{1, 2, 3, 4, 5}
+	uintValues := []uint{10, 20, 30}
+
 	fmt.Printf("Sum of int8 values: %d\n", Sum(int8Values))
 	fmt.Printf("Sum of uint values: %d\n", Sum(uintValues))
+}
 
-	// 12. Generic function with pointer
+// 12. Generic function with pointer
+func genericFunctionWithPointer() {
 	fmt.Println("\n12. Generic pointer function:")
 	x := 42
 	y := "Hello"
-	
+
 	fmt.Printf("Before: x = %d, y = %s\n", x, y)
 	UpdateValue(&x, 100)
 	UpdateValue(&y, "World")
 	fmt.Printf("After: x = %d, y = %s\n", x, y)
+}
 
-	// 13. Complex generic operations
+// 13. Complex generic operations
+func complexGenericOperations() {
 	fmt.Println("\n13. Complex operations:")
 	people := []Person{
 		{Name: "Alice", Age: 30},
@@ -402,15 +478,15 @@ func main() {
 		{Name: "Charlie", Age: 35},
 		{Name: "Diana", Age: 28},
 	}
-	
+
 	// Filter people older than 28
 	olderPeople := Filter(people, func(p Person) bool { return p.Age > 28 })
 	fmt.Printf("People older than 28: %v\n", olderPeople)
-	
+
 	// Map to names
 	names := Map(people, func(p Person) string { return p.Name })
 	fmt.Printf("All names: %v\n", names)
-	
+
 	// Find oldest person
 	oldest := Reduce(people, people[0], func(older, current Person) Person {
 		if current.Age > older.Age {
@@ -1,4 +1,4 @@
-package main
+package goroutines
 
 import (
 	"fmt"
@@ -6,61 +6,119 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "goroutines",
+		Category:    examples.Advanced,
+		Description: "Concurrent Programming",
+		Icon:        "🚀",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic goroutine", Run: basicGoroutine},
+			{Number: 2, Title: "Anonymous function goroutine", Run: anonymousFunctionGoroutine},
+			{Number: 3, Title: "Goroutine with parameters", Run: goroutineWithParameters},
+			{Number: 4, Title: "Using WaitGroup for synchronization", Run: usingWaitGroupForSynchronization},
+			{Number: 5, Title: "Common pitfall: loop variable capture", Run: commonPitfallLoopVariableCapture},
+			{Number: 6, Title: "Goroutine with channels", Run: goroutineWithChannels},
+			{Number: 7, Title: "Multiple goroutines sending to one channel", Run: multipleGoroutinesSendingToOneChannel},
+			{Number: 8, Title: "Worker pool pattern", Run: workerPoolPattern},
+			{Number: 9, Title: "Atomic operations", Run: atomicOperations},
+			{Number: 10, Title: "Mutex for protecting shared state", Run: mutexForProtectingSharedState},
+			{Number: 11, Title: "Select statement with channels", Run: selectStatementWithChannels},
+			{Number: 12, Title: "Fan-out/Fan-in pattern", Run: fanOutFanInPattern},
+			{Number: 13, Title: "Goroutine leak prevention", Run: goroutineLeakPrevention},
+			{Number: 14, Title: "Using once for initialization", Run: usingOnceForInitialization},
+			{Number: 15, Title: "Goroutine for background tasks", Run: goroutineForBackgroundTasks},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Goroutines Examples ===")
 
-	// 1. Basic goroutine
+	basicGoroutine()
+	anonymousFunctionGoroutine()
+	goroutineWithParameters()
+	usingWaitGroupForSynchronization()
+	commonPitfallLoopVariableCapture()
+	goroutineWithChannels()
+	multipleGoroutinesSendingToOneChannel()
+	workerPoolPattern()
+	atomicOperations()
+	mutexForProtectingSharedState()
+	selectStatementWithChannels()
+	fanOutFanInPattern()
+	goroutineLeakPrevention()
+	usingOnceForInitialization()
+	goroutineForBackgroundTasks()
+
+	fmt.Println("All examples completed!")
+}
+
+// 1. Basic goroutine
+func basicGoroutine() {
 	fmt.Println("\n1. Basic goroutine:")
 	go sayHello("Goroutine 1")
 	go sayHello("Goroutine 2")
-	
+
 	// Wait a bit to see the output
-	time.Sleep(100 * time.Millisecond)
+	clock.Sleep(100 * time.Millisecond)
+}
 
-	// 2. Anonymous function goroutine
+// 2. Anonymous function goroutine
+func anonymousFunctionGoroutine() {
 	fmt.Println("\n2. Anonymous function goroutine:")
 	go func() {
 		fmt.Println("Anonymous goroutine running")
 	}()
-	
-	time.Sleep(50 * time.Millisecond)
 
-	// 3. Goroutine with parameters
+	clock.Sleep(50 * time.Millisecond)
+}
+
+// 3. Goroutine with parameters
+func goroutineWithParameters() {
 	fmt.Println("\n3. Goroutine with parameters:")
 	for i := 1; i <= 3; i++ {
 		go func(id int) {
 			fmt.Printf("Worker %d started\n", id)
-			time.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
+			clock.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
 			fmt.Printf("Worker %d finished\n", id)
 		}(i)
 	}
-	
-	time.Sleep(200 * time.Millisecond)
 
-	// 4. Using WaitGroup for synchronization
+	clock.Sleep(200 * time.Millisecond)
+}
+
+// 4. Using WaitGroup for synchronization
+func usingWaitGroupForSynchronization() {
 	fmt.Println("\n4. WaitGroup synchronization:")
 	var wg sync.WaitGroup
-	
+
 	for i := 1; i <= 5; i++ {
 		wg.Add(1)
 		go func(id int) {
 			defer wg.Done()
 			fmt.Printf("Task %d started\n", id)
-			time.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
+			clock.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
 			fmt.Printf("Task %d completed\n", id)
 		}(i)
 	}
-	
+
 	fmt.Println("Waiting for all tasks to complete...")
 	wg.Wait()
 	fmt.Println("All tasks completed")
+}
 
-	// 5. Common pitfall: loop variable capture
+// 5. Common pitfall: loop variable capture
+func commonPitfallLoopVariableCapture() {
 	fmt.Println("\n5. Loop variable capture (correct way):")
 	var wg2 sync.WaitGroup
-	
+
 	for i := 1; i <= 3; i++ {
 		wg2.Add(1)
 		go func(id int) {
@@ -68,25 +126,29 @@ func main() {
 			fmt.Printf("Correct capture: %d\n", id)
 		}(i) // Pass i as parameter
 	}
-	
+
 	wg2.Wait()
+}
 
-	// 6. Goroutine with channels
+// 6. Goroutine with channels
+func goroutineWithChannels() {
 	fmt.Println("\n6. Goroutine with channels:")
 	ch := make(chan string)
-	
+
 	go func() {
 		ch <- "Message from goroutine"
 	}()
-	
+
 	message := <-ch
 	fmt.Printf("Received: %s\n", message)
+}
 
-	// 7. Multiple goroutines sending to one channel
+// 7. Multiple goroutines sending to one channel
+func multipleGoroutinesSendingToOneChannel() {
 	fmt.Println("\n7. Multiple producers:")
 	ch2 := make(chan int)
 	var wg3 sync.WaitGroup
-	
+
 	// Producer goroutines
 	for i := 1; i <= 3; i++ {
 		wg3.Add(1)
@@ -99,53 +161,58 @@ func main() {
 			}
 		}(i)
 	}
-	
+
 	// Consumer goroutine
 	go func() {
 		wg3.Wait()
 		close(ch2)
 	}()
-	
+
 	// Receive values
 	for value := range ch2 {
 		fmt.Printf("Consumer received: %d\n", value)
 	}
+}
 
-	// 8. Worker pool pattern
+// 8. Worker pool pattern
+func workerPoolPattern() {
 	fmt.Println("\n8. Worker pool:")
 	jobs := make(chan int, 10)
 	results := make(chan int, 10)
-	
+
 	// Start workers
 	var wg4 sync.WaitGroup
 	for i := 1; i <= 3; i++ {
 		wg4.Add(1)
 		go worker(i, jobs, results, &wg4)
 	}
-	
+
 	// Send jobs
 	for j := 1; j <= 5; j++ {
 		jobs <- j
 		fmt.Printf("Sent job %d\n", j)
 	}
 	close(jobs)
-	
+
 	// Wait for workers to finish
 	go func() {
 		wg4.Wait()
 		close(results)
 	}()
-	
+
 	// Collect results
 	for result := range results {
 		fmt.Printf("Received result: %d\n", result)
 	}
+}
 
-	// 9. Atomic operations
+// 9. Atomic operations
+func atomicOperations() {
 	fmt.Println("\n9. Atomic operations:")
 	var counter int64
-	
+
 	// Start multiple goroutines that increment counter
+	// readme:begin
 	var wg5 sync.WaitGroup
 	for i := 0; i < 100; i++ {
 		wg5.Add(1)
@@ -154,17 +221,20 @@ func main() {
 			atomic.AddInt64(&counter, 1)
 		}()
 	}
-	
+	// readme:end
+
 	wg5.Wait()
 	fmt.Printf("Final counter value: %d\n", atomic.LoadInt64(&counter))
+}
 
-	// 10. Mutex for protecting shared state
+// 10. Mutex for protecting shared state
+func mutexForProtectingSharedState() {
 	fmt.Println("\n10. Mutex for shared state:")
 	var mu sync.Mutex
 	balance := 1000
-	
+
 	var wg6 sync.WaitGroup
-	
+
 	// Depositors
 	for i := 1; i <= 5; i++ {
 		wg6.Add(1)
@@ -176,7 +246,7 @@ func main() {
 			mu.Unlock()
 		}(100)
 	}
-	
+
 	// Withdrawers
 	for i := 1; i <= 3; i++ {
 		wg6.Add(1)
@@ -192,115 +262,123 @@ func main() {
 			mu.Unlock()
 		}(200)
 	}
-	
+
 	wg6.Wait()
 	fmt.Printf("Final balance: %d\n", balance)
+}
 
-	// 11. Select statement with channels
+// 11. Select statement with channels
+func selectStatementWithChannels() {
 	fmt.Println("\n11. Select statement:")
 	ch3 := make(chan string)
 	ch4 := make(chan string)
-	
+
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		ch3 <- "From channel 3"
 	}()
-	
+
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch4 <- "From channel 4"
 	}()
-	
+
 	select {
 	case msg1 := <-ch3:
 		fmt.Printf("Received from ch3: %s\n", msg1)
 	case msg2 := <-ch4:
 		fmt.Printf("Received from ch4: %s\n", msg2)
-	case <-time.After(200 * time.Millisecond):
+	case <-clock.After(200 * time.Millisecond):
 		fmt.Println("Timeout occurred")
 	}
+}
 
-	// 12. Fan-out/Fan-in pattern
+// 12. Fan-out/Fan-in pattern
+func fanOutFanInPattern() {
 	fmt.Println("\n12. Fan-out/Fan-in pattern:")
 	input := make(chan int)
-	
+
 	// Fan-out: distribute work to multiple workers
 	output1 := make(chan int)
 	output2 := make(chan int)
-	
+
 	go func() {
 		for i := 1; i <= 5; i++ {
 			input <- i
 		}
 		close(input)
 	}()
-	
+
 	go squareWorker(input, output1)
 	go squareWorker(input, output2)
-	
+
 	// Fan-in: collect results
 	go func() {
 		var wg7 sync.WaitGroup
 		wg7.Add(2)
-		
+
 		go func() {
 			defer wg7.Done()
 			for val := range output1 {
 				fmt.Printf("Worker 1 result: %d\n", val)
 			}
 		}()
-		
+
 		go func() {
 			defer wg7.Done()
 			for val := range output2 {
 				fmt.Printf("Worker 2 result: %d\n", val)
 			}
 		}()
-		
+
 		wg7.Wait()
 	}()
-	
-	time.Sleep(100 * time.Millisecond)
 
-	// 13. Goroutine leak prevention
+	clock.Sleep(100 * time.Millisecond)
+}
+
+// 13. Goroutine leak prevention
+func goroutineLeakPrevention() {
 	fmt.Println("\n13. Goroutine leak prevention:")
 	processWithTimeout := func() error {
 		ch := make(chan string)
-		
+
 		go func() {
-			time.Sleep(200 * time.Millisecond) // Simulate work
+			clock.Sleep(200 * time.Millisecond) // Simulate work
 			ch <- "result"
 		}()
-		
+
 		select {
 		case result := <-ch:
 			fmt.Printf("Work completed: %s\n", result)
 			return nil
-		case <-time.After(100 * time.Millisecond):
+		case <-clock.After(100 * time.Millisecond):
 			fmt.Println("Work timed out")
 			return fmt.Errorf("operation timed out")
 		}
 	}
-	
+
 	err := processWithTimeout()
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	}
+}
 
-	// 14. Using once for initialization
+// 14. Using once for initialization
+func usingOnceForInitialization() {
 	fmt.Println("\n14. Using sync.Once:")
 	var once sync.Once
 	var config map[string]string
-	
+
 	loadConfig := func() {
 		fmt.Println("Loading configuration...")
 		config = map[string]string{
 			"host": "localhost",
 			"port": "8080",
 		}
-		time.Sleep(50 * time.Millisecond) // Simulate loading time
+		clock.Sleep(50 * time.Millisecond) // Simulate loading time
 	}
-	
+
 	var wg8 sync.WaitGroup
 	for i := 0; i < 3; i++ {
 		wg8.Add(1)
@@ -310,19 +388,21 @@ func main() {
 			fmt.Printf("Goroutine %d: config loaded\n", id)
 		}(i)
 	}
-	
+
 	wg8.Wait()
 	fmt.Printf("Config: %v\n", config)
+}
 
-	// 15. Goroutine for background tasks
+// 15. Goroutine for background tasks
+func goroutineForBackgroundTasks() {
 	fmt.Println("\n15. Background task with goroutine:")
 	stop := make(chan bool)
-	
+
 	// Background task
 	go func() {
-		ticker := time.NewTicker(500 * time.Millisecond)
+		ticker := clock.NewTicker(500 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		for {
 			select {
 			case <-ticker.C:
@@ -333,15 +413,13 @@ func main() {
 			}
 		}
 	}()
-	
+
 	// Let it run for a while
-	time.Sleep(1600 * time.Millisecond)
-	
+	clock.Sleep(1600 * time.Millisecond)
+
 	// Stop the background task
 	stop <- true
-	time.Sleep(100 * time.Millisecond)
-
-	fmt.Println("All examples completed!")
+	clock.Sleep(100 * time.Millisecond)
 }
 
 // Helper functions
@@ -352,10 +430,10 @@ func sayHello(message string) {
 
 func worker(id int, jobs <-chan int, results chan<- int, wg *sync.WaitGroup) {
 	defer wg.Done()
-	
+
 	for job := range jobs {
 		fmt.Printf("Worker %d processing job %d\n", id, job)
-		time.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
+		clock.Sleep(time.Duration(rand.Intn(100)) * time.Millisecond)
 		result := job * 2
 		results <- result
 		fmt.Printf("Worker %d completed job %d with result %d\n", id, job, result)
@@ -0,0 +1,23 @@
+package hello
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "hello",
+		Category:    examples.Beginner,
+		Description: "Hello World",
+		Icon:        "👋",
+		Run:         Run,
+	})
+}
+
+func Run() {
+	// readme:begin
+	fmt.Println("Hello World!")
+	// readme:end
+}
@@ -0,0 +1,210 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+// Authenticator adds credentials to a request before it is sent.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// BasicAuth sends a username and password with every request.
+type BasicAuth struct {
+	Username, Password string
+}
+
+func (a BasicAuth) Authenticate(req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+// BearerToken sends a fixed token with every request.
+type BearerToken string
+
+func (t BearerToken) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(t))
+	return nil
+}
+
+// How long before a token expires ClientCredentials fetches the next one,
+// unless it sets its own RefreshBefore
+const defaultRefreshBefore = 30 * time.Second
+
+// ClientCredentials gets tokens from an OAuth2 token endpoint with the
+// client credentials grant (RFC 6749 section 4.4) and sends them as bearer
+// tokens. A token is reused until RefreshBefore its expiry, or half its
+// lifetime if that is shorter, so requests never go out with one that is
+// about to run out. Only one token request is made at a time however many
+// requests need one.
+type ClientCredentials struct {
+	TokenURL      string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	Client        *http.Client  // for the token endpoint; nil means http.DefaultClient
+	RefreshBefore time.Duration // 0 means defaultRefreshBefore
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time // zero if the token does not expire
+}
+
+// TokenError is an error response from a token endpoint (RFC 6749
+// section 5.2).
+type TokenError struct {
+	Status      int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *TokenError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("token request failed: %s (%s)", e.Code, e.Description)
+	}
+	return fmt.Sprintf("token request failed: %s (status %d)", e.Code, e.Status)
+}
+
+func (c *ClientCredentials) Authenticate(req *http.Request) error {
+	token, err := c.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns the current token, fetching a new one if there is none or
+// it is due for refresh.
+func (c *ClientCredentials) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && (c.refreshAt.IsZero() || clock.Now().Before(c.refreshAt)) {
+		return c.token, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(c.Scopes) > 0 {
+		form.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		tokenErr := &TokenError{Status: resp.StatusCode}
+		if json.Unmarshal(body, tokenErr) != nil || tokenErr.Code == "" {
+			tokenErr.Code = resp.Status
+		}
+		return "", tokenErr
+	}
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return "", fmt.Errorf("token response: %w", err)
+	}
+	if tok.AccessToken == "" || !strings.EqualFold(tok.TokenType, "bearer") {
+		return "", fmt.Errorf("token response has no bearer token")
+	}
+
+	c.token, c.refreshAt = tok.AccessToken, time.Time{}
+	if tok.ExpiresIn > 0 {
+		lifetime := time.Duration(tok.ExpiresIn) * time.Second
+		refreshBefore := c.RefreshBefore
+		if refreshBefore == 0 {
+			refreshBefore = defaultRefreshBefore
+		}
+		c.refreshAt = clock.Now().Add(lifetime - min(refreshBefore, lifetime/2))
+	}
+	return c.token, nil
+}
+
+// Invalidate drops the current token, for when the server has turned it
+// down before it was due to expire.
+func (c *ClientCredentials) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = ""
+}
+
+// AuthTransport is an http.RoundTripper that has Auth add credentials to
+// every request. If the server answers 401 Unauthorized and Auth has an
+// Invalidate method, as ClientCredentials does, the credentials are
+// dropped and the request sent once more with fresh ones.
+type AuthTransport struct {
+	Base http.RoundTripper // nil means http.DefaultTransport
+	Auth Authenticator
+}
+
+func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// A RoundTripper must not change the request it is given
+	authed := req.Clone(req.Context())
+	if err := t.Auth.Authenticate(authed); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	resp, err := base.RoundTrip(authed)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	inv, ok := t.Auth.(interface{ Invalidate() })
+	if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+	resp.Body.Close()
+
+	inv.Invalidate()
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.Auth.Authenticate(retry); err != nil {
+		if retry.Body != nil {
+			retry.Body.Close()
+		}
+		return nil, err
+	}
+	return base.RoundTrip(retry)
+}
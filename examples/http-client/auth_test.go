@@ -0,0 +1,68 @@
+package httpclient
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var errNoCredentials = errors.New("no credentials")
+
+// flakyAuth fails its nth call to Authenticate, and can be invalidated.
+type flakyAuth struct {
+	failOn int
+	calls  int
+}
+
+func (a *flakyAuth) Authenticate(req *http.Request) error {
+	if a.calls++; a.calls == a.failOn {
+		return errNoCredentials
+	}
+	req.Header.Set("Authorization", "Bearer token")
+	return nil
+}
+
+func (a *flakyAuth) Invalidate() {}
+
+// Whichever request fails to authenticate, its body is closed.
+func TestAuthTransportClosesBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name   string
+		failOn int
+	}{
+		{"first request", 1},
+		{"retry after 401", 2},
+	}
+	for _, tt := range tests {
+		var bodies []*closeTracker
+		req, _ := http.NewRequest("PUT", srv.URL, nil)
+		req.GetBody = func() (io.ReadCloser, error) {
+			body := &closeTracker{Reader: strings.NewReader("body")}
+			bodies = append(bodies, body)
+			return body, nil
+		}
+		req.Body, _ = req.GetBody()
+
+		transport := &AuthTransport{Base: srv.Client().Transport, Auth: &flakyAuth{failOn: tt.failOn}}
+		if _, err := transport.RoundTrip(req); !errors.Is(err, errNoCredentials) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, errNoCredentials)
+		}
+		if len(bodies) != tt.failOn {
+			t.Errorf("%s: %d bodies made, want %d", tt.name, len(bodies), tt.failOn)
+		}
+		for i, body := range bodies {
+			if !body.closed {
+				t.Errorf("%s: body %d not closed", tt.name, i+1)
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileJar is a cookie jar that can be saved to a file and opened again, so
+// a session outlives the program. The cookies are kept in a
+// net/http/cookiejar.Jar, which decides which requests get them; FileJar
+// also remembers each one as it was set, since a Jar cannot list what it
+// holds.
+//
+// Expiry is on the real clock, as it is in the Jar. Session cookies, which
+// have no expiry, are saved too, the way command line tools keep them
+// between runs. The file holds credentials, so only its owner can read it.
+type FileJar struct {
+	path string
+	jar  *cookiejar.Jar
+
+	mu    sync.Mutex
+	saved map[string]savedCookie // by URL host, domain, path and name
+}
+
+// savedCookie is a cookie as stored in the file, with the URL that set it.
+type savedCookie struct {
+	URL      string     `json:"url"`
+	Name     string     `json:"name"`
+	Value    string     `json:"value"`
+	Domain   string     `json:"domain,omitempty"`
+	Path     string     `json:"path,omitempty"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	Secure   bool       `json:"secure,omitempty"`
+	HttpOnly bool       `json:"http_only,omitempty"`
+}
+
+// OpenJar returns a jar holding the unexpired cookies saved in path. A file
+// that does not exist yet is an empty jar.
+func OpenJar(path string) (*FileJar, error) {
+	jar, _ := cookiejar.New(nil) // only fails on options it was not given
+	j := &FileJar{path: path, jar: jar, saved: make(map[string]savedCookie)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cookies []savedCookie
+	if err := json.Unmarshal(data, &cookies); err != nil {
+		return nil, err
+	}
+	for _, sc := range cookies {
+		u, err := url.Parse(sc.URL)
+		if err != nil {
+			continue
+		}
+		c := &http.Cookie{
+			Name: sc.Name, Value: sc.Value, Domain: sc.Domain, Path: sc.Path,
+			Secure: sc.Secure, HttpOnly: sc.HttpOnly,
+		}
+		if sc.Expires != nil {
+			c.Expires = *sc.Expires
+		}
+		j.SetCookies(u, []*http.Cookie{c})
+	}
+	return j, nil
+}
+
+func (j *FileJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.jar.SetCookies(u, cookies)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := time.Now()
+	for _, c := range cookies {
+		key := u.Host + ";" + c.Domain + ";" + c.Path + ";" + c.Name
+		sc := savedCookie{
+			URL:  (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: u.Path}).String(),
+			Name: c.Name, Value: c.Value, Domain: c.Domain, Path: c.Path,
+			Secure: c.Secure, HttpOnly: c.HttpOnly,
+		}
+		switch {
+		case c.MaxAge < 0:
+			delete(j.saved, key)
+			continue
+		case c.MaxAge > 0:
+			expires := now.Add(time.Duration(c.MaxAge) * time.Second)
+			sc.Expires = &expires
+		case !c.Expires.IsZero():
+			expires := c.Expires.UTC()
+			sc.Expires = &expires
+		}
+		if sc.Expires != nil && !sc.Expires.After(now) {
+			delete(j.saved, key)
+			continue
+		}
+		j.saved[key] = sc
+	}
+}
+
+func (j *FileJar) Cookies(u *url.URL) []*http.Cookie {
+	return j.jar.Cookies(u)
+}
+
+// Save writes the unexpired cookies to the jar's file, replacing it whole
+// so that a crash cannot leave it half written.
+func (j *FileJar) Save() error {
+	j.mu.Lock()
+	now := time.Now()
+	cookies := make([]savedCookie, 0, len(j.saved))
+	for _, sc := range j.saved {
+		if sc.Expires == nil || sc.Expires.After(now) {
+			cookies = append(cookies, sc)
+		}
+	}
+	j.mu.Unlock()
+	sort.Slice(cookies, func(a, b int) bool {
+		if cookies[a].URL != cookies[b].URL {
+			return cookies[a].URL < cookies[b].URL
+		}
+		return cookies[a].Name < cookies[b].Name
+	})
+
+	data, err := json.MarshalIndent(cookies, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(j.path), filepath.Base(j.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // fails harmlessly once renamed
+	err = tmp.Chmod(0o600)
+	if err == nil {
+		_, err = tmp.Write(append(data, '\n'))
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), j.path)
+}
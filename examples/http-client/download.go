@@ -0,0 +1,366 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// errChanged means the server sent the whole file in answer to a request
+// for part of it: it cannot do ranges after all, or the file has changed
+// since the last part was fetched.
+var errChanged = errors.New("server sent the whole file instead of the range asked for")
+
+// errStatus is wrapped by errors for responses that are no use at all,
+// which resuming would not fix.
+var errStatus = errors.New("unexpected status")
+
+// ChecksumError is returned when a downloaded file is not the one
+// expected. The file is deleted rather than kept.
+type ChecksumError struct {
+	Want, Got string // SHA-256 sums in hex
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("checksum mismatch: want sha256 %s, got %s", e.Want, e.Got)
+}
+
+// Downloader saves files from URLs without holding them in memory, for
+// files that are large or links that are flaky.
+//
+// The file is streamed into path + ".part" and only renamed to path once
+// it has all arrived and, if a checksum was given, been checked, so path
+// never holds part of a file. If the stream breaks off, it is picked up
+// again from where it stopped with a Range request. If-Range makes sure
+// the pieces all come from the same version of the file.
+//
+// A ".part" file left by an earlier call is picked up too, but only if
+// the server's ETag for the file is still the one its bytes came with,
+// which is kept beside it in path + ".part.etag". Without a match it is
+// started again from the beginning.
+//
+// When the server supports ranges and the file is larger than ChunkSize,
+// it is fetched as chunks of that size, Parallel of them at a time, each
+// written in place in the file.
+type Downloader struct {
+	Client     *http.Client // nil means http.DefaultClient
+	Parallel   int          // chunks fetched at once; 0 or 1 fetches in one stream
+	ChunkSize  int64        // size of each chunk, when fetching in parallel
+	MaxResumes int          // times each stream may be picked up again after breaking off
+
+	// Progress, if set, is called as data arrives with the bytes received
+	// so far and the size of the file, or -1 if the server did not say.
+	// Calls are never concurrent, even when chunks are.
+	Progress func(done, total int64)
+}
+
+// download is the state of one call to Download.
+type download struct {
+	*Downloader
+	url    string
+	etag   string // strong ETag to send with If-Range, if the server gave one
+	size   int64  // -1 if unknown
+	ranges bool   // the server accepts Range requests
+
+	mu   sync.Mutex
+	done int64
+}
+
+// Download fetches rawURL to path. If sum is not empty, it is the SHA-256
+// the file must have, in hex. Download returns the file's SHA-256 either
+// way.
+func (d *Downloader) Download(ctx context.Context, rawURL, path, sum string) (string, error) {
+	dl, err := d.probe(ctx, rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	part := path + ".part"
+	if dl.ranges && dl.size > 0 && d.Parallel > 1 && d.ChunkSize > 0 && dl.size > d.ChunkSize {
+		err = dl.chunked(ctx, part)
+	} else {
+		err = dl.stream(ctx, part)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	got, err := fileSHA256(part)
+	if err != nil {
+		return "", err
+	}
+	os.Remove(versionFile(part))
+	if sum != "" && !strings.EqualFold(sum, got) {
+		os.Remove(part)
+		return got, &ChecksumError{Want: strings.ToLower(sum), Got: got}
+	}
+	return got, os.Rename(part, path)
+}
+
+// versionFile names the file holding the ETag of the version part's bytes
+// came from.
+func versionFile(part string) string {
+	return part + ".etag"
+}
+
+// sameVersion reports whether part holds bytes of the version the server
+// has now.
+func (dl *download) sameVersion(part string) bool {
+	saved, err := os.ReadFile(versionFile(part))
+	return err == nil && dl.etag != "" && string(saved) == dl.etag
+}
+
+// saveVersion records the version part is about to be filled from, or
+// that it is not known.
+func (dl *download) saveVersion(part string) error {
+	if dl.etag == "" {
+		if err := os.Remove(versionFile(part)); !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	return os.WriteFile(versionFile(part), []byte(dl.etag), 0o644)
+}
+
+// probe asks for the file's headers, to learn its size and whether the
+// server can send parts of it.
+func (d *Downloader) probe(ctx context.Context, rawURL string) (*download, error) {
+	dl := &download{Downloader: d, url: rawURL, size: -1}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	// A server that does not do HEAD can still be downloaded from, just
+	// not resumed
+	if resp.StatusCode != http.StatusOK {
+		return dl, nil
+	}
+	dl.size = resp.ContentLength
+	dl.ranges = resp.Header.Get("Accept-Ranges") == "bytes"
+	if etag := resp.Header.Get("ETag"); strings.HasPrefix(etag, `"`) {
+		dl.etag = etag
+	}
+	return dl, nil
+}
+
+func (d *Downloader) client() *http.Client {
+	if d.Client != nil {
+		return d.Client
+	}
+	return http.DefaultClient
+}
+
+// stream fetches the file in one piece, after whatever an earlier attempt
+// left in part.
+func (dl *download) stream(ctx context.Context, part string) error {
+	f, err := os.OpenFile(part, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var start int64
+	if info, err := f.Stat(); err == nil && dl.ranges && dl.sameVersion(part) && (dl.size < 0 || info.Size() <= dl.size) {
+		start = info.Size()
+	}
+	if err := f.Truncate(start); err != nil {
+		return err
+	}
+	if start == 0 {
+		if err := dl.saveVersion(part); err != nil {
+			return err
+		}
+	}
+	dl.advance(start)
+	if start == dl.size {
+		return nil // all there already, waiting to be checked
+	}
+
+	err = dl.fetch(ctx, f, start, -1)
+	if errors.Is(err, errChanged) {
+		// What is already there belongs to another version: start again,
+		// with one whose ETag has not been seen
+		os.Remove(versionFile(part))
+		if err = f.Truncate(0); err == nil {
+			dl.advance(-dl.done)
+			err = dl.fetch(ctx, f, 0, -1)
+		}
+	}
+	if err != nil {
+		// Keep what arrived for next time, if anything did
+		if info, statErr := f.Stat(); statErr == nil && info.Size() == 0 {
+			os.Remove(part)
+			os.Remove(versionFile(part))
+		}
+		return err
+	}
+	return f.Sync()
+}
+
+// chunked fetches the file as ChunkSize pieces, Parallel at a time. The
+// first error cancels the rest.
+func (dl *download) chunked(ctx context.Context, part string) error {
+	f, err := os.Create(part)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := f.Truncate(dl.size); err != nil {
+		os.Remove(part)
+		return err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, dl.Parallel)
+	for start := int64(0); start < dl.size; start += dl.ChunkSize {
+		end := min(start+dl.ChunkSize, dl.size) - 1
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				return
+			}
+			if err := dl.fetch(ctx, f, start, end); err != nil {
+				cancel(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// A chunked file has holes until every chunk is in, so unlike a
+	// stream it cannot be resumed from its length
+	if err := context.Cause(ctx); err != nil {
+		os.Remove(part)
+		os.Remove(versionFile(part))
+		return err
+	}
+	return f.Sync()
+}
+
+// fetch writes bytes start to end of the file (to the end of it if end is
+// negative) into f at the same offsets, resuming up to MaxResumes times if
+// the response breaks off.
+func (dl *download) fetch(ctx context.Context, f *os.File, start, end int64) error {
+	for resumes := 0; ; resumes++ {
+		n, err := dl.fetchOnce(ctx, f, start, end)
+		start += n
+		if end >= 0 && start > end {
+			return nil // everything arrived before the connection failed
+		}
+		if err == nil || errors.Is(err, errChanged) || ctx.Err() != nil {
+			return err
+		}
+		if !dl.ranges || resumes == dl.MaxResumes || errors.Is(err, errStatus) {
+			return fmt.Errorf("downloading %s: %w", dl.url, err)
+		}
+	}
+}
+
+func (dl *download) fetchOnce(ctx context.Context, f *os.File, start, end int64) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, dl.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	ranged := start > 0 || end >= 0
+	if ranged {
+		if end >= 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+		} else {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", start))
+		}
+		if dl.etag != "" {
+			req.Header.Set("If-Range", dl.etag)
+		}
+	}
+
+	resp, err := dl.client().Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	want := int64(-1)
+	switch {
+	case ranged && resp.StatusCode == http.StatusPartialContent:
+		var first int64
+		if _, err := fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-", &first); err != nil || first != start {
+			return 0, fmt.Errorf("asked for bytes from %d, got %q", start, resp.Header.Get("Content-Range"))
+		}
+		want = resp.ContentLength
+	case resp.StatusCode == http.StatusOK && start == 0:
+		// The whole file, of which a chunk only wants the beginning
+		if end >= 0 {
+			want = end + 1
+		}
+	case resp.StatusCode == http.StatusOK:
+		return 0, errChanged
+	default:
+		return 0, fmt.Errorf("%w %s", errStatus, resp.Status)
+	}
+
+	body := io.Reader(resp.Body)
+	if want >= 0 {
+		body = io.LimitReader(body, want)
+	}
+	n, err := io.Copy(io.NewOffsetWriter(f, start), &progressReader{body, dl})
+	if err == nil && want >= 0 && n < want {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// advance adds n bytes to the progress and reports it.
+func (dl *download) advance(n int64) {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+	dl.done += n
+	if dl.Progress != nil && n != 0 {
+		dl.Progress(dl.done, dl.size)
+	}
+}
+
+// progressReader counts what is read through it into the download.
+type progressReader struct {
+	r  io.Reader
+	dl *download
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.dl.advance(int64(n))
+	return n, err
+}
+
+// fileSHA256 returns the SHA-256 of a file's content in hex.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
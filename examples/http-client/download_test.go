@@ -0,0 +1,178 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fileServer serves one file with an ETag, doing Range and If-Range as
+// http.ServeContent does, and logs the requests it gets.
+type fileServer struct {
+	content string
+	etag    string
+	ranges  bool   // advertise and honour Range requests
+	cutOff  int    // if not 0, the first GET breaks off after this many bytes
+	changed string // if set, the file becomes this, version "v2", after the HEAD
+
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *fileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, strings.Join(strings.Fields(
+		r.Method+" "+r.Header.Get("Range")+" "+r.Header.Get("If-Range")), " "))
+	content, etag := s.content, s.etag
+	if r.Method == http.MethodHead && s.changed != "" {
+		s.content, s.etag = s.changed, `"v2"`
+	}
+	cutOff := 0
+	if r.Method == http.MethodGet {
+		cutOff, s.cutOff = s.cutOff, 0
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("ETag", etag)
+	if !s.ranges {
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.Write([]byte(content))
+		return
+	}
+	if cutOff > 0 {
+		http.ServeContent(&cutOffWriter{ResponseWriter: w, left: cutOff}, r, "", time.Time{}, strings.NewReader(content))
+		panic(http.ErrAbortHandler)
+	}
+	http.ServeContent(w, r, "", time.Time{}, strings.NewReader(content))
+}
+
+func TestDownloadResume(t *testing.T) {
+	content := strings.Repeat("0123456789", 30)
+	newContent := strings.Repeat("abcdefghij", 30)
+
+	tests := []struct {
+		name     string
+		server   *fileServer
+		part     string // left by an earlier call, if not empty
+		partETag string // saved beside it, if not empty
+		parallel bool
+		want     string
+		wantReqs []string
+	}{
+		{name: "fresh",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true},
+			want:   content, wantReqs: []string{"HEAD", "GET"}},
+		{name: "resumed",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true},
+			part:   content[:120], partETag: `"v1"`,
+			want: content, wantReqs: []string{"HEAD", `GET bytes=120- "v1"`}},
+		{name: "already complete",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true},
+			part:   content, partETag: `"v1"`,
+			want: content, wantReqs: []string{"HEAD"}},
+		{name: "part of an older version",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true},
+			part:   newContent[:120], partETag: `"v0"`,
+			want: content, wantReqs: []string{"HEAD", "GET"}},
+		{name: "part of an unknown version",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true},
+			part:   newContent[:120],
+			want:   content, wantReqs: []string{"HEAD", "GET"}},
+		{name: "part longer than the file",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true},
+			part:   content + "extra", partETag: `"v1"`,
+			want: content, wantReqs: []string{"HEAD", "GET"}},
+		{name: "weak ETag",
+			server: &fileServer{content: content, etag: `W/"v1"`, ranges: true},
+			part:   content[:120], partETag: `W/"v1"`,
+			want: content, wantReqs: []string{"HEAD", "GET"}},
+		{name: "no ranges",
+			server: &fileServer{content: content, etag: `"v1"`},
+			part:   content[:120], partETag: `"v1"`,
+			want: content, wantReqs: []string{"HEAD", "GET"}},
+		{name: "broken off",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true, cutOff: 100},
+			want:   content, wantReqs: []string{"HEAD", "GET", `GET bytes=100- "v1"`}},
+		{name: "changed since the HEAD",
+			server: &fileServer{content: content, etag: `"v1"`, ranges: true, changed: newContent},
+			part:   content[:120], partETag: `"v1"`,
+			want: newContent, wantReqs: []string{"HEAD", `GET bytes=120- "v1"`, "GET"}},
+		{name: "chunks",
+			server:   &fileServer{content: content, etag: `"v1"`, ranges: true},
+			parallel: true,
+			want:     content, wantReqs: []string{
+				`GET bytes=0-127 "v1"`, `GET bytes=128-255 "v1"`, `GET bytes=256-299 "v1"`, "HEAD"}},
+	}
+	for _, tt := range tests {
+		srv := httptest.NewServer(tt.server)
+		path := filepath.Join(t.TempDir(), "file")
+		if tt.part != "" {
+			os.WriteFile(path+".part", []byte(tt.part), 0o644)
+		}
+		if tt.partETag != "" {
+			os.WriteFile(path+".part.etag", []byte(tt.partETag), 0o644)
+		}
+
+		d := &Downloader{Client: srv.Client(), MaxResumes: 1}
+		if tt.parallel {
+			d.Parallel, d.ChunkSize = 2, 128
+		}
+		sum, err := d.Download(context.Background(), srv.URL, path, "")
+		srv.Close()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		got, _ := os.ReadFile(path)
+		want := sha256.Sum256([]byte(tt.want))
+		if string(got) != tt.want || sum != hex.EncodeToString(want[:]) {
+			t.Errorf("%s: saved %q... with sum %s, want %q...", tt.name, got[:min(len(got), 20)], sum, tt.want[:20])
+		}
+		if tt.parallel {
+			slices.Sort(tt.server.requests)
+		}
+		if !reflect.DeepEqual(tt.server.requests, tt.wantReqs) {
+			t.Errorf("%s: requests %q, want %q", tt.name, tt.server.requests, tt.wantReqs)
+		}
+		for _, leftover := range []string{path + ".part", path + ".part.etag"} {
+			if _, err := os.Stat(leftover); err == nil {
+				t.Errorf("%s: %s left behind", tt.name, filepath.Base(leftover))
+			}
+		}
+	}
+}
+
+// A stream that breaks off for good keeps what arrived, with its version,
+// for the next call to pick up.
+func TestDownloadKeepsPart(t *testing.T) {
+	content := strings.Repeat("0123456789", 30)
+	server := &fileServer{content: content, etag: `"v1"`, ranges: true, cutOff: 100}
+	srv := httptest.NewServer(server)
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "file")
+
+	d := &Downloader{Client: srv.Client()}
+	if _, err := d.Download(context.Background(), srv.URL, path, ""); err == nil {
+		t.Fatal("download succeeded without resuming")
+	}
+	part, _ := os.ReadFile(path + ".part")
+	etag, _ := os.ReadFile(path + ".part.etag")
+	if string(part) != content[:100] || string(etag) != `"v1"` {
+		t.Errorf("kept %d bytes of version %s, want 100 of \"v1\"", len(part), etag)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Error("incomplete file saved under its final name")
+	}
+}
@@ -0,0 +1,550 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	customerrors "github.com/saqib77official/go-by-example/examples/custom-errors"
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "http-client",
+		Category:    examples.Practical,
+		Description: "HTTP Client",
+		Icon:        "🌐",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "POST Request", Run: section(postRequest)},
+			{Number: 2, Title: "Custom Client with Timeout", Run: section(customClientWithTimeout)},
+			{Number: 3, Title: "Request with Headers", Run: section(requestWithHeaders)},
+			{Number: 4, Title: "Status Code Handling", Run: section(statusCodeHandling)},
+			{Number: 5, Title: "Download File", Run: section(downloadFile)},
+			{Number: 6, Title: "Retries and Circuit Breaker", Run: section(retriesAndCircuitBreaker)},
+			{Number: 7, Title: "Request Timing", Run: section(requestTiming)},
+			{Number: 8, Title: "Authentication", Run: section(authentication)},
+		},
+	})
+}
+
+// baseURL is the httpbin-compatible server every request goes to
+var baseURL string
+
+// Settings for the Request Timing section
+var (
+	traceMode bool   // print the waterfall and percentiles
+	traceJSON string // file to write the timings to
+	traceRuns int    // times to repeat the requests
+)
+
+// useServer reads the command line, sets baseURL from --base-url or
+// starts the stand-in server if there is none, and returns a function that
+// stops it again.
+// (gobyexample run http-client --base-url=https://httpbin.org --trace)
+func useServer() (stop func()) {
+	flags := flag.NewFlagSet("http-client", flag.ExitOnError)
+	base := flags.String("base-url", "", "httpbin-compatible server to call (default: an in-process stand-in)")
+	flags.BoolVar(&traceMode, "trace", false, "Print a timing waterfall and percentiles for the Request Timing section")
+	flags.StringVar(&traceJSON, "trace-json", "", "Write the Request Timing timings to this file as JSON")
+	flags.IntVar(&traceRuns, "runs", 3, "Times the Request Timing section repeats its requests")
+	flags.Parse(os.Args[1:])
+
+	if *base != "" {
+		baseURL = strings.TrimSuffix(*base, "/")
+		return func() {}
+	}
+	server := NewStandIn()
+	baseURL = server.URL
+	return server.Close
+}
+
+// section runs one section on its own, with a server to talk to.
+func section(run func()) func() {
+	return func() {
+		defer useServer()()
+		run()
+	}
+}
+
+func Run() {
+	fmt.Println("=== HTTP Client ===")
+	defer useServer()()
+
+	// Simple GET request
+	resp, err := http.Get(baseURL + "/get")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	fmt.Printf("Status: %s\n", resp.Status)
+	fmt.Printf("Content-Type: %s\n", resp.Header.Get("Content-Type"))
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Printf("Error reading body: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Response body (first 100 chars): %s...\n", string(body[:100]))
+
+	postRequest()
+	customClientWithTimeout()
+	requestWithHeaders()
+	statusCodeHandling()
+	downloadFile()
+	retriesAndCircuitBreaker()
+	requestTiming()
+	authentication()
+}
+
+// POST request
+func postRequest() {
+	fmt.Println("\n--- POST Request ---")
+	postData := `{"name": "Alice", "age": 25}`
+
+	resp, err := http.Post(baseURL+"/post", "application/json",
+		strings.NewReader(postData))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	fmt.Printf("POST Response: %s\n", string(body))
+}
+
+// Custom client with timeout
+func customClientWithTimeout() {
+	fmt.Println("\n--- Custom Client with Timeout ---")
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+	}
+
+	req, err := http.NewRequest("GET", baseURL+"/delay/2", nil)
+	if err != nil {
+		fmt.Printf("Error creating request: %v\n", err)
+		return
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		fmt.Printf("Timeout error: %v\n", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	fmt.Printf("Request completed within timeout\n")
+}
+
+// Adding headers
+func requestWithHeaders() {
+	fmt.Println("\n--- Request with Headers ---")
+	// readme:begin
+	client := &http.Client{Timeout: 5 * time.Second}
+	req, _ := http.NewRequest("GET", baseURL+"/headers", nil)
+	// readme:end
+	req.Header.Set("User-Agent", "Go-HTTP-Client/1.0")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Custom-Header", "custom-value")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	fmt.Printf("Headers response: %s\n", string(body))
+}
+
+// Handling different status codes
+func statusCodeHandling() {
+	fmt.Println("\n--- Status Code Handling ---")
+	urls := []string{
+		baseURL + "/status/200",
+		baseURL + "/status/404",
+		baseURL + "/status/500",
+	}
+
+	for _, url := range urls {
+		resp, err := http.Get(url)
+		if err != nil {
+			fmt.Printf("Error fetching %s: %v\n", url, err)
+			continue
+		}
+		defer resp.Body.Close()
+
+		switch resp.StatusCode {
+		case 200:
+			fmt.Printf("✓ %s: Success\n", url)
+		case 404:
+			fmt.Printf("✗ %s: Not Found\n", url)
+		case 500:
+			fmt.Printf("✗ %s: Server Error\n", url)
+		default:
+			fmt.Printf("? %s: Unknown status %d\n", url, resp.StatusCode)
+		}
+	}
+}
+
+// Download file
+func downloadFile() {
+	fmt.Println("\n--- Download File ---")
+	dir, err := os.MkdirTemp("", "downloads")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// The checksum is normally published beside the file; /range always
+	// serves the alphabet over and over, so it can be worked out here
+	const size = 100 * 1024
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte('a' + i%26)
+	}
+	sum := sha256.Sum256(content)
+	want := hex.EncodeToString(sum[:])
+	fileURL := fmt.Sprintf("%s/range/%d", baseURL, size)
+
+	// In parallel chunks, reporting progress every quarter
+	var quarter int64
+	d := &Downloader{Parallel: 4, ChunkSize: 16 * 1024, MaxResumes: 3}
+	d.Progress = func(done, total int64) {
+		for total > 0 && done*4 >= (quarter+1)*total {
+			quarter++
+			fmt.Printf("  %d%%\n", quarter*25)
+		}
+	}
+	got, err := d.Download(context.Background(), fileURL, filepath.Join(dir, "chunked.bin"), want)
+	if err != nil {
+		fmt.Printf("Error downloading: %v\n", err)
+		return
+	}
+	info, _ := os.Stat(filepath.Join(dir, "chunked.bin"))
+	fmt.Printf("Downloaded %d bytes in chunks, sha256 %s...\n", info.Size(), got[:16])
+
+	// Over a link that drops the first response halfway through: the
+	// download picks up from where it stopped
+	var requests int
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			StandInHandler().ServeHTTP(w, r)
+			return
+		}
+		requests++
+		if rng := r.Header.Get("Range"); rng != "" {
+			fmt.Printf("  request %d: Range %s\n", requests, rng)
+		} else {
+			fmt.Printf("  request %d: the whole file\n", requests)
+		}
+		if requests > 1 {
+			StandInHandler().ServeHTTP(w, r)
+			return
+		}
+		cut := &cutOffWriter{ResponseWriter: w, left: size / 2}
+		StandInHandler().ServeHTTP(cut, r)
+		fmt.Printf("  link dropped after %d bytes\n", size/2)
+		panic(http.ErrAbortHandler)
+	}))
+	defer flaky.Close()
+
+	d = &Downloader{MaxResumes: 3}
+	if _, err := d.Download(context.Background(), flaky.URL+fmt.Sprintf("/range/%d", size), filepath.Join(dir, "resumed.bin"), want); err != nil {
+		fmt.Printf("Error downloading: %v\n", err)
+		return
+	}
+	fmt.Println("Resumed download matches its checksum")
+
+	// A part left by an earlier run is only carried on with if it came
+	// from the version of the file the server has now
+	stale := filepath.Join(dir, "stale.bin")
+	os.WriteFile(stale+".part", []byte(strings.Repeat("x", size/2)), 0o644)
+	os.WriteFile(stale+".part.etag", []byte(`"an-older-version"`), 0o644)
+	if _, err := d.Download(context.Background(), fileURL, stale, want); err != nil {
+		fmt.Printf("Error downloading: %v\n", err)
+		return
+	}
+	_, statErr := os.Stat(stale + ".part.etag")
+	fmt.Printf("Part of an older version started again: checksum matches, version file left: %t\n", statErr == nil)
+
+	// A file that is not what was expected is thrown away
+	_, err = d.Download(context.Background(), fileURL, filepath.Join(dir, "wrong.bin"), strings.Repeat("0", 64))
+	var sumErr *ChecksumError
+	if errors.As(err, &sumErr) {
+		_, statErr := os.Stat(filepath.Join(dir, "wrong.bin"))
+		fmt.Printf("Checksum mismatch, got %s...; file kept: %t\n", sumErr.Got[:16], statErr == nil)
+	}
+}
+
+// cutOffWriter passes on only the first left bytes of a response.
+type cutOffWriter struct {
+	http.ResponseWriter
+	left int
+}
+
+func (c *cutOffWriter) Write(p []byte) (int, error) {
+	if len(p) > c.left {
+		n, _ := c.ResponseWriter.Write(p[:c.left])
+		c.left = 0
+		http.NewResponseController(c.ResponseWriter).Flush()
+		return n, io.ErrClosedPipe
+	}
+	c.left -= len(p)
+	return c.ResponseWriter.Write(p)
+}
+
+// Retries with backoff and a circuit breaker
+func retriesAndCircuitBreaker() {
+	fmt.Println("\n--- Retries and Circuit Breaker ---")
+	transport := NewRetryTransport(nil)
+	transport.BreakerCooldown = 2 * time.Second
+	var retries int
+	transport.OnRetry = func(req *http.Request, attempt int, wait time.Duration, err error) {
+		retries = attempt
+		fmt.Printf("  retry %d of %s %s in %v: status %d\n",
+			attempt, req.Method, req.URL.Path, wait.Round(time.Millisecond), err.(*customerrors.NetworkError).StatusCode)
+	}
+	client := &http.Client{Transport: transport, Timeout: 30 * time.Second}
+
+	// A server that is busy for its first two requests, and says when to
+	// come back
+	var calls int
+	busy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls++; calls <= 2 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ready now")
+	}))
+	defer busy.Close()
+
+	resp, err := client.Get(busy.URL + "/busy")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	fmt.Printf("Busy server: %s after %d requests: %s", resp.Status, calls, body)
+
+	// Asked to come back tomorrow, the transport hands the answer over
+	// rather than wait that long
+	closed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer closed.Close()
+	resp, err = client.Get(closed.URL + "/closed")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	resp.Body.Close()
+	fmt.Printf("Closed for the day: %s, Retry-After %ss\n", resp.Status, resp.Header.Get("Retry-After"))
+
+	// A POST is not repeated, since the server may have acted on it: its
+	// first answer is the one the caller gets
+	retries = 0
+	resp, err = client.Post(baseURL+"/status/503", "text/plain", strings.NewReader("hello"))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	resp.Body.Close()
+	fmt.Printf("POST: %s after %d retries\n", resp.Status, retries)
+
+	// A host that keeps failing: the retries run out, the last answer is
+	// handed over, and with the failures in a row the breaker opens
+	retries = 0
+	resp, err = client.Get(baseURL + "/status/503")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	resp.Body.Close()
+	fmt.Printf("GET: %s after %d retries\n", resp.Status, retries)
+	host := mustHost(baseURL)
+	fmt.Printf("Breaker for the host: %s\n", transport.BreakerState(host))
+
+	// While it is open, requests fail without being sent
+	_, err = client.Get(baseURL + "/get")
+	fmt.Printf("While open: circuit open error: %t\n", errors.Is(err, ErrCircuitOpen))
+
+	// After the cooldown one probe goes through, and closes it again
+	clock.Sleep(transport.BreakerCooldown)
+	resp, err = client.Get(baseURL + "/get")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	resp.Body.Close()
+	fmt.Printf("Probe after cooldown: %s, breaker %s\n", resp.Status, transport.BreakerState(host))
+}
+
+// Timing each phase of a request with httptrace
+func requestTiming() {
+	fmt.Println("\n--- Request Timing ---")
+	tracer := &TraceTransport{}
+	client := &http.Client{Transport: tracer, Timeout: 10 * time.Second}
+
+	for run := 0; run < traceRuns; run++ {
+		for _, path := range []string{"/get", "/delay/0.1", "/bytes/102400"} {
+			resp, err := client.Get(baseURL + path)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+			// Reading the body to the end lets the connection be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+
+	timings := tracer.Timings()
+	var reused int
+	for _, t := range timings {
+		if t.Reused {
+			reused++
+		}
+	}
+	fmt.Printf("Timed %d requests over %d connections (%d reused)\n",
+		len(timings), len(timings)-reused, reused)
+
+	summary := Summarize(timings)
+	if traceMode {
+		WriteWaterfall(os.Stdout, timings)
+		fmt.Println()
+		WriteSummary(os.Stdout, summary)
+	} else {
+		fmt.Println("Run with --trace to see where the time went")
+	}
+
+	if traceJSON != "" {
+		data, _ := json.MarshalIndent(map[string]any{"requests": timings, "summary": summary}, "", "  ")
+		if err := os.WriteFile(traceJSON, append(data, '\n'), 0o644); err != nil {
+			fmt.Printf("Error writing timings: %v\n", err)
+			return
+		}
+		fmt.Printf("Wrote timings to %s\n", traceJSON)
+	}
+}
+
+// Basic auth, bearer tokens, OAuth2 client credentials and a cookie jar
+// that is saved between runs
+func authentication() {
+	fmt.Println("\n--- Authentication ---")
+	getJSON := func(client *http.Client, path string) {
+		resp, err := client.Get(baseURL + path)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		fmt.Println(strings.TrimSpace(fmt.Sprintf("GET %s: %s %s", path, resp.Status, body)))
+	}
+
+	basic := &http.Client{Transport: &AuthTransport{Auth: BasicAuth{"alice", "s3cret"}}}
+	getJSON(basic, "/basic-auth/alice/s3cret")
+	getJSON(basic, "/basic-auth/alice/hunter2")
+	getJSON(&http.Client{Transport: &AuthTransport{Auth: BearerToken("static-token")}}, "/bearer")
+
+	// The token endpoint and the API it guards run locally even with
+	// --base-url, since public httpbins have no token endpoint
+	tokens := &TokenEndpoint{ClientID: "cli", ClientSecret: "shh", Lifetime: 2 * time.Second}
+	mux := http.NewServeMux()
+	mux.Handle("POST /oauth/token", tokens)
+	mux.Handle("GET /api/me", tokens.Protect(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "hello, holder of %s\n", strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	})))
+	api := httptest.NewServer(mux)
+	defer api.Close()
+
+	creds := &ClientCredentials{TokenURL: api.URL + "/oauth/token", ClientID: "cli", ClientSecret: "shh", Scopes: []string{"read"}}
+	client := &http.Client{Transport: &AuthTransport{Auth: creds}, Timeout: 10 * time.Second}
+	callAPI := func(label string) {
+		resp, err := client.Get(api.URL + "/api/me")
+		if err != nil {
+			fmt.Printf("%s: %v\n", label, err)
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		fmt.Printf("%s: %s %s", label, resp.Status, body)
+	}
+	callAPI("First call")
+	callAPI("Second call")
+	// Half a 2s token's lifetime later it is due for refresh
+	clock.Sleep(1100 * time.Millisecond)
+	callAPI("After 1.1s")
+	fmt.Printf("Tokens issued: %d\n", tokens.Issued())
+
+	wrong := &ClientCredentials{TokenURL: creds.TokenURL, ClientID: "cli", ClientSecret: "guess"}
+	_, err := wrong.Token(context.Background())
+	var tokenErr *TokenError
+	if errors.As(err, &tokenErr) {
+		fmt.Printf("Wrong secret: %v (status %d)\n", tokenErr, tokenErr.Status)
+	}
+
+	// Cookies set in one run are sent in the next
+	dir, err := os.MkdirTemp("", "http-client-cookies")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cookies.json")
+
+	jar, err := OpenJar(path)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	getJSON(&http.Client{Jar: jar}, "/cookies/set?session=abc123")
+	if err := jar.Save(); err != nil {
+		fmt.Printf("Error saving cookies: %v\n", err)
+		return
+	}
+	info, _ := os.Stat(path)
+	fmt.Printf("Saved the jar, mode %v\n", info.Mode().Perm())
+
+	jar, err = OpenJar(path)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	getJSON(&http.Client{Jar: jar}, "/cookies")
+
+	fmt.Println("HTTP client examples completed!")
+}
+
+func mustHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		panic(err)
+	}
+	return u.Host
+}
@@ -0,0 +1,352 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	customerrors "github.com/saqib77official/go-by-example/examples/custom-errors"
+	"github.com/saqib77official/go-by-example/internal/clock"
+	"github.com/saqib77official/go-by-example/internal/random"
+)
+
+// ErrCircuitOpen is the cause of the NetworkError returned for a request
+// to a host whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// Statuses worth asking again for: the server was busy, overloaded or
+// briefly unreachable, and may not be next time
+var retryStatuses = map[int]bool{
+	http.StatusRequestTimeout:      true,
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// RetryTransport is an http.RoundTripper that retries requests which fail
+// in a way that might not happen again: a transport error, or one of the
+// statuses in retryStatuses. Only requests that are safe to send twice are
+// retried: GET, HEAD, OPTIONS, PUT and DELETE, and others carrying an
+// Idempotency-Key header, as long as their body can be replayed.
+//
+// Retries wait as Policy says, with each delay jittered between half and
+// all of it so that clients failing together do not retry together. A
+// Retry-After header from the server takes the place of the delay, unless
+// it asks for longer than Policy.MaxDelay. No retry starts that could not
+// finish before the request's context deadline. A response that will not
+// be retried, because the request cannot be, the retries have run out or
+// the wait is too long, is returned as it is for the caller to read,
+// Retry-After and all.
+//
+// Each host has a circuit breaker. After BreakerThreshold failures in a
+// row it opens, and requests to the host fail at once with ErrCircuitOpen
+// rather than adding to its load. Once BreakerCooldown has passed it lets
+// one request through as a probe: if that succeeds the breaker closes, and
+// if not it stays open for another cooldown.
+//
+// When a request could not be sent, or failed to get a response for the
+// last time, the error is a *customerrors.NetworkError, whose Retryable
+// field says whether trying again later might help.
+type RetryTransport struct {
+	Base             http.RoundTripper // nil means http.DefaultTransport
+	Policy           customerrors.RetryPolicy
+	BreakerThreshold int           // 0 means no circuit breaker
+	BreakerCooldown  time.Duration // how long a breaker stays open
+
+	// OnRetry, if set, is called before each retry with the number of the
+	// attempt about to be made, the wait before it and the failure that
+	// caused it.
+	OnRetry func(req *http.Request, attempt int, wait time.Duration, err error)
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+	rng      *rand.Rand
+}
+
+// NewRetryTransport returns a RetryTransport over base with a policy and
+// breaker suited to calling a service that is usually up.
+func NewRetryTransport(base http.RoundTripper) *RetryTransport {
+	return &RetryTransport{
+		Base: base,
+		Policy: customerrors.RetryPolicy{
+			MaxRetries:    3,
+			InitialDelay:  100 * time.Millisecond,
+			MaxDelay:      5 * time.Second,
+			BackoffFactor: 2,
+		},
+		BreakerThreshold: 5,
+		BreakerCooldown:  30 * time.Second,
+	}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	ctx := req.Context()
+	host := req.URL.Host
+	replayable := canRetry(req)
+
+	for attempt := 0; ; attempt++ {
+		b := t.breaker(host)
+		if b != nil && !b.allow() {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, &customerrors.NetworkError{
+				Operation:  req.Method,
+				URL:        req.URL.String(),
+				Retryable:  true,
+				RetryCount: attempt,
+				Cause:      ErrCircuitOpen,
+			}
+		}
+
+		// Every attempt after the first needs a fresh copy of the body
+		try := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			try = req.Clone(ctx)
+			try.Body = body
+		}
+
+		resp, err := base.RoundTrip(try)
+		failure := &customerrors.NetworkError{
+			Operation:  req.Method,
+			URL:        req.URL.String(),
+			RetryCount: attempt,
+			Cause:      err,
+		}
+		switch {
+		case err != nil:
+			// The caller gave up, which is no fault of the host's
+			if ctx.Err() != nil {
+				b.abandon()
+				return nil, failure
+			}
+			failure.Retryable = true
+		case retryStatuses[resp.StatusCode]:
+			failure.StatusCode = resp.StatusCode
+			failure.Retryable = true
+			failure.Cause = errors.New(resp.Status)
+		}
+		b.done(!failure.Retryable)
+		if !failure.Retryable {
+			return resp, nil
+		}
+
+		retry := &customerrors.RetryableError{
+			Operation:   req.Method + " " + req.URL.String(),
+			RetryPolicy: t.Policy,
+			Attempt:     attempt,
+			LastError:   failure,
+		}
+		wait := t.jitter(retry.NextDelay())
+		again := replayable && retry.ShouldRetry()
+		if resp != nil {
+			if after, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+				again = again && after <= t.Policy.MaxDelay
+				wait = after
+			}
+		}
+		if !again || !inTime(ctx, wait) {
+			// The server's answer is the caller's to read
+			if resp != nil {
+				return resp, nil
+			}
+			return nil, failure
+		}
+		if resp != nil {
+			// Read a little of the body so the connection can be reused
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+			resp.Body.Close()
+		}
+
+		if t.OnRetry != nil {
+			t.OnRetry(req, attempt+1, wait, failure)
+		}
+		timer := clock.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, failure
+		}
+	}
+}
+
+// canRetry reports whether req may be sent more than once.
+func canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// jitter picks a delay between d/2 and d.
+func (t *RetryTransport) jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.rng == nil {
+		t.rng = rand.New(rand.NewPCG(random.Seed(), 0))
+	}
+	return d/2 + time.Duration(t.rng.Int64N(int64(d/2)))
+}
+
+// retryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return max(time.Until(when), 0), true
+	}
+	return 0, false
+}
+
+// inTime reports whether waiting d still leaves time before ctx's deadline.
+// Deadlines are on the real clock even when the waits are not.
+func inTime(ctx context.Context, d time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Until(deadline) > d
+}
+
+// breaker returns the circuit breaker for host, or nil if they are off.
+func (t *RetryTransport) breaker(host string) *breaker {
+	if t.BreakerThreshold <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.breakers == nil {
+		t.breakers = make(map[string]*breaker)
+	}
+	b, ok := t.breakers[host]
+	if !ok {
+		b = &breaker{threshold: t.BreakerThreshold, cooldown: t.BreakerCooldown}
+		t.breakers[host] = b
+	}
+	return b
+}
+
+// BreakerState describes the circuit breaker for host: "closed", "open"
+// or "half-open".
+func (t *RetryTransport) BreakerState(host string) string {
+	b := t.breaker(host)
+	if b == nil {
+		return "closed"
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state.String()
+}
+
+type breakerState int
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+func (s breakerState) String() string {
+	return [...]string{"closed", "open", "half-open"}[s]
+}
+
+// breaker is the circuit breaker for one host.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int       // in a row, while closed
+	openedAt time.Time // when it last opened
+	probing  bool      // a half-open probe is in flight
+}
+
+// allow reports whether a request may go ahead. While the breaker is half
+// open only the one probe may.
+func (b *breaker) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == open && clock.Since(b.openedAt) >= b.cooldown {
+		b.state = halfOpen
+	}
+	switch b.state {
+	case closed:
+		return true
+	case halfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return false
+}
+
+// abandon records that an allowed request was cancelled, which says
+// nothing about the host.
+func (b *breaker) abandon() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == halfOpen {
+		b.probing = false
+	}
+}
+
+// done records how an allowed request went.
+func (b *breaker) done(ok bool) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == halfOpen {
+		b.probing = false
+		if ok {
+			b.state, b.failures = closed, 0
+		} else {
+			b.state, b.openedAt = open, clock.Now()
+		}
+		return
+	}
+	if ok {
+		b.failures = 0
+		return
+	}
+	if b.failures++; b.failures >= b.threshold {
+		b.state, b.openedAt = open, clock.Now()
+	}
+}
@@ -0,0 +1,185 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	customerrors "github.com/saqib77official/go-by-example/examples/custom-errors"
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+// script is a server that answers its nth request with statuses[n], or
+// the last of them once they run out, and says which request it was.
+type script struct {
+	statuses   []int
+	retryAfter string
+	calls      int
+}
+
+func (s *script) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := s.statuses[min(s.calls, len(s.statuses)-1)]
+	s.calls++
+	if s.retryAfter != "" {
+		w.Header().Set("Retry-After", s.retryAfter)
+	}
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "request %d", s.calls)
+}
+
+// closeTracker is a request body that remembers being closed.
+type closeTracker struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestRetryTransport(t *testing.T) {
+	fake := clock.NewFake(clock.Epoch)
+	defer func(c clock.Clock) { clock.Default = c }(clock.Default)
+	clock.Default = fake
+	defer fake.AutoAdvance()()
+
+	tests := []struct {
+		name       string
+		method     string
+		key        bool // send an Idempotency-Key
+		statuses   []int
+		retryAfter string
+		wantStatus int
+		wantCalls  int
+	}{
+		{"success", "GET", false, []int{200}, "", 200, 1},
+		{"not found", "GET", false, []int{404, 200}, "", 404, 1},
+		{"recovers", "GET", false, []int{503, 502, 200}, "", 200, 3},
+		{"retries run out", "GET", false, []int{503}, "", 503, 4},
+		{"PUT", "PUT", false, []int{500, 200}, "", 200, 2},
+		{"POST", "POST", false, []int{503, 200}, "", 503, 1},
+		{"POST with key", "POST", true, []int{503, 200}, "", 200, 2},
+		{"short Retry-After", "GET", false, []int{429, 200}, "1", 200, 2},
+		{"long Retry-After", "GET", false, []int{503, 200}, "3600", 503, 1},
+	}
+	for _, tt := range tests {
+		server := &script{statuses: tt.statuses, retryAfter: tt.retryAfter}
+		srv := httptest.NewServer(server)
+		transport := NewRetryTransport(srv.Client().Transport)
+		transport.BreakerThreshold = 0
+
+		req, _ := http.NewRequest(tt.method, srv.URL, strings.NewReader("body"))
+		if tt.key {
+			req.Header.Set("Idempotency-Key", "k1")
+		}
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			srv.Close()
+			continue
+		}
+		// Whatever comes back is the server's last answer, unread
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		srv.Close()
+		if resp.StatusCode != tt.wantStatus || server.calls != tt.wantCalls {
+			t.Errorf("%s: %d after %d requests, want %d after %d",
+				tt.name, resp.StatusCode, server.calls, tt.wantStatus, tt.wantCalls)
+		}
+		if want := fmt.Sprintf("request %d", server.calls); string(body) != want {
+			t.Errorf("%s: body %q, want %q", tt.name, body, want)
+		}
+		if tt.retryAfter != "" && resp.Header.Get("Retry-After") != tt.retryAfter {
+			t.Errorf("%s: Retry-After %q, want %q", tt.name, resp.Header.Get("Retry-After"), tt.retryAfter)
+		}
+	}
+}
+
+// A request that cannot be sent at all fails with a NetworkError.
+func TestRetryTransportError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	transport := NewRetryTransport(nil)
+	transport.Policy.MaxRetries = 0
+	req, _ := http.NewRequest("GET", url, nil)
+	_, err := transport.RoundTrip(req)
+	var netErr *customerrors.NetworkError
+	if !errors.As(err, &netErr) || !netErr.Retryable {
+		t.Errorf("error %v, want a retryable NetworkError", err)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	fake := clock.NewFake(clock.Epoch)
+	defer func(c clock.Clock) { clock.Default = c }(clock.Default)
+	clock.Default = fake
+
+	server := &script{statuses: []int{200}}
+	srv := httptest.NewServer(server)
+	defer srv.Close()
+	transport := NewRetryTransport(srv.Client().Transport)
+	transport.Policy.MaxRetries = 0
+	transport.BreakerThreshold = 2
+	transport.BreakerCooldown = 10 * time.Second
+	host := srv.Listener.Addr().String()
+
+	// Run in order: each step sees the breaker the last one left
+	steps := []struct {
+		name      string
+		advance   time.Duration // how far to move the clock first
+		status    int           // what the server answers
+		wantOpen  bool          // the request fails with ErrCircuitOpen
+		wantState string        // the breaker afterwards
+	}{
+		{"ok", 0, 200, false, "closed"},
+		{"first failure", 0, 503, false, "closed"},
+		{"success resets the count", 0, 200, false, "closed"},
+		{"failure", 0, 503, false, "closed"},
+		{"second failure in a row", 0, 500, false, "open"},
+		{"while open", 0, 200, true, "open"},
+		{"before the cooldown", 9 * time.Second, 200, true, "open"},
+		{"failed probe", time.Second, 503, false, "open"},
+		{"open again", 0, 200, true, "open"},
+		{"cooldown restarted", 9 * time.Second, 200, true, "open"},
+		{"probe succeeds", time.Second, 200, false, "closed"},
+		{"closed", 0, 404, false, "closed"},
+	}
+	for _, step := range steps {
+		fake.Advance(step.advance)
+		server.statuses = []int{step.status}
+		calls := server.calls
+
+		body := &closeTracker{Reader: strings.NewReader("body")}
+		req, _ := http.NewRequest("PUT", srv.URL, body)
+		resp, err := transport.RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+
+		switch {
+		case step.wantOpen:
+			if !errors.Is(err, ErrCircuitOpen) || server.calls != calls {
+				t.Errorf("%s: error %v after %d requests, want ErrCircuitOpen without one",
+					step.name, err, server.calls-calls)
+			}
+		case err != nil:
+			t.Errorf("%s: %v", step.name, err)
+		case resp.StatusCode != step.status:
+			t.Errorf("%s: status %d, want %d", step.name, resp.StatusCode, step.status)
+		}
+		if !body.closed {
+			t.Errorf("%s: request body not closed", step.name)
+		}
+		if got := transport.BreakerState(host); got != step.wantState {
+			t.Errorf("%s: breaker %s, want %s", step.name, got, step.wantState)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+package httpclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+// Limits of the stand-in, the same as httpbin.org's
+const (
+	maxDelay = 10 * time.Second
+	maxBytes = 100 * 1024
+)
+
+// NewStandIn starts an in-process server answering the part of the
+// httpbin.org API the examples use, so they run without a network. Close
+// it when done.
+func NewStandIn() *httptest.Server {
+	return httptest.NewServer(StandInHandler())
+}
+
+// StandInHandler serves the stand-in's endpoints, for mounting on a server
+// of your own (an httptest.NewTLSServer, say):
+//
+//	GET  /get           the request's query, headers, origin and URL
+//	POST /post          the same, with the body as data, json, form and files
+//	GET  /headers       the request's headers
+//	ANY  /status/{code} an empty response with that status
+//	GET  /delay/{n}     /get after n seconds (at most 10)
+//	GET  /bytes/{n}     n random bytes (at most 100 KiB), or the same ones
+//	                    every time with ?seed
+//	GET  /range/{n}     n bytes of the alphabet over and over (at most
+//	                    100 KiB), honouring Range requests
+//	GET  /basic-auth/{user}/{passwd}
+//	                    200 if the request has that username and password
+//	                    in Basic auth, 401 if not
+//	GET  /bearer        200 with the request's bearer token, 401 if none
+//	GET  /cookies       the cookies the request carries
+//	GET  /cookies/set   sets a cookie for each query parameter, then
+//	                    redirects to /cookies
+func StandInHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /get", func(w http.ResponseWriter, r *http.Request) {
+		writeBin(w, http.StatusOK, describe(r))
+	})
+	mux.HandleFunc("POST /post", servePost)
+	mux.HandleFunc("GET /headers", func(w http.ResponseWriter, r *http.Request) {
+		writeBin(w, http.StatusOK, map[string]any{"headers": headerMap(r)})
+	})
+	mux.HandleFunc("/status/{code}", serveStatus)
+	mux.HandleFunc("GET /delay/{n}", serveDelay)
+	mux.HandleFunc("GET /bytes/{n}", serveBytes)
+	mux.HandleFunc("GET /range/{n}", serveRange)
+	mux.HandleFunc("GET /basic-auth/{user}/{passwd}", serveBasicAuth)
+	mux.HandleFunc("GET /bearer", serveBearer)
+	mux.HandleFunc("GET /cookies", serveCookies)
+	mux.HandleFunc("GET /cookies/set", setCookies)
+	return mux
+}
+
+// describe is the body of /get: what the server saw of the request.
+func describe(r *http.Request) map[string]any {
+	origin, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		origin = r.RemoteAddr
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return map[string]any{
+		"args":    flatten(r.URL.Query()),
+		"headers": headerMap(r),
+		"origin":  origin,
+		"url":     scheme + "://" + r.Host + r.URL.RequestURI(),
+	}
+}
+
+// headerMap returns the request headers as httpbin does, with repeated
+// headers joined by commas and Host included.
+func headerMap(r *http.Request) map[string]string {
+	headers := map[string]string{"Host": r.Host}
+	for name, values := range r.Header {
+		headers[name] = strings.Join(values, ",")
+	}
+	return headers
+}
+
+// flatten turns query or form values into JSON: a string for a name given
+// once, a list for one given more often.
+func flatten(values url.Values) map[string]any {
+	m := make(map[string]any, len(values))
+	for name, v := range values {
+		if len(v) == 1 {
+			m[name] = v[0]
+		} else {
+			m[name] = v
+		}
+	}
+	return m
+}
+
+func servePost(w http.ResponseWriter, r *http.Request) {
+	body := describe(r)
+	form := url.Values{}
+	files := map[string]string{}
+	var data string
+	var parsed any
+
+	mediaType, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";")
+	switch strings.TrimSpace(mediaType) {
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		form = r.PostForm
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(maxBytes); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		form = r.MultipartForm.Value
+		for name, fhs := range r.MultipartForm.File {
+			f, err := fhs[0].Open()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			content, _ := io.ReadAll(f)
+			f.Close()
+			files[name] = string(content)
+		}
+	default:
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data = string(raw)
+		if json.Unmarshal(raw, &parsed) != nil {
+			parsed = nil
+		}
+	}
+
+	body["data"] = data
+	body["json"] = parsed
+	body["form"] = flatten(form)
+	body["files"] = files
+	writeBin(w, http.StatusOK, body)
+}
+
+func serveStatus(w http.ResponseWriter, r *http.Request) {
+	code, err := strconv.Atoi(r.PathValue("code"))
+	if err != nil || code < 100 || code > 599 {
+		http.Error(w, "Invalid status code", http.StatusBadRequest)
+		return
+	}
+	if code >= 300 && code < 400 {
+		w.Header().Set("Location", "/get")
+	}
+	w.WriteHeader(code)
+}
+
+// serveDelay waits before answering, unless the client gives up first.
+func serveDelay(w http.ResponseWriter, r *http.Request) {
+	seconds, err := strconv.ParseFloat(r.PathValue("n"), 64)
+	if err != nil || seconds < 0 {
+		http.Error(w, "Invalid delay", http.StatusBadRequest)
+		return
+	}
+	delay := min(time.Duration(seconds*float64(time.Second)), maxDelay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		writeBin(w, http.StatusOK, describe(r))
+	case <-r.Context().Done():
+	}
+}
+
+func serveBytes(w http.ResponseWriter, r *http.Request) {
+	n, err := strconv.Atoi(r.PathValue("n"))
+	if err != nil || n < 0 {
+		http.Error(w, "Invalid length", http.StatusBadRequest)
+		return
+	}
+	n = min(n, maxBytes)
+
+	var src *rand.Rand
+	if seed, err := strconv.ParseUint(r.URL.Query().Get("seed"), 10, 64); err == nil {
+		src = rand.New(rand.NewPCG(seed, seed))
+	} else {
+		src = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(src.Uint32())
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.Itoa(n))
+	w.Write(data)
+}
+
+// serveRange serves content that is the same on every request, so it can
+// be fetched in pieces. http.ServeContent answers Range and If-Range.
+func serveRange(w http.ResponseWriter, r *http.Request) {
+	n, err := strconv.Atoi(r.PathValue("n"))
+	if err != nil || n < 0 || n > maxBytes {
+		http.Error(w, "Invalid length", http.StatusBadRequest)
+		return
+	}
+
+	const alphabet = "abcdefghijklmnopqrstuvwxyz"
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = alphabet[i%len(alphabet)]
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("ETag", fmt.Sprintf(`"range%d"`, n))
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+}
+
+func serveBasicAuth(w http.ResponseWriter, r *http.Request) {
+	user, pass, ok := r.BasicAuth()
+	if !ok || user != r.PathValue("user") || pass != r.PathValue("passwd") {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Fake Realm"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	writeBin(w, http.StatusOK, map[string]any{"authenticated": true, "user": user})
+}
+
+func serveBearer(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	writeBin(w, http.StatusOK, map[string]any{"authenticated": true, "token": token})
+}
+
+func serveCookies(w http.ResponseWriter, r *http.Request) {
+	cookies := make(map[string]string)
+	for _, c := range r.Cookies() {
+		cookies[c.Name] = c.Value
+	}
+	writeBin(w, http.StatusOK, map[string]any{"cookies": cookies})
+}
+
+func setCookies(w http.ResponseWriter, r *http.Request) {
+	for name, values := range r.URL.Query() {
+		http.SetCookie(w, &http.Cookie{Name: name, Value: values[0], Path: "/"})
+	}
+	http.Redirect(w, r, "/cookies", http.StatusFound)
+}
+
+// TokenEndpoint is a stand-in OAuth2 token endpoint, issuing tokens to one
+// client with the client credentials grant. Protect wraps handlers so they
+// only answer requests bearing an unexpired token from it, which makes a
+// whole API to try ClientCredentials against.
+type TokenEndpoint struct {
+	ClientID     string
+	ClientSecret string
+	Lifetime     time.Duration
+
+	mu     sync.Mutex
+	issued int
+	tokens map[string]time.Time // expiry by token
+}
+
+// Issued returns the number of tokens handed out so far.
+func (e *TokenEndpoint) Issued() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.issued
+}
+
+// ServeHTTP answers token requests as RFC 6749 section 4.4 describes, with
+// the client authenticated by Basic auth.
+func (e *TokenEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fail := func(status int, code, description string) {
+		if status == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", `Basic realm="token"`)
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		writeBin(w, status, map[string]string{"error": code, "error_description": description})
+	}
+	if r.Method != http.MethodPost {
+		fail(http.StatusMethodNotAllowed, "invalid_request", "token requests are POSTed")
+		return
+	}
+	if r.PostFormValue("grant_type") != "client_credentials" {
+		fail(http.StatusBadRequest, "unsupported_grant_type", "only client_credentials is supported")
+		return
+	}
+	id, secret, ok := r.BasicAuth()
+	id, _ = url.QueryUnescape(id)
+	secret, _ = url.QueryUnescape(secret)
+	if !ok || id != e.ClientID || secret != e.ClientSecret {
+		fail(http.StatusUnauthorized, "invalid_client", "unknown client or wrong secret")
+		return
+	}
+
+	e.mu.Lock()
+	if e.tokens == nil {
+		e.tokens = make(map[string]time.Time)
+	}
+	e.issued++
+	token := fmt.Sprintf("token-%d", e.issued)
+	e.tokens[token] = clock.Now().Add(e.Lifetime)
+	e.mu.Unlock()
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeBin(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int(e.Lifetime.Seconds()),
+	})
+}
+
+// Protect answers 401 Unauthorized to requests for h without a valid
+// token.
+func (e *TokenEndpoint) Protect(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		e.mu.Lock()
+		expiry, ok := e.tokens[token]
+		e.mu.Unlock()
+		if !ok || !clock.Now().Before(expiry) {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// writeBin writes v as indented JSON, as httpbin does.
+func writeBin(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
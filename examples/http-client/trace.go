@@ -0,0 +1,286 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Timing is where the time went on one request. The phases follow each
+// other: DNS lookup, TCP connect and TLS handshake (all zero when a
+// connection is reused), then time to first byte, from having a
+// connection to the first byte of the response, then the transfer of the
+// rest of the body.
+type Timing struct {
+	Method   string
+	URL      string
+	Status   int
+	Reused   bool // the connection had carried a request before
+	DNS      time.Duration
+	Connect  time.Duration
+	TLS      time.Duration
+	TTFB     time.Duration
+	Transfer time.Duration
+	Total    time.Duration // from the start of the request to the end of the body
+}
+
+// phases returns the named phases in order.
+func (t Timing) phases() []phase {
+	return []phase{
+		{"dns", t.DNS},
+		{"connect", t.Connect},
+		{"tls", t.TLS},
+		{"ttfb", t.TTFB},
+		{"transfer", t.Transfer},
+	}
+}
+
+type phase struct {
+	name string
+	d    time.Duration
+}
+
+// MarshalJSON writes the durations as milliseconds.
+func (t Timing) MarshalJSON() ([]byte, error) {
+	m := map[string]any{
+		"method":   t.Method,
+		"url":      t.URL,
+		"status":   t.Status,
+		"reused":   t.Reused,
+		"total_ms": millis(t.Total),
+	}
+	for _, p := range t.phases() {
+		m[p.name+"_ms"] = millis(p.d)
+	}
+	return json.Marshal(m)
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// TraceTransport is an http.RoundTripper that times the phases of every
+// request it carries with net/http/httptrace. A request's Timing is
+// recorded when its response body is closed.
+type TraceTransport struct {
+	Base http.RoundTripper // nil means http.DefaultTransport
+
+	mu      sync.Mutex
+	timings []Timing
+}
+
+// Timings returns the timings recorded so far, in the order the requests
+// finished.
+func (t *TraceTransport) Timings() []Timing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.timings)
+}
+
+func (t *TraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// The hooks can run on the transport's goroutines, so the times are
+	// guarded until the response is back
+	var (
+		mu                     sync.Mutex
+		start                  = time.Now()
+		dnsStart, dnsDone      time.Time
+		connectStart, connDone time.Time
+		tlsStart, tlsDone      time.Time
+		gotConn, firstByte     time.Time
+		reused                 bool
+	)
+	at := func(field *time.Time) {
+		mu.Lock()
+		defer mu.Unlock()
+		if field.IsZero() {
+			*field = time.Now()
+		}
+	}
+	trace := &httptrace.ClientTrace{
+		DNSStart:     func(httptrace.DNSStartInfo) { at(&dnsStart) },
+		DNSDone:      func(httptrace.DNSDoneInfo) { at(&dnsDone) },
+		ConnectStart: func(string, string) { at(&connectStart) },
+		ConnectDone: func(_, _ string, err error) {
+			if err == nil {
+				at(&connDone)
+			}
+		},
+		TLSHandshakeStart: func() { at(&tlsStart) },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { at(&tlsDone) },
+		GotConn: func(info httptrace.GotConnInfo) {
+			at(&gotConn)
+			mu.Lock()
+			reused = info.Reused
+			mu.Unlock()
+		},
+		GotFirstResponseByte: func() { at(&firstByte) },
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	mu.Lock()
+	timing := Timing{
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Status:  resp.StatusCode,
+		Reused:  reused,
+		DNS:     span(dnsStart, dnsDone),
+		Connect: span(connectStart, connDone),
+		TLS:     span(tlsStart, tlsDone),
+		TTFB:    span(gotConn, firstByte),
+	}
+	bodyStart := firstByte
+	mu.Unlock()
+
+	resp.Body = &timedBody{ReadCloser: resp.Body, done: func() {
+		end := time.Now()
+		timing.Transfer = span(bodyStart, end)
+		timing.Total = end.Sub(start)
+		t.mu.Lock()
+		t.timings = append(t.timings, timing)
+		t.mu.Unlock()
+	}}
+	return resp, nil
+}
+
+// span is the time from start to end, or zero if either did not happen.
+func span(start, end time.Time) time.Duration {
+	if start.IsZero() || end.IsZero() {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// timedBody calls done once, when the body is closed.
+type timedBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *timedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
+
+// Letters the waterfall draws each phase with
+var phaseMarks = map[string]byte{"dns": 'd', "connect": 'c', "tls": 's', "ttfb": 'w', "transfer": 'r'}
+
+// WriteWaterfall draws the timings as bars on a shared time scale, one
+// request to a line, with each phase in its own letter.
+func WriteWaterfall(w io.Writer, timings []Timing) {
+	const width = 50
+	var longest time.Duration
+	for _, t := range timings {
+		longest = max(longest, t.Total)
+	}
+	if longest == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "d=dns c=connect s=tls w=ttfb r=transfer")
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, t := range timings {
+		var bar strings.Builder
+		var elapsed time.Duration
+		for _, p := range t.phases() {
+			// Round the ends, not the lengths, so bars stay in step
+			from := int(elapsed * width / longest)
+			elapsed += p.d
+			to := int(elapsed * width / longest)
+			if p.d > 0 && to == from {
+				to++
+			}
+			bar.WriteString(strings.Repeat(string(phaseMarks[p.name]), to-from))
+		}
+		conn := "new"
+		if t.Reused {
+			conn = "reused"
+		}
+		fmt.Fprintf(tw, "%s %s\t%d\t%s\t|%-*s|\t%v\n",
+			t.Method, t.URL, t.Status, conn, width, bar.String(), t.Total.Round(time.Microsecond))
+	}
+	tw.Flush()
+}
+
+// PhaseSummary gives percentiles of one phase over many requests.
+type PhaseSummary struct {
+	Phase              string
+	P50, P90, P99, Max time.Duration
+}
+
+// MarshalJSON writes the durations as milliseconds, like Timing's.
+func (s PhaseSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"phase":  s.Phase,
+		"p50_ms": millis(s.P50),
+		"p90_ms": millis(s.P90),
+		"p99_ms": millis(s.P99),
+		"max_ms": millis(s.Max),
+	})
+}
+
+// Summarize returns the percentiles of every phase and of the total.
+func Summarize(timings []Timing) []PhaseSummary {
+	if len(timings) == 0 {
+		return nil
+	}
+	names := []string{"dns", "connect", "tls", "ttfb", "transfer", "total"}
+	byPhase := make(map[string][]time.Duration)
+	for _, t := range timings {
+		for _, p := range t.phases() {
+			byPhase[p.name] = append(byPhase[p.name], p.d)
+		}
+		byPhase["total"] = append(byPhase["total"], t.Total)
+	}
+
+	var summary []PhaseSummary
+	for _, name := range names {
+		ds := byPhase[name]
+		slices.Sort(ds)
+		summary = append(summary, PhaseSummary{
+			Phase: name,
+			P50:   percentile(ds, 50),
+			P90:   percentile(ds, 90),
+			P99:   percentile(ds, 99),
+			Max:   ds[len(ds)-1],
+		})
+	}
+	return summary
+}
+
+// percentile returns the nearest-rank percentile p of sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+// WriteSummary prints a table of percentiles.
+func WriteSummary(w io.Writer, summary []PhaseSummary) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "phase\tp50\tp90\tp99\tmax\t")
+	for _, s := range summary {
+		fmt.Fprintf(tw, "%s\t%v\t%v\t%v\t%v\t\n", s.Phase,
+			s.P50.Round(time.Microsecond), s.P90.Round(time.Microsecond),
+			s.P99.Round(time.Microsecond), s.Max.Round(time.Microsecond))
+	}
+	tw.Flush()
+}
@@ -0,0 +1,164 @@
+package httpserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The static files and page templates are compiled into the binary, so a
+// deployment is the one file.
+//
+//go:embed assets
+var embeddedAssets embed.FS
+
+// site serves the files under assets/: static/ as they are, and the pages
+// in templates/ rendered inside layout.html. Normally they come from the
+// binary; in development mode they are read from the source tree on every
+// request, so edits show up on reload.
+type site struct {
+	fsys fs.FS // rooted at assets/
+	dev  bool
+
+	mu    sync.Mutex
+	etags map[string]string             // by file, once computed
+	pages map[string]*template.Template // by page name, once parsed
+}
+
+// newSite returns a site serving the embedded assets, or with dev set the
+// ones on disk next to this source file.
+func newSite(dev bool) (*site, error) {
+	s := &site{dev: dev, etags: make(map[string]string), pages: make(map[string]*template.Template)}
+	if !dev {
+		s.fsys, _ = fs.Sub(embeddedAssets, "assets")
+
+		// Parse every page now, so a broken template stops the server
+		// starting rather than failing requests
+		names, _ := fs.Glob(s.fsys, "templates/*.html")
+		for _, name := range names {
+			page := strings.TrimSuffix(path.Base(name), ".html")
+			if page == "layout" {
+				continue
+			}
+			if _, err := s.page(page); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	}
+
+	_, file, _, ok := runtime.Caller(0)
+	dir := filepath.Join(filepath.Dir(file), "assets")
+	if info, err := os.Stat(dir); !ok || err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("--dev serves assets from the source tree, but %s is not there", dir)
+	}
+	s.fsys = os.DirFS(dir)
+	return s, nil
+}
+
+// page returns the named page template, parsed together with the layout.
+// Outside development mode each is parsed only once.
+func (s *site) page(name string) (*template.Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.pages[name]; ok {
+		return t, nil
+	}
+
+	t, err := template.ParseFS(s.fsys, "templates/layout.html", "templates/"+name+".html")
+	if err != nil {
+		return nil, err
+	}
+	if !s.dev {
+		s.pages[name] = t
+	}
+	return t, nil
+}
+
+// renderPage writes the named page with data. The page is rendered in full
+// before anything is sent, so a template error becomes a 500 rather than a
+// page cut off halfway.
+func (s *site) renderPage(w http.ResponseWriter, r *http.Request, status int, name string, data any) {
+	t, err := s.page(name)
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, "layout.html", data); err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+// serveStatic serves a file from static/. http.ServeContent does the
+// protocol work: the Content-Type from the extension, conditional requests
+// against the ETag set here, HEAD and Range requests. Directories are not
+// listed.
+func (s *site) serveStatic(w http.ResponseWriter, r *http.Request) {
+	name := path.Join("static", path.Clean("/"+r.PathValue("file")))
+	info, err := fs.Stat(s.fsys, name)
+	if err != nil || info.IsDir() {
+		renderError(w, r, http.StatusNotFound, "no such file")
+		return
+	}
+	data, err := fs.ReadFile(s.fsys, name)
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Embedded files have no modification time, so caches revalidate
+	// with the ETag instead
+	w.Header().Set("ETag", s.etag(name, data))
+	w.Header().Set("Cache-Control", "no-cache")
+	var modTime time.Time
+	if s.dev {
+		modTime = info.ModTime()
+	}
+	http.ServeContent(w, r, name, modTime, bytes.NewReader(data))
+}
+
+// etag returns a strong ETag for a file's content.
+func (s *site) etag(name string, data []byte) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tag, ok := s.etags[name]; ok {
+		return tag
+	}
+
+	sum := sha256.Sum256(data)
+	tag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	if !s.dev {
+		s.etags[name] = tag
+	}
+	return tag
+}
+
+// registerSite adds the static files and the pages that are not part of
+// another handler to router:
+//
+//	GET /static/{file...}  files from assets/static
+//	GET /dashboard         live metrics and messages from /events and /ws
+func registerSite(router *Router, s *site) {
+	router.HandleFunc("GET", "/static/{file...}", s.serveStatic)
+	router.HandleFunc("GET", "/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		s.renderPage(w, r, http.StatusOK, "dashboard", struct{ MaxMessage int }{wsMaxMessage})
+	})
+}
@@ -0,0 +1,50 @@
+// Shows the metrics from /events, and sends and shows chat messages over
+// /ws. EventSource reconnects by itself and resumes with Last-Event-ID;
+// the WebSocket is reopened by hand, resuming from the last ID seen.
+"use strict";
+
+const status = document.getElementById("status");
+const messages = document.getElementById("messages");
+
+function showMetrics(m) {
+  document.getElementById("time").textContent = new Date(m.time).toLocaleTimeString();
+  document.getElementById("goroutines").textContent = m.goroutines;
+  document.getElementById("heap").textContent = (m.heap_alloc_bytes / 1024).toFixed(0) + " KiB";
+  document.getElementById("subscribers").textContent = m.subscribers;
+}
+
+function showMessage(m) {
+  const li = document.createElement("li");
+  li.textContent = m.from + ": " + m.text;
+  messages.prepend(li);
+}
+
+const events = new EventSource("/events");
+events.onopen = () => { status.textContent = "connected"; };
+events.onerror = () => { status.textContent = "reconnecting…"; };
+events.addEventListener("metrics", e => showMetrics(JSON.parse(e.data)));
+
+let socket;
+let lastID = "";
+
+function connect() {
+  const scheme = location.protocol === "https:" ? "wss:" : "ws:";
+  const query = lastID ? "?last_event_id=" + lastID : "";
+  socket = new WebSocket(scheme + "//" + location.host + "/ws" + query);
+  socket.onmessage = e => {
+    const ev = JSON.parse(e.data);
+    lastID = ev.id;
+    if (ev.type === "message") showMessage(ev.data);
+  };
+  socket.onclose = () => setTimeout(connect, 3000);
+}
+connect();
+
+document.getElementById("send").addEventListener("submit", e => {
+  e.preventDefault();
+  const input = e.target.elements.text;
+  if (input.value && socket.readyState === WebSocket.OPEN) {
+    socket.send(input.value);
+    input.value = "";
+  }
+});
@@ -0,0 +1,73 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// Config holds the settings that can change while the server runs. They
+// start out from the command-line flags; a --config file overrides them
+// and is read again on SIGHUP.
+type Config struct {
+	LogFormat    string   `json:"log_format"`
+	Rate         float64  `json:"rate"`
+	Burst        int      `json:"burst"`
+	CORSOrigins  []string `json:"cors_origins"`
+	DrainTimeout Duration `json:"drain_timeout"`
+}
+
+// Duration is a time.Duration written as a string such as "30s" in JSON.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"30s\": %v", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// loadConfig reads path over a copy of base, so the file only needs the
+// settings it changes.
+func loadConfig(path string, base Config) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg := base
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("%s: %v", path, err)
+	}
+	if cfg.Rate < 0 || (cfg.Rate > 0 && cfg.Burst < 1) {
+		return Config{}, fmt.Errorf("%s: rate must not be negative and burst must be at least 1", path)
+	}
+	return cfg, nil
+}
+
+// swapHandler serves through whichever handler was stored last, so a
+// reload can replace the middleware chain without touching the listener
+// or the connections already open.
+type swapHandler struct {
+	current atomic.Pointer[http.Handler]
+}
+
+func (s *swapHandler) Store(h http.Handler) {
+	s.current.Store(&h)
+}
+
+func (s *swapHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*s.current.Load()).ServeHTTP(w, r)
+}
@@ -0,0 +1,248 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Limits for the live event streams
+const (
+	eventInterval   = time.Second
+	eventHistory    = 256 // events kept for clients that reconnect
+	subscriberQueue = 32  // events a client may fall behind by
+
+	sseRetry     = 3 * time.Second  // how long browsers wait to reconnect
+	sseKeepAlive = 15 * time.Second // idle time before a keep-alive comment
+	sseWriteWait = 10 * time.Second
+)
+
+// event is one update pushed to the dashboards. IDs count up from 1 for
+// as long as the server runs.
+type event struct {
+	ID   uint64          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// sse formats the event for an event stream. The data is a single line
+// of JSON, so one data field carries it.
+func (ev event) sse() string {
+	return fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type, ev.Data)
+}
+
+// metrics is the data of the "metrics" event published on every tick.
+type metrics struct {
+	Time        time.Time `json:"time"`
+	Goroutines  int       `json:"goroutines"`
+	HeapAlloc   uint64    `json:"heap_alloc_bytes"`
+	Subscribers int       `json:"subscribers"`
+}
+
+// message is the data of a "message" event, sent by a WebSocket client.
+type message struct {
+	From string `json:"from"`
+	Text string `json:"text"`
+}
+
+// eventHub fans events out to every subscribed stream. It keeps the most
+// recent ones so a client that reconnects can pick up where it left off.
+// A subscriber that falls too far behind is dropped rather than allowed
+// to hold up the rest; it reconnects and catches up from the history.
+type eventHub struct {
+	mu      sync.Mutex
+	lastID  uint64
+	history []event
+	subs    map[*subscriber]struct{}
+	closed  bool
+	stop    chan struct{}
+
+	origins atomic.Pointer[[]string] // other origins whose pages may open a WebSocket
+}
+
+type subscriber struct {
+	ch   chan event
+	slow bool // dropped for falling behind, not by shutdown
+}
+
+// newEventHub starts a hub publishing metrics every eventInterval.
+func newEventHub() *eventHub {
+	h := &eventHub{subs: make(map[*subscriber]struct{}), stop: make(chan struct{})}
+	go h.tick()
+	return h
+}
+
+func (h *eventHub) tick() {
+	ticker := time.NewTicker(eventInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case now := <-ticker.C:
+			var mem runtime.MemStats
+			runtime.ReadMemStats(&mem)
+			h.mu.Lock()
+			subs := len(h.subs)
+			h.mu.Unlock()
+
+			h.publish("metrics", metrics{
+				Time:        now.UTC().Truncate(time.Millisecond),
+				Goroutines:  runtime.NumGoroutine(),
+				HeapAlloc:   mem.HeapAlloc,
+				Subscribers: subs,
+			})
+		}
+	}
+}
+
+// publish sends an event of type typ with v as its data.
+func (h *eventHub) publish(typ string, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err) // only ever called with the types above
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return
+	}
+
+	h.lastID++
+	ev := event{ID: h.lastID, Type: typ, Data: data}
+	h.history = append(h.history, ev)
+	if len(h.history) > eventHistory {
+		h.history = h.history[1:]
+	}
+	for sub := range h.subs {
+		select {
+		case sub.ch <- ev:
+		default:
+			sub.slow = true
+			delete(h.subs, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// subscribe starts a subscription. lastEventID is the last event the
+// client saw, if it is reconnecting; the events after it that are still in
+// the history are returned, to be sent before anything from the channel.
+func (h *eventHub) subscribe(lastEventID string) ([]event, *subscriber) {
+	sub := &subscriber{ch: make(chan event, subscriberQueue)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(sub.ch)
+		return nil, sub
+	}
+	h.subs[sub] = struct{}{}
+
+	// An ID from before a restart may be ahead of ours; it cannot resume
+	var backlog []event
+	if last, err := strconv.ParseUint(lastEventID, 10, 64); err == nil && last <= h.lastID {
+		for _, ev := range h.history {
+			if ev.ID > last {
+				backlog = append(backlog, ev)
+			}
+		}
+	}
+	return backlog, sub
+}
+
+func (h *eventHub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// close ends every subscription and stops publishing. The server calls it
+// when shutting down, since Shutdown would otherwise wait for streams that
+// never finish.
+func (h *eventHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return
+	}
+	h.closed = true
+	close(h.stop)
+	for sub := range h.subs {
+		delete(h.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// registerEvents adds the live event streams to router:
+//
+//	GET /events  Server-Sent Events, resuming from Last-Event-ID
+//	GET /ws      the same events over a WebSocket, resuming from the
+//	             last_event_id query parameter
+func registerEvents(router *Router, h *eventHub) {
+	router.HandleFunc("GET", "/events", h.serveEvents)
+	router.HandleFunc("GET", "/ws", h.serveWebSocket)
+}
+
+func (h *eventHub) serveEvents(w http.ResponseWriter, r *http.Request) {
+	// EventSource sends Last-Event-ID by itself when it reconnects
+	backlog, sub := h.subscribe(r.Header.Get("Last-Event-ID"))
+	defer h.unsubscribe(sub)
+
+	hdr := w.Header()
+	hdr.Set("Content-Type", "text/event-stream")
+	hdr.Set("Cache-Control", "no-cache")
+	hdr.Set("X-Accel-Buffering", "no") // stop nginx buffering the stream
+
+	// The server's WriteTimeout would cut the stream off, so each write
+	// gets a deadline of its own instead
+	rc := http.NewResponseController(w)
+	send := func(block string) error {
+		rc.SetWriteDeadline(time.Now().Add(sseWriteWait))
+		if _, err := fmt.Fprint(w, block); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if send(fmt.Sprintf("retry: %d\n\n", sseRetry.Milliseconds())) != nil {
+		return
+	}
+	for _, ev := range backlog {
+		if send(ev.sse()) != nil {
+			return
+		}
+	}
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		var err error
+		select {
+		case ev, ok := <-sub.ch:
+			if !ok {
+				// Shutting down or fallen behind: either way the browser
+				// reconnects and resumes from the last event it saw
+				return
+			}
+			err = send(ev.sse())
+		case <-keepAlive.C:
+			err = send(": keep-alive\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
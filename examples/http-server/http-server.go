@@ -0,0 +1,298 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/certs"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "http-server",
+		Category:    examples.Practical,
+		Description: "HTTP Server",
+		Icon:        "🖥️",
+		Run:         Run,
+	})
+}
+
+func Run() {
+	fmt.Println("=== HTTP Server ===")
+
+	// Read the server settings from the command line
+	// (gobyexample run http-server --log-format=json --drain-timeout=1m --tls)
+	flags := flag.NewFlagSet("http-server", flag.ExitOnError)
+	addr := flags.String("addr", ":8080", "Address to listen on")
+	logFormat := flags.String("log-format", LogCommon, "Access log format: common or json")
+	rate := flags.Float64("rate", 20, "Requests per second allowed per client (0 for no limit)")
+	burst := flags.Int("burst", 40, "Requests a client may make at once before the rate applies")
+	corsOrigins := flags.String("cors-origins", "*", "Comma-separated origins allowed to call the API from a browser")
+	configPath := flags.String("config", "", "JSON file overriding the settings above; reloaded on SIGHUP")
+	drainTimeout := flags.Duration("drain-timeout", 30*time.Second, "How long shutdown waits for requests in flight")
+	readyDelay := flags.Duration("ready-delay", 0, "How long readiness fails before shutdown starts draining")
+	uploadDir := flags.String("upload-dir", filepath.Join(os.TempDir(), "gobyexample-uploads"), "Directory files uploaded to /submit are stored in")
+	dev := flags.Bool("dev", false, "Serve static files and templates from the source tree, for live editing")
+	routes := flags.Bool("routes", false, "Print the route table and exit")
+	var tlsFlags certs.Config
+	tlsFlags.RegisterFlags(flags)
+	flags.Parse(os.Args[1:])
+
+	// Router matching method and path, with {parameters} in the path
+	router := NewRouter()
+
+	// Basic handler
+	// readme:begin
+	router.HandleFunc("GET", "/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "Hello, World! Requested path: %s", r.URL.Path)
+	})
+	// readme:end
+
+	// Static files and page templates, embedded in the binary
+	// (or read from the source tree with --dev)
+	pages, err := newSite(*dev)
+	if err != nil {
+		log.Fatal(err)
+	}
+	registerSite(router, pages)
+
+	// JSON REST resource with create, read, update and delete
+	api := router.Group("/api")
+	registerUsers(api)
+
+	// Form handler with validated file uploads, stored under --upload-dir
+	if err := os.MkdirAll(*uploadDir, 0o750); err != nil {
+		log.Fatal("Error creating the upload directory:", err)
+	}
+	registerSubmit(router, *uploadDir, pages)
+
+	// Headers handler, answering in JSON, XML or text as the client accepts
+	router.HandleFunc("GET", "/headers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Custom-Header", "CustomValue")
+		info := requestInfo{Method: r.Method, Path: r.URL.Path, Host: r.Host, Headers: headerFields(r.Header)}
+
+		// Over TLS, say which version was negotiated and who the client is
+		if r.TLS != nil {
+			info.TLS = &tlsInfo{Version: tls.VersionName(r.TLS.Version), ClientSubject: certs.PeerSubject(r.TLS)}
+		}
+		render(w, r, http.StatusOK, info)
+	})
+
+	// Playground for browsing and running the other examples
+	registerPlayground(router)
+
+	// Live events pushed to dashboards over SSE and WebSocket
+	hub := newEventHub()
+	registerEvents(router, hub)
+
+	// The route table, for localhost only
+	registerDebug(router)
+	if *routes {
+		router.WriteRoutes(os.Stdout)
+		return
+	}
+
+	// Settings that a --config file can override and SIGHUP reloads
+	base := Config{
+		LogFormat:    *logFormat,
+		Rate:         *rate,
+		Burst:        *burst,
+		CORSOrigins:  strings.Split(*corsOrigins, ","),
+		DrainTimeout: Duration(*drainTimeout),
+	}
+	cfg := base
+	if *configPath != "" {
+		loaded, err := loadConfig(*configPath, base)
+		if err != nil {
+			log.Fatal("Error loading config:", err)
+		}
+		cfg = loaded
+	}
+
+	// Swappable handler, so a reload keeps every connection open
+	// (each simply sees the new settings on its next request). The rate
+	// limiter lives outside it, so a reload changes the limits without
+	// forgetting what each client has used.
+	lc := newLifecycle()
+	limiter := NewRateLimiter(cfg.Rate, cfg.Burst)
+	hub.SetOrigins(cfg.CORSOrigins)
+	handler := &swapHandler{}
+	h, err := buildHandler(cfg, lc, limiter, router)
+	if err != nil {
+		log.Fatal(err)
+	}
+	handler.Store(h)
+
+	// TLS configuration: loaded from --cert/--key or generated in memory
+	tlsConfig, generated, err := tlsFlags.ServerConfig("localhost", "127.0.0.1", "::1")
+	if err != nil {
+		log.Fatal("Error configuring TLS:", err)
+	}
+	defer generated.Remove()
+
+	// Server configuration
+	server := &http.Server{
+		Addr:         *addr,
+		Handler:      handler,
+		TLSConfig:    tlsConfig,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+	server.RegisterOnShutdown(hub.close)
+
+	scheme := "http"
+	if tlsConfig != nil {
+		scheme = "https"
+	}
+	_, port, _ := net.SplitHostPort(*addr)
+	fmt.Printf("Server starting on %s://localhost:%s\n", scheme, port)
+	if generated != nil {
+		fmt.Printf("Using a generated certificate; its CA is in %s\n", generated.CA)
+		fmt.Printf("Try: curl --cacert %s", generated.CA)
+		if generated.ClientCert != "" {
+			fmt.Printf(" --cert %s --key %s", generated.ClientCert, generated.ClientKey)
+		}
+		fmt.Printf(" https://localhost:%s/headers\n", port)
+	}
+	fmt.Println("Available endpoints:")
+	fmt.Println("  /                    - Basic response")
+	fmt.Println("  /api/users           - JSON REST resource (GET, POST, PUT, PATCH, DELETE)")
+	fmt.Println("  /submit              - Form with file upload")
+	fmt.Println("  /headers             - Request headers")
+	fmt.Println("  /playground          - Browse and run the examples")
+	fmt.Println("  /dashboard           - Live metrics page built from embedded templates")
+	fmt.Println("  /static/             - Embedded static files")
+	fmt.Println("  /events              - Server-Sent Events, one metrics event a second")
+	fmt.Println("  /ws                  - The same events over a WebSocket")
+	fmt.Println("  /healthz, /readyz    - Liveness and readiness probes")
+	fmt.Println("  /debug/routes        - The route table (from localhost only)")
+	fmt.Println("\nPress Ctrl+C to stop the server, or send SIGHUP to reload --config")
+
+	// Serve in the background so the signals can be handled here
+	// (with TLS, the certificate comes from TLSConfig)
+	serveErr := make(chan error, 1)
+	go func() {
+		if tlsConfig != nil {
+			serveErr <- server.ListenAndServeTLS("", "")
+		} else {
+			serveErr <- server.ListenAndServe()
+		}
+	}()
+
+	// SIGINT and SIGTERM shut down gracefully, SIGHUP reloads the config
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	for {
+		select {
+		case err := <-serveErr:
+			generated.Remove() // log.Fatal skips the deferred one
+			log.Fatal("Server error:", err)
+		case sig := <-sigs:
+			if sig != syscall.SIGHUP {
+				log.Printf("Received %v, shutting down", sig)
+				shutdown(server, lc, time.Duration(cfg.DrainTimeout), *readyDelay, sigs)
+				return
+			}
+			if *configPath == "" {
+				log.Print("Received SIGHUP, but there is no --config file to reload")
+				continue
+			}
+			next, err := loadConfig(*configPath, base)
+			if err == nil {
+				h, err = buildHandler(next, lc, limiter, router)
+			}
+			if err != nil {
+				log.Printf("Reload failed, keeping the current config: %v", err)
+				continue
+			}
+			cfg = next
+			limiter.SetLimit(cfg.Rate, cfg.Burst)
+			hub.SetOrigins(cfg.CORSOrigins)
+			handler.Store(h)
+			log.Printf("Reloaded config from %s", *configPath)
+		}
+	}
+}
+
+// buildHandler puts the middleware chain for cfg in front of the routes.
+// The chain is listed outermost first: each middleware sees the request
+// before those after it, and the response after them. The limiter's limits
+// are set by the caller.
+func buildHandler(cfg Config, lc *lifecycle, limiter *RateLimiter, routes http.Handler) (http.Handler, error) {
+	accessLog, err := AccessLog(os.Stdout, cfg.LogFormat)
+	if err != nil {
+		return nil, err
+	}
+	middleware := []Middleware{
+		RequestID,
+		accessLog,
+		lc.Track,
+		Recover,
+		CORS(CORSOptions{
+			AllowedOrigins: cfg.CORSOrigins,
+			AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"},
+			AllowedHeaders: []string{"Content-Type", "If-Match", "If-None-Match", "X-Request-ID"},
+			ExposedHeaders: []string{"ETag", "Location", "X-Request-ID", "X-Total-Count"},
+			MaxAge:         time.Hour,
+		}),
+		limiter.Limit,
+		Gzip,
+	}
+	return Chain(routes, middleware...), nil
+}
+
+// shutdown stops the server without dropping requests. The readiness
+// probe fails first, and readyDelay gives a load balancer time to notice
+// and stop sending traffic. Then the listener closes, event streams are
+// told to end, and requests already running get until drainTimeout to
+// finish; any still going after that, or after a second signal, are logged
+// and their connections closed.
+func shutdown(server *http.Server, lc *lifecycle, drainTimeout, readyDelay time.Duration, sigs <-chan os.Signal) {
+	// Too late to reload, and a SIGHUP is not a reason to stop waiting
+	signal.Ignore(syscall.SIGHUP)
+	lc.stopReady()
+	if readyDelay > 0 {
+		log.Printf("Readiness probe failing, waiting %v before draining", readyDelay)
+		select {
+		case <-time.After(readyDelay):
+		case <-sigs:
+		}
+	}
+
+	lc.startDrain()
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-sigs:
+			log.Print("Received a second signal, not waiting any longer")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	log.Printf("Draining connections for up to %v", drainTimeout)
+	err := server.Shutdown(ctx)
+	if err == nil {
+		err = lc.wait(ctx)
+	}
+	if err != nil {
+		lc.logInFlight("Force-closed")
+		server.Close()
+		return
+	}
+	log.Print("Server stopped")
+}
@@ -0,0 +1,125 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// lifecycle follows the server through a graceful shutdown. First the
+// readiness probe starts failing, so a load balancer stops sending new
+// traffic; then, while open connections drain, any request that still
+// arrives is turned away with 503. It also keeps a list of requests in
+// flight, to report the ones a drain deadline cuts off.
+type lifecycle struct {
+	mu       sync.Mutex
+	ready    bool
+	draining bool
+	inFlight map[*http.Request]time.Time
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{ready: true, inFlight: make(map[*http.Request]time.Time)}
+}
+
+// Health probe paths. They are answered by Track itself, so they work
+// whatever else the server is doing.
+const (
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+)
+
+// stopReady makes the readiness probe fail.
+func (lc *lifecycle) stopReady() {
+	lc.mu.Lock()
+	lc.ready = false
+	lc.mu.Unlock()
+}
+
+// startDrain turns away requests from now on.
+func (lc *lifecycle) startDrain() {
+	lc.mu.Lock()
+	lc.ready, lc.draining = false, true
+	lc.mu.Unlock()
+}
+
+// Track answers the health probes, rejects requests during a drain and
+// records the rest while they run.
+func (lc *lifecycle) Track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lc.mu.Lock()
+		ready, draining := lc.ready, lc.draining
+		if !draining {
+			lc.inFlight[r] = time.Now()
+		}
+		lc.mu.Unlock()
+
+		switch {
+		case r.URL.Path == livenessPath:
+			// Alive for as long as the process can answer
+			fmt.Fprintln(w, "ok")
+		case r.URL.Path == readinessPath && !ready:
+			renderError(w, r, http.StatusServiceUnavailable, "not ready: shutting down")
+		case r.URL.Path == readinessPath:
+			fmt.Fprintln(w, "ready")
+		case draining:
+			w.Header().Set("Connection", "close")
+			w.Header().Set("Retry-After", "1")
+			renderError(w, r, http.StatusServiceUnavailable, "server is shutting down")
+		default:
+			next.ServeHTTP(w, r)
+		}
+
+		if !draining {
+			lc.mu.Lock()
+			delete(lc.inFlight, r)
+			lc.mu.Unlock()
+		}
+	})
+}
+
+// wait returns once no requests are in flight, or with ctx's error if it
+// is done first. Server.Shutdown does the same for ordinary requests, but
+// leaves hijacked connections such as WebSockets to look after themselves.
+func (lc *lifecycle) wait(ctx context.Context) error {
+	poll := time.NewTicker(50 * time.Millisecond)
+	defer poll.Stop()
+	for {
+		lc.mu.Lock()
+		n := len(lc.inFlight)
+		lc.mu.Unlock()
+		if n == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-poll.C:
+		}
+	}
+}
+
+// logInFlight logs the requests still running, oldest first.
+func (lc *lifecycle) logInFlight(why string) {
+	lc.mu.Lock()
+	type entry struct {
+		r     *http.Request
+		start time.Time
+	}
+	entries := make([]entry, 0, len(lc.inFlight))
+	for r, start := range lc.inFlight {
+		entries = append(entries, entry{r, start})
+	}
+	lc.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].start.Before(entries[j].start) })
+	for _, e := range entries {
+		log.Printf("%s: %s %s from %s (request %s), running for %v", why,
+			e.r.Method, e.r.URL.RequestURI(), e.r.RemoteAddr, RequestIDFrom(e.r.Context()),
+			time.Since(e.start).Round(time.Millisecond))
+	}
+}
@@ -0,0 +1,536 @@
+package httpserver
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Middleware wraps a handler with behaviour shared by every request.
+type Middleware func(http.Handler) http.Handler
+
+// Chain wraps h in middleware, listed from the outside in: the first one
+// sees the request first and the response last.
+func Chain(h http.Handler, middleware ...Middleware) http.Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+	return h
+}
+
+// responseRecorder remembers the status code and body size written
+// through it, for middleware that reports on the response.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(p []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	n, err := rec.ResponseWriter.Write(p)
+	rec.bytes += int64(n)
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, for
+// flushing and hijacking.
+func (rec *responseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+func (rec *responseRecorder) Flush() {
+	http.NewResponseController(rec.ResponseWriter).Flush()
+}
+
+// Hijack records a switch to another protocol, such as WebSocket, as a
+// 101 response before handing over the connection.
+func (rec *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := http.NewResponseController(rec.ResponseWriter).Hijack()
+	if err == nil && rec.status == 0 {
+		rec.status = http.StatusSwitchingProtocols
+	}
+	return conn, brw, err
+}
+
+// Request IDs
+
+type requestIDKey struct{}
+
+// maxRequestIDLength bounds the X-Request-ID accepted from clients.
+const maxRequestIDLength = 128
+
+// RequestID gives every request an ID, taken from its X-Request-ID header
+// if a proxy in front already set one, and sends it back in the response.
+// Handlers read it with RequestIDFrom.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-ID")
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set("X-Request-ID", id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+// RequestIDFrom returns the request ID stored in ctx, or "-" if none.
+func RequestIDFrom(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return id
+	}
+	return "-"
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validRequestID accepts short IDs of printable ASCII, so a client cannot
+// smuggle anything odd into the logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Panic recovery
+
+// Recover turns a panicking handler into a 500 response and logs the
+// panic with its stack, instead of letting net/http drop the connection.
+// If the response had already started, all it can do is log.
+func Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &responseRecorder{ResponseWriter: w}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler {
+				// Deliberate abort: let net/http handle it quietly
+				panic(v)
+			}
+			log.Printf("panic serving %s %s (request %s): %v\n%s",
+				r.Method, r.URL.Path, RequestIDFrom(r.Context()), v, debug.Stack())
+			if rec.status == 0 {
+				renderError(w, r, http.StatusInternalServerError, "internal server error")
+			}
+		}()
+		next.ServeHTTP(rec, r)
+	})
+}
+
+// Compression
+
+// Gzip compresses responses for clients that accept gzip. Responses that
+// are already encoded, already compressed formats, event streams and
+// partial content are sent as they are.
+func Gzip(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r) || r.Header.Get("Range") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		gw := &gzipWriter{ResponseWriter: w}
+		defer gw.close()
+		next.ServeHTTP(gw, r)
+	})
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(enc), ";")
+		if strings.TrimSpace(name) == "gzip" && strings.ReplaceAll(params, " ", "") != "q=0" {
+			return true
+		}
+	}
+	return false
+}
+
+// gzipWriter decides whether to compress when the handler writes the
+// header, since only then is the content type known.
+type gzipWriter struct {
+	http.ResponseWriter
+	zw      *gzip.Writer
+	decided bool
+}
+
+func (gw *gzipWriter) WriteHeader(status int) {
+	if !gw.decided {
+		gw.decided = true
+		h := gw.Header()
+		if status != http.StatusNoContent && status != http.StatusNotModified &&
+			status != http.StatusPartialContent && h.Get("Content-Encoding") == "" &&
+			compressible(h.Get("Content-Type")) {
+			h.Set("Content-Encoding", "gzip")
+			h.Del("Content-Length")
+			// The compressed bytes differ, so a strong ETag no longer holds
+			if etag := h.Get("ETag"); strings.HasPrefix(etag, `"`) {
+				h.Set("ETag", "W/"+etag)
+			}
+			gw.zw = gzip.NewWriter(gw.ResponseWriter)
+		}
+	}
+	gw.ResponseWriter.WriteHeader(status)
+}
+
+func (gw *gzipWriter) Write(p []byte) (int, error) {
+	if !gw.decided {
+		if gw.Header().Get("Content-Type") == "" {
+			gw.Header().Set("Content-Type", http.DetectContentType(p))
+		}
+		gw.WriteHeader(http.StatusOK)
+	}
+	if gw.zw != nil {
+		return gw.zw.Write(p)
+	}
+	return gw.ResponseWriter.Write(p)
+}
+
+// Flush sends what has been compressed so far, so streamed responses
+// still arrive as they are written.
+func (gw *gzipWriter) Flush() {
+	if gw.zw != nil {
+		gw.zw.Flush()
+	}
+	http.NewResponseController(gw.ResponseWriter).Flush()
+}
+
+func (gw *gzipWriter) Unwrap() http.ResponseWriter {
+	return gw.ResponseWriter
+}
+
+func (gw *gzipWriter) close() {
+	if gw.zw != nil {
+		gw.zw.Close()
+	}
+}
+
+// compressible reports whether gzip is likely to shrink a content type.
+func compressible(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+	switch {
+	case mediaType == "text/event-stream":
+		return false
+	case strings.HasPrefix(mediaType, "text/"):
+		return true
+	}
+	switch mediaType {
+	case "application/json", "application/xml", "application/javascript", "image/svg+xml":
+		return true
+	}
+	return false
+}
+
+// CORS
+
+// CORSOptions says which cross-origin requests browsers may make.
+type CORSOptions struct {
+	AllowedOrigins []string // origins allowed, or "*" for any
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposedHeaders []string // response headers scripts may read
+	MaxAge         time.Duration
+}
+
+// CORS answers preflight requests and adds the Access-Control headers
+// that let pages on the allowed origins call the server from JavaScript.
+func CORS(opts CORSOptions) Middleware {
+	allowed := func(origin string) bool {
+		for _, o := range opts.AllowedOrigins {
+			if o == "*" || strings.EqualFold(o, origin) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			h := w.Header()
+			h.Add("Vary", "Origin")
+			if origin == "" || !allowed(origin) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h.Set("Access-Control-Allow-Origin", origin)
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				// Preflight: answer it here rather than in the handler
+				h.Set("Access-Control-Allow-Methods", strings.Join(opts.AllowedMethods, ", "))
+				h.Set("Access-Control-Allow-Headers", strings.Join(opts.AllowedHeaders, ", "))
+				h.Set("Access-Control-Max-Age", strconv.Itoa(int(opts.MaxAge.Seconds())))
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			if len(opts.ExposedHeaders) > 0 {
+				h.Set("Access-Control-Expose-Headers", strings.Join(opts.ExposedHeaders, ", "))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Access logs
+
+// Access log formats
+const (
+	LogCommon = "common" // NCSA Common Log Format, as Apache and nginx write
+	LogJSON   = "json"   // one JSON object per line
+)
+
+// accessEntry is one line of the JSON access log.
+type accessEntry struct {
+	Time       string  `json:"time"`
+	Remote     string  `json:"remote"`
+	Method     string  `json:"method"`
+	Path       string  `json:"path"`
+	Proto      string  `json:"proto"`
+	Status     int     `json:"status"`
+	Bytes      int64   `json:"bytes"`
+	DurationMS float64 `json:"duration_ms"`
+	RequestID  string  `json:"request_id"`
+	UserAgent  string  `json:"user_agent,omitempty"`
+}
+
+// AccessLog writes a line to out for every request once it has been
+// answered, including the status code and the size of the body.
+func AccessLog(out io.Writer, format string) (Middleware, error) {
+	if format != LogCommon && format != LogJSON {
+		return nil, fmt.Errorf("unknown log format %q, want %s or %s", format, LogCommon, LogJSON)
+	}
+	logger := log.New(out, "", 0)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &responseRecorder{ResponseWriter: w}
+			defer func() {
+				if rec.status == 0 {
+					rec.status = http.StatusOK // nothing written
+				}
+				host, _, err := net.SplitHostPort(r.RemoteAddr)
+				if err != nil {
+					host = r.RemoteAddr
+				}
+
+				if format == LogJSON {
+					line, _ := json.Marshal(accessEntry{
+						Time:       start.UTC().Format(time.RFC3339Nano),
+						Remote:     host,
+						Method:     r.Method,
+						Path:       r.URL.RequestURI(),
+						Proto:      r.Proto,
+						Status:     rec.status,
+						Bytes:      rec.bytes,
+						DurationMS: float64(time.Since(start).Microseconds()) / 1000,
+						RequestID:  RequestIDFrom(r.Context()),
+						UserAgent:  r.UserAgent(),
+					})
+					logger.Print(string(line))
+					return
+				}
+
+				user := "-"
+				if name, _, ok := r.BasicAuth(); ok && name != "" {
+					user = name
+				}
+				logger.Printf("%s - %s [%s] %q %d %d", host, user,
+					start.Format("02/Jan/2006:15:04:05 -0700"),
+					r.Method+" "+r.URL.RequestURI()+" "+r.Proto, rec.status, rec.bytes)
+			}()
+			next.ServeHTTP(rec, r)
+		})
+	}, nil
+}
+
+// Rate limiting
+
+// tokenBucket is the token bucket from the rate-limiting example, with
+// fractional tokens so that slow refill rates work too.
+type tokenBucket struct {
+	tokens     float64
+	maxTokens  float64
+	refillRate float64 // tokens per second
+	lastRefill time.Time
+}
+
+// take refills the bucket for the time since the last call and takes a
+// token if there is one. If not, it says how long until there will be.
+func (b *tokenBucket) take(now time.Time) (ok bool, retryAfter time.Duration) {
+	b.tokens = min(b.maxTokens, b.tokens+now.Sub(b.lastRefill).Seconds()*b.refillRate)
+	b.lastRefill = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / b.refillRate * float64(time.Second))
+}
+
+// RateLimit allows each client, identified by IP address, rate requests
+// per second on average with bursts of up to burst. Requests over the limit
+// get 429 Too Many Requests and a Retry-After header.
+func RateLimit(rate float64, burst int) Middleware {
+	return NewRateLimiter(rate, burst).Limit
+}
+
+// RateLimiter keeps a token bucket per client for RateLimit. The limits
+// can be changed while it is in use: the buckets stay, so a reload does not
+// hand every client a fresh burst. Buckets of clients that have gone quiet
+// are dropped from time to time.
+type RateLimiter struct {
+	mu        sync.Mutex
+	rate      float64 // 0 lets every request through
+	burst     int
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// NewRateLimiter returns a limiter allowing rate requests per second with
+// bursts of up to burst. A rate of 0 turns limiting off.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	l := &RateLimiter{buckets: make(map[string]*tokenBucket)}
+	l.SetLimit(rate, burst)
+	return l
+}
+
+// SetLimit changes the limits, for new and existing buckets alike. Tokens
+// a bucket holds over the new burst are dropped.
+func (l *RateLimiter) SetLimit(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate, l.burst = rate, burst
+	for _, b := range l.buckets {
+		b.maxTokens = float64(burst)
+		b.refillRate = rate
+		b.tokens = min(b.tokens, b.maxTokens)
+	}
+}
+
+// Limit is the middleware that enforces the limits.
+func (l *RateLimiter) Limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			client = r.RemoteAddr
+		}
+		now := time.Now()
+
+		l.mu.Lock()
+		if l.rate <= 0 {
+			l.mu.Unlock()
+			next.ServeHTTP(w, r)
+			return
+		}
+		if now.Sub(l.lastSweep) > time.Minute {
+			// A bucket left alone this long is full again, so forgetting
+			// it changes nothing
+			idle := time.Duration(float64(l.burst) / l.rate * float64(time.Second))
+			for key, b := range l.buckets {
+				if now.Sub(b.lastRefill) > idle {
+					delete(l.buckets, key)
+				}
+			}
+			l.lastSweep = now
+		}
+		b, found := l.buckets[client]
+		if !found {
+			b = &tokenBucket{tokens: float64(l.burst), maxTokens: float64(l.burst), refillRate: l.rate, lastRefill: now}
+			l.buckets[client] = b
+		}
+		ok, retryAfter := b.take(now)
+		remaining := int(b.tokens)
+		burst := l.burst
+		l.mu.Unlock()
+
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(burst))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		if !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			renderError(w, r, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Access control
+
+// LocalOnly refuses requests from anywhere but the machine itself with 403
+// Forbidden, for pages that give away how the server is put together or
+// that do more than serve pages. The Host header must name the machine too:
+// a page whose own name has been rebound to 127.0.0.1 sends requests from
+// loopback, but under its name.
+func LocalOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if ip := net.ParseIP(host); err != nil || ip == nil || !ip.IsLoopback() ||
+			!localHosts[(&url.URL{Host: r.Host}).Hostname()] {
+			renderError(w, r, http.StatusForbidden, "only available from localhost")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// localHosts are the names LocalOnly and SameOrigin accept for the machine
+// itself.
+var localHosts = map[string]bool{"localhost": true, "127.0.0.1": true, "::1": true}
+
+// SameOrigin refuses with 403 Forbidden requests that a browser sent on
+// behalf of another site, such as a form on some other page posting here.
+// Browsers say where a request came from in Sec-Fetch-Site and Origin;
+// requests with neither did not come from a web page. It goes behind
+// LocalOnly, so the only origins it lets through are the machine's own.
+func SameOrigin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if site := r.Header.Get("Sec-Fetch-Site"); site != "" && site != "same-origin" && site != "none" {
+			renderError(w, r, http.StatusForbidden, "cross-site request refused")
+			return
+		}
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if u, err := url.Parse(origin); err != nil || !localHosts[u.Hostname()] {
+				renderError(w, r, http.StatusForbidden, "cross-origin request refused")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
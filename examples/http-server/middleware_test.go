@@ -0,0 +1,148 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jsonexample "github.com/saqib77official/go-by-example/examples/json"
+)
+
+// Gzip turns strong ETags weak; the users resource must still honour them
+// when a client that accepts gzip sends them back.
+func TestGzipConditionalRequests(t *testing.T) {
+	router := NewRouter()
+	registerUsers(router.Group("/api"))
+	h := Chain(router, Gzip)
+
+	rec := users.create(jsonexample.Person{Name: "Carol", Age: 40, Email: "carol@example.com"})
+	path := fmt.Sprintf("/api/users/%d", rec.ID)
+	v1 := fmt.Sprintf(`W/"%d-1"`, rec.ID)
+	v2 := fmt.Sprintf(`W/"%d-2"`, rec.ID)
+
+	// Run in order: each PATCH that succeeds moves the user on a version
+	steps := []struct {
+		name       string
+		method     string
+		header     string
+		etag       string
+		gzip       bool
+		wantStatus int
+		wantETag   string
+	}{
+		{"get gzipped", "GET", "", "", true, http.StatusOK, v1},
+		{"get plain", "GET", "", "", false, http.StatusOK, strings.TrimPrefix(v1, "W/")},
+		{"not modified, weak", "GET", "If-None-Match", v1, true, http.StatusNotModified, ""},
+		{"not modified, strong", "GET", "If-None-Match", strings.TrimPrefix(v1, "W/"), true, http.StatusNotModified, ""},
+		{"modified", "GET", "If-None-Match", `W/"0-0"`, true, http.StatusOK, v1},
+		{"patch with weak tag", "PATCH", "If-Match", v1, true, http.StatusOK, v2},
+		{"patch with stale tag", "PATCH", "If-Match", v1, true, http.StatusPreconditionFailed, ""},
+		{"patch with strong tag", "PATCH", "If-Match", strings.TrimPrefix(v2, "W/"), false, http.StatusOK, ""},
+	}
+	for _, step := range steps {
+		var body *strings.Reader
+		if step.method == "PATCH" {
+			body = strings.NewReader(`{"age": 41}`)
+		} else {
+			body = strings.NewReader("")
+		}
+		req := httptest.NewRequest(step.method, path, body)
+		req.Header.Set("Content-Type", "application/json")
+		if step.gzip {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+		if step.header != "" {
+			req.Header.Set(step.header, step.etag)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != step.wantStatus {
+			t.Errorf("%s: status %d, want %d", step.name, w.Code, step.wantStatus)
+		}
+		if step.wantETag != "" && w.Header().Get("ETag") != step.wantETag {
+			t.Errorf("%s: ETag %s, want %s", step.name, w.Header().Get("ETag"), step.wantETag)
+		}
+	}
+}
+
+func TestLocalOnlySameOrigin(t *testing.T) {
+	h := Chain(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), LocalOnly, SameOrigin)
+
+	tests := []struct {
+		name       string
+		remote     string
+		host       string
+		site       string
+		origin     string
+		wantStatus int
+	}{
+		{"curl", "127.0.0.1:5000", "localhost:8080", "", "", http.StatusOK},
+		{"ipv6 loopback", "[::1]:5000", "[::1]:8080", "", "", http.StatusOK},
+		{"loopback address as host", "127.0.0.1:5000", "127.0.0.1", "", "", http.StatusOK},
+		{"own page", "127.0.0.1:5000", "localhost:8080", "same-origin", "http://localhost:8080", http.StatusOK},
+		{"other loopback port", "127.0.0.1:5000", "localhost:8080", "", "http://127.0.0.1:3000", http.StatusOK},
+		{"remote client", "192.0.2.1:5000", "localhost:8080", "", "", http.StatusForbidden},
+		{"rebound name", "127.0.0.1:5000", "evil.example:8080", "same-origin", "http://evil.example:8080", http.StatusForbidden},
+		{"cross site", "127.0.0.1:5000", "localhost:8080", "cross-site", "http://evil.example", http.StatusForbidden},
+		{"foreign origin", "127.0.0.1:5000", "localhost:8080", "", "http://evil.example", http.StatusForbidden},
+		{"null origin", "127.0.0.1:5000", "localhost:8080", "", "null", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest("POST", "/playground/run/hello-world", nil)
+		req.RemoteAddr = tt.remote
+		req.Host = tt.host
+		if tt.site != "" {
+			req.Header.Set("Sec-Fetch-Site", tt.site)
+		}
+		if tt.origin != "" {
+			req.Header.Set("Origin", tt.origin)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != tt.wantStatus {
+			t.Errorf("%s: status %d, want %d", tt.name, w.Code, tt.wantStatus)
+		}
+	}
+}
+
+// Changing the limits, as a reload does, keeps what clients have used.
+func TestRateLimiterSetLimit(t *testing.T) {
+	limiter := NewRateLimiter(0.001, 2)
+	h := limiter.Limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	steps := []struct {
+		name       string
+		rate       float64 // if set, the new rate before the request
+		burst      int
+		remote     string
+		wantStatus int
+	}{
+		{"first", 0, 0, "192.0.2.1:1000", http.StatusOK},
+		{"second", 0, 0, "192.0.2.1:1001", http.StatusOK},
+		{"over the burst", 0, 0, "192.0.2.1:1002", http.StatusTooManyRequests},
+		{"other client", 0, 0, "192.0.2.2:1000", http.StatusOK},
+		{"larger burst after reload", 0.001, 5, "192.0.2.1:1003", http.StatusTooManyRequests},
+		{"limiting off", -1, 0, "192.0.2.1:1004", http.StatusOK},
+		{"limiting back on", 0.001, 5, "192.0.2.1:1005", http.StatusTooManyRequests},
+	}
+	for _, step := range steps {
+		switch {
+		case step.rate < 0:
+			limiter.SetLimit(0, 0)
+		case step.rate > 0:
+			limiter.SetLimit(step.rate, step.burst)
+		}
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = step.remote
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != step.wantStatus {
+			t.Errorf("%s: status %d, want %d", step.name, w.Code, step.wantStatus)
+		}
+	}
+}
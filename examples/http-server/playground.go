@@ -0,0 +1,306 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+// Limits for examples run from the playground
+const (
+	runTimeout  = 10 * time.Second
+	maxOutput   = 64 << 10 // bytes
+	maxParallel = 4        // examples running at once
+)
+
+var errOutputLimit = errors.New("output limit reached")
+
+// runSlots is a semaphore that bounds how many examples run at once
+var runSlots = make(chan struct{}, maxParallel)
+
+// registerPlayground adds the playground pages to router:
+//
+//	GET  /playground               examples grouped by category
+//	GET  /playground/source/{name} source with its section headings
+//	POST /playground/run/{name}    run it, streaming the output back
+//
+// Running starts processes on the machine, so only the machine itself may
+// do it, and not from pages on other sites it happens to have open.
+func registerPlayground(router *Router) {
+	router.HandleFunc("GET", "/playground", playgroundIndex)
+	router.HandleFunc("GET", "/playground/source/{name}", playgroundSource)
+	run := router.Group("/playground/run", LocalOnly, SameOrigin)
+	run.HandleFunc("POST", "/{name}", playgroundRun)
+}
+
+type categoryGroup struct {
+	Category examples.Category
+	Examples []examples.Example
+}
+
+func playgroundIndex(w http.ResponseWriter, r *http.Request) {
+	var groups []categoryGroup
+	for _, e := range examples.All() {
+		if len(groups) == 0 || groups[len(groups)-1].Category != e.Category {
+			groups = append(groups, categoryGroup{Category: e.Category})
+		}
+		last := &groups[len(groups)-1]
+		last.Examples = append(last.Examples, e)
+	}
+
+	if err := indexTemplate.Execute(w, groups); err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// sourceLine is one line of an example's source. Lines that open a
+// numbered section carry the section so the page can link to them.
+type sourceLine struct {
+	Text    string
+	Section *examples.Section
+}
+
+func playgroundSource(w http.ResponseWriter, r *http.Request) {
+	e, ok := examples.Lookup(r.PathValue("name"))
+	if !ok {
+		renderError(w, r, http.StatusNotFound, "no such example")
+		return
+	}
+
+	src, err := e.Source()
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Section functions start with their "// N. Title" doc comment, or
+	// print a "--- Title ---" banner in the older examples
+	headings := make(map[string]*examples.Section)
+	for i := range e.Sections {
+		s := &e.Sections[i]
+		headings["// "+s.String()] = s
+		headings[fmt.Sprintf("\tfmt.Println(\"--- %s ---\")", s.Title)] = s
+		headings[fmt.Sprintf("\tfmt.Println(\"\\n--- %s ---\")", s.Title)] = s
+	}
+
+	var lines []sourceLine
+	for _, text := range strings.Split(src, "\n") {
+		lines = append(lines, sourceLine{Text: text, Section: headings[text]})
+	}
+
+	data := struct {
+		Example examples.Example
+		Lines   []sourceLine
+	}{e, lines}
+	if err := sourceTemplate.Execute(w, data); err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// playgroundRun runs an example in a child gobyexample process and streams
+// its combined output to the client as it is produced. The form values
+// "section" and "args" select a section and pass arguments.
+func playgroundRun(w http.ResponseWriter, r *http.Request) {
+	e, ok := examples.Lookup(r.PathValue("name"))
+	if !ok {
+		renderError(w, r, http.StatusNotFound, "no such example")
+		return
+	}
+
+	target := e.Name
+	if section := r.FormValue("section"); section != "" {
+		s, err := e.Section(section)
+		if err != nil {
+			renderError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+		target = fmt.Sprintf("%s#%d", e.Name, s.Number)
+	}
+
+	select {
+	case runSlots <- struct{}{}:
+		defer func() { <-runSlots }()
+	default:
+		renderError(w, r, http.StatusServiceUnavailable, "too many examples running, try again shortly")
+		return
+	}
+
+	binary, err := os.Executable()
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Examples write files into the working directory, so give each run
+	// a scratch directory of its own
+	dir, err := os.MkdirTemp("", "playground-")
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// The run stops when it times out, overflows or the client goes away
+	ctx, cancel := context.WithTimeout(r.Context(), runTimeout)
+	defer cancel()
+
+	args := append([]string{"run", target}, strings.Fields(r.FormValue("args"))...)
+	cmd := exec.CommandContext(ctx, binary, args...)
+	cmd.Dir = dir
+	out := &streamWriter{w: w, limit: maxOutput, cancel: cancel}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	// Anything the example starts may hold on to the output after it is
+	// killed; stop waiting for it shortly after
+	cmd.WaitDelay = time.Second
+
+	// The server's WriteTimeout is meant for ordinary responses; this one
+	// may legitimately stream for the whole run
+	http.NewResponseController(w).SetWriteDeadline(time.Now().Add(runTimeout + 5*time.Second))
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	start := time.Now()
+	err = cmd.Run()
+	duration := time.Since(start).Round(time.Millisecond)
+
+	var exitErr *exec.ExitError
+	switch {
+	case out.truncated:
+		fmt.Fprintf(w, "\n[output truncated at %d KiB]\n", maxOutput>>10)
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		fmt.Fprintf(w, "\n[timed out after %v]\n", runTimeout)
+	case errors.As(err, &exitErr):
+		fmt.Fprintf(w, "\n[exit status %d after %v]\n", exitErr.ExitCode(), duration)
+	case err != nil:
+		fmt.Fprintf(w, "\n[error: %v]\n", err)
+	default:
+		fmt.Fprintf(w, "\n[finished in %v]\n", duration)
+	}
+}
+
+// streamWriter copies output to an HTTP response, flushing after every
+// write so the browser sees it straight away. Once limit bytes have been
+// written it cancels the run and drops the rest.
+type streamWriter struct {
+	w         io.Writer
+	written   int
+	limit     int
+	truncated bool
+	cancel    context.CancelFunc
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if s.truncated {
+		return 0, errOutputLimit
+	}
+	if room := s.limit - s.written; len(p) > room {
+		p = p[:room]
+		s.truncated = true
+		s.cancel()
+	}
+
+	n, err := s.w.Write(p)
+	s.written += n
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	if err == nil && s.truncated {
+		err = errOutputLimit
+	}
+	return n, err
+}
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Go by Example Playground</title>
+` + playgroundStyle + `
+</head>
+<body>
+<h1>Go by Example Playground</h1>
+{{range .}}
+<h2>{{.Category}}</h2>
+<ul>
+{{range .Examples}}  <li><a href="/playground/source/{{.Name}}">{{.Name}}</a> &ndash; {{.Description}}</li>
+{{end}}</ul>
+{{end}}
+</body>
+</html>
+`))
+
+var sourceTemplate = template.Must(template.New("source").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Example.Name}} - Go by Example Playground</title>
+` + playgroundStyle + `
+</head>
+<body>
+<p><a href="/playground">&larr; All examples</a></p>
+<h1>{{.Example.Name}}</h1>
+<p>{{.Example.Category}} &middot; {{.Example.Description}}</p>
+
+<form id="run">
+  <input name="args" placeholder="arguments">
+  <button>Run</button>
+</form>
+{{with .Example.Sections}}
+<h2>Sections</h2>
+<ol>
+{{range .}}  <li value="{{.Number}}"><a href="#section-{{.Number}}">{{.Title}}</a>
+    <button class="section" data-section="{{.Number}}">Run</button></li>
+{{end}}</ol>
+{{end}}
+<pre id="output" hidden></pre>
+
+<h2>Source</h2>
+<pre class="source">{{range .Lines}}{{if .Section}}<b id="section-{{.Section.Number}}">{{.Text}}</b>{{else}}{{.Text}}{{end}}
+{{end}}</pre>
+
+<script>
+const output = document.getElementById("output");
+const form = document.getElementById("run");
+
+async function run(section) {
+  const body = new URLSearchParams(new FormData(form));
+  if (section) body.set("section", section);
+
+  output.hidden = false;
+  output.textContent = "";
+  output.scrollIntoView();
+
+  const resp = await fetch("/playground/run/{{.Example.Name}}", {method: "POST", body});
+  const reader = resp.body.pipeThrough(new TextDecoderStream()).getReader();
+  for (;;) {
+    const {value, done} = await reader.read();
+    if (done) break;
+    output.textContent += value;
+  }
+}
+
+form.addEventListener("submit", e => { e.preventDefault(); run(); });
+document.querySelectorAll("button.section").forEach(b =>
+  b.addEventListener("click", () => run(b.dataset.section)));
+</script>
+</body>
+</html>
+`))
+
+const playgroundStyle = `<style>
+body { font-family: sans-serif; max-width: 60em; margin: 2em auto; padding: 0 1em; }
+pre { background: #f6f8fa; padding: 1em; overflow-x: auto; }
+pre.source b { background: #fff3c4; }
+#output { background: #1e1e1e; color: #d4d4d4; max-height: 30em; }
+</style>`
@@ -0,0 +1,215 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Response rendering. Handlers hand render a value and it is written in
+// whichever of JSON, XML or plain text the client's Accept header prefers,
+// so every endpoint speaks the same formats and reports errors the same
+// way.
+
+// Media types the server can render, in order of preference when the
+// client has none
+var renderTypes = []string{"application/json", "application/xml", "text/xml", "text/plain"}
+
+// textWriter is implemented by values with a plain-text form. Others are
+// written with fmt's %v.
+type textWriter interface {
+	writeText(w io.Writer)
+}
+
+// errorBody is the body of every error response. Fields lists the
+// problems with each field of a form that failed validation.
+type errorBody struct {
+	XMLName xml.Name     `json:"-" xml:"error"`
+	Status  int          `json:"status" xml:"status,attr"`
+	Message string       `json:"error" xml:"message"`
+	Fields  []fieldError `json:"fields,omitempty" xml:"field,omitempty"`
+}
+
+type fieldError struct {
+	Field   string `json:"field" xml:"name,attr"`
+	Rule    string `json:"rule" xml:"rule,attr"`
+	Message string `json:"message" xml:",chardata"`
+}
+
+func (e errorBody) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%d %s: %s\n", e.Status, http.StatusText(e.Status), e.Message)
+	for _, f := range e.Fields {
+		fmt.Fprintf(w, "  %s: %s\n", f.Field, f.Message)
+	}
+}
+
+// render writes v with the given status in the format r asks for. If r
+// accepts none of them, the response is 406 Not Acceptable, in JSON.
+func render(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Add("Vary", "Accept")
+	mediaType := negotiate(r.Header.Get("Accept"), renderTypes...)
+	if mediaType == "" {
+		mediaType = renderTypes[0]
+		status = http.StatusNotAcceptable
+		v = errorBody{Status: status, Message: "can only respond with " + strings.Join(renderTypes, ", ")}
+	}
+
+	switch mediaType {
+	case "application/json":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.Encode(v)
+	case "application/xml", "text/xml":
+		w.Header().Set("Content-Type", mediaType+"; charset=utf-8")
+		w.WriteHeader(status)
+		io.WriteString(w, xml.Header)
+		enc := xml.NewEncoder(w)
+		enc.Indent("", "  ")
+		enc.Encode(v)
+		io.WriteString(w, "\n")
+	default:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(status)
+		if t, ok := v.(textWriter); ok {
+			t.writeText(w)
+		} else {
+			fmt.Fprintf(w, "%v\n", v)
+		}
+	}
+}
+
+// renderError writes an error response with message as its text.
+func renderError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	render(w, r, status, errorBody{Status: status, Message: message})
+}
+
+// negotiate returns the offer the Accept header rates highest, the first
+// offer if there is no header, or "" if none of them is acceptable. Each
+// offer takes the quality of the most specific media range matching it,
+// and ties go to the earlier offer.
+func negotiate(accept string, offers ...string) string {
+	if strings.TrimSpace(accept) == "" {
+		return offers[0]
+	}
+
+	type mediaRange struct {
+		typ, subtype string
+		q            float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mt, params, _ := strings.Cut(part, ";")
+		typ, subtype, ok := strings.Cut(strings.ToLower(strings.TrimSpace(mt)), "/")
+		if !ok {
+			continue
+		}
+		q := 1.0
+		for _, p := range strings.Split(params, ";") {
+			k, v, _ := strings.Cut(strings.TrimSpace(p), "=")
+			if strings.EqualFold(k, "q") {
+				if f, err := strconv.ParseFloat(v, 64); err == nil {
+					q = f
+				}
+			}
+		}
+		ranges = append(ranges, mediaRange{typ, subtype, q})
+	}
+
+	best, bestQ := "", 0.0
+	for _, offer := range offers {
+		typ, subtype, _ := strings.Cut(offer, "/")
+		q, specificity := 0.0, -1
+		for _, mr := range ranges {
+			s := -1
+			switch {
+			case mr.typ == typ && mr.subtype == subtype:
+				s = 2
+			case mr.typ == typ && mr.subtype == "*":
+				s = 1
+			case mr.typ == "*" && mr.subtype == "*":
+				s = 0
+			}
+			if s > specificity {
+				q, specificity = mr.q, s
+			}
+		}
+		if q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+	return best
+}
+
+// headerFields renders request headers with every value, whether as a
+// JSON object of arrays, XML elements or text lines.
+type headerFields http.Header
+
+func (h headerFields) sortedKeys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// MarshalXML writes each header as <header name="...">, with one <value>
+// per value, since XML has no maps.
+func (h headerFields) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type header struct {
+		Name   string   `xml:"name,attr"`
+		Values []string `xml:"value"`
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, k := range h.sortedKeys() {
+		if err := e.EncodeElement(header{k, h[k]}, xml.StartElement{Name: xml.Name{Local: "header"}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+func (h headerFields) writeText(w io.Writer) {
+	for _, k := range h.sortedKeys() {
+		for _, v := range h[k] {
+			fmt.Fprintf(w, "%s: %s\n", k, v)
+		}
+	}
+}
+
+// requestInfo is the body of /headers.
+type requestInfo struct {
+	XMLName xml.Name     `json:"-" xml:"request"`
+	Method  string       `json:"method" xml:"method,attr"`
+	Path    string       `json:"path" xml:"path,attr"`
+	Host    string       `json:"host" xml:"host"`
+	Headers headerFields `json:"headers" xml:"headers"`
+	TLS     *tlsInfo     `json:"tls,omitempty" xml:"tls,omitempty"`
+}
+
+type tlsInfo struct {
+	Version       string `json:"version" xml:"version"`
+	ClientSubject string `json:"client_subject" xml:"client_subject,omitempty"`
+}
+
+func (info requestInfo) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%s %s\nHost: %s\n", info.Method, info.Path, info.Host)
+	info.Headers.writeText(w)
+	if info.TLS != nil {
+		fmt.Fprintf(w, "TLS: %s", info.TLS.Version)
+		if info.TLS.ClientSubject != "" {
+			fmt.Fprintf(w, ", client certificate %s", info.TLS.ClientSubject)
+		}
+		fmt.Fprintln(w)
+	}
+}
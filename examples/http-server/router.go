@@ -0,0 +1,396 @@
+package httpserver
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	urlparsing "github.com/saqib77official/go-by-example/examples/url-parsing"
+)
+
+// Router dispatches requests by method and path. Patterns are made of
+// segments, each one literal text or a parameter:
+//
+//	/api/users/{id}        any single segment, read with r.PathValue("id")
+//	/api/users/{id:int}    a segment passing the "int" check in paramTypes
+//	/static/{file...}      everything left of the path, slashes and all
+//
+// When several routes match a path the most specific wins, comparing
+// segment by segment: literal text, then a typed parameter, then an
+// untyped one, then a rest parameter. A path that matches but not for the
+// request's method gets 405 Method Not Allowed, with an Allow header
+// listing the methods that would have worked. GET routes also answer HEAD.
+type Router struct {
+	routes []*route
+}
+
+// NewRouter returns an empty router.
+func NewRouter() *Router {
+	return &Router{}
+}
+
+// paramTypes are the constraints a parameter may name after a colon.
+var paramTypes = map[string]func(string) bool{
+	"int":   isDigits,
+	"alpha": regexp.MustCompile(`^[A-Za-z]+$`).MatchString,
+	"slug":  regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`).MatchString,
+	"uuid":  regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`).MatchString,
+}
+
+// isDigits accepts a decimal number that fits in an int.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+type route struct {
+	method     string
+	pattern    string
+	segments   []segment
+	handler    http.Handler
+	name       string   // of the handler, for the route table
+	middleware []string // names of the group middleware, outermost first
+}
+
+// segment is one part of a pattern.
+type segment struct {
+	literal string
+	param   string
+	typ     string            // constraint name, if any
+	check   func(string) bool // the constraint itself
+	rest    bool
+}
+
+// Segment kinds from most to least specific
+const (
+	rankRest = iota
+	rankParam
+	rankTyped
+	rankLiteral
+)
+
+func (s segment) rank() int {
+	switch {
+	case s.rest:
+		return rankRest
+	case s.param == "":
+		return rankLiteral
+	case s.check != nil:
+		return rankTyped
+	}
+	return rankParam
+}
+
+func (s segment) String() string {
+	switch {
+	case s.param == "":
+		return s.literal
+	case s.rest:
+		return "{" + s.param + "...}"
+	case s.typ != "":
+		return "{" + s.param + ":" + s.typ + "}"
+	}
+	return "{" + s.param + "}"
+}
+
+// parsePattern splits a pattern into segments. Mistakes in a pattern are
+// programming errors, so it panics on them as http.ServeMux does.
+func parsePattern(pattern string) []segment {
+	if !strings.HasPrefix(pattern, "/") {
+		panic(fmt.Sprintf("router: pattern %q must start with /", pattern))
+	}
+
+	var segments []segment
+	seen := make(map[string]bool)
+	parts := urlparsing.Segments(pattern)
+	for i, part := range parts {
+		inner, isParam := strings.CutPrefix(part, "{")
+		if !isParam {
+			segments = append(segments, segment{literal: part})
+			continue
+		}
+		inner, closed := strings.CutSuffix(inner, "}")
+		if !closed || inner == "" {
+			panic(fmt.Sprintf("router: bad parameter %q in pattern %q", part, pattern))
+		}
+
+		var s segment
+		if name, ok := strings.CutSuffix(inner, "..."); ok {
+			if i != len(parts)-1 {
+				panic(fmt.Sprintf("router: %q must be the last segment of %q", part, pattern))
+			}
+			s = segment{param: name, rest: true}
+		} else {
+			name, typ, _ := strings.Cut(inner, ":")
+			s = segment{param: name, typ: typ}
+			if typ != "" {
+				if s.check = paramTypes[typ]; s.check == nil {
+					panic(fmt.Sprintf("router: unknown parameter type %q in pattern %q", typ, pattern))
+				}
+			}
+		}
+		if seen[s.param] {
+			panic(fmt.Sprintf("router: parameter %q appears twice in pattern %q", s.param, pattern))
+		}
+		seen[s.param] = true
+		segments = append(segments, s)
+	}
+	return segments
+}
+
+// match reports whether the path segments fit the route, returning the
+// parameter values if they do.
+func (rt *route) match(parts []string) (map[string]string, bool) {
+	var params map[string]string
+	set := func(name, value string) {
+		if params == nil {
+			params = make(map[string]string)
+		}
+		params[name] = value
+	}
+
+	for i, s := range rt.segments {
+		if s.rest {
+			set(s.param, strings.Join(parts[i:], "/"))
+			return params, true
+		}
+		if i >= len(parts) {
+			return nil, false
+		}
+		switch {
+		case s.param == "":
+			if parts[i] != s.literal {
+				return nil, false
+			}
+		case s.check != nil && !s.check(parts[i]):
+			return nil, false
+		default:
+			set(s.param, parts[i])
+		}
+	}
+	return params, len(parts) == len(rt.segments)
+}
+
+// moreSpecific reports whether a should be preferred to b when both match.
+func moreSpecific(a, b *route) bool {
+	for i := 0; i < len(a.segments) && i < len(b.segments); i++ {
+		if ra, rb := a.segments[i].rank(), b.segments[i].rank(); ra != rb {
+			return ra > rb
+		}
+	}
+	return len(a.segments) > len(b.segments)
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	parts := urlparsing.Segments(req.URL.EscapedPath())
+	for i, p := range parts {
+		if unescaped, err := url.PathUnescape(p); err == nil {
+			parts[i] = unescaped
+		}
+	}
+
+	// Find the most specific route for each method the path allows
+	best := make(map[string]*route)
+	bestParams := make(map[string]map[string]string)
+	for _, rt := range r.routes {
+		params, ok := rt.match(parts)
+		if !ok {
+			continue
+		}
+		if cur := best[rt.method]; cur == nil || moreSpecific(rt, cur) {
+			best[rt.method] = rt
+			bestParams[rt.method] = params
+		}
+	}
+	if len(best) == 0 {
+		renderError(w, req, http.StatusNotFound, "no route for "+req.URL.Path)
+		return
+	}
+
+	method := req.Method
+	if best[method] == nil && method == http.MethodHead {
+		method = http.MethodGet
+	}
+	rt := best[method]
+	if rt == nil {
+		allow := make([]string, 0, len(best)+1)
+		for m := range best {
+			allow = append(allow, m)
+		}
+		if best[http.MethodGet] != nil && best[http.MethodHead] == nil {
+			allow = append(allow, http.MethodHead)
+		}
+		sort.Strings(allow)
+		w.Header().Set("Allow", strings.Join(allow, ", "))
+		renderError(w, req, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	for name, value := range bestParams[method] {
+		req.SetPathValue(name, value)
+	}
+	rt.handler.ServeHTTP(w, req)
+}
+
+// Group is a set of routes sharing a path prefix and middleware.
+type Group struct {
+	router     *Router
+	prefix     string
+	middleware []Middleware
+}
+
+// Group returns a group of routes under prefix, wrapped in middleware.
+func (r *Router) Group(prefix string, middleware ...Middleware) *Group {
+	return &Group{router: r, prefix: strings.TrimSuffix(prefix, "/"), middleware: middleware}
+}
+
+// Handle registers h for method and pattern.
+func (r *Router) Handle(method, pattern string, h http.Handler) {
+	r.Group("").Handle(method, pattern, h)
+}
+
+// HandleFunc registers f for method and pattern.
+func (r *Router) HandleFunc(method, pattern string, f http.HandlerFunc) {
+	r.Group("").Handle(method, pattern, f)
+}
+
+// Group returns a group nested in g: its prefix follows g's, and its
+// middleware runs inside g's.
+func (g *Group) Group(prefix string, middleware ...Middleware) *Group {
+	return &Group{
+		router:     g.router,
+		prefix:     g.prefix + strings.TrimSuffix(prefix, "/"),
+		middleware: append(g.middleware[:len(g.middleware):len(g.middleware)], middleware...),
+	}
+}
+
+// Handle registers h for method and the pattern under the group's prefix.
+func (g *Group) Handle(method, pattern string, h http.Handler) {
+	full := g.prefix + pattern
+	if pattern == "/" && g.prefix != "" {
+		full = g.prefix
+	}
+	rt := &route{
+		method:   method,
+		segments: parsePattern(full),
+		handler:  Chain(h, g.middleware...),
+		name:     funcName(h),
+	}
+	canonical := make([]string, len(rt.segments))
+	for i, s := range rt.segments {
+		canonical[i] = s.String()
+	}
+	rt.pattern = "/" + strings.Join(canonical, "/")
+	for _, mw := range g.middleware {
+		rt.middleware = append(rt.middleware, funcName(mw))
+	}
+
+	for _, other := range g.router.routes {
+		if other.method == method && other.pattern == rt.pattern {
+			panic(fmt.Sprintf("router: %s %s registered twice", method, rt.pattern))
+		}
+	}
+	g.router.routes = append(g.router.routes, rt)
+}
+
+// HandleFunc registers f for method and the pattern under the group's
+// prefix.
+func (g *Group) HandleFunc(method, pattern string, f http.HandlerFunc) {
+	g.Handle(method, pattern, f)
+}
+
+// routeTable lists the registered routes, for --routes and /debug/routes.
+type routeTable struct {
+	XMLName xml.Name    `json:"-" xml:"routes"`
+	Routes  []routeInfo `json:"routes" xml:"route"`
+}
+
+type routeInfo struct {
+	Method     string   `json:"method" xml:"method,attr"`
+	Pattern    string   `json:"pattern" xml:"pattern,attr"`
+	Handler    string   `json:"handler" xml:"handler"`
+	Middleware []string `json:"middleware,omitempty" xml:"middleware,omitempty"`
+}
+
+// table returns the routes ordered by pattern, then method.
+func (r *Router) table() routeTable {
+	var t routeTable
+	for _, rt := range r.routes {
+		t.Routes = append(t.Routes, routeInfo{rt.method, rt.pattern, rt.name, rt.middleware})
+	}
+	sort.SliceStable(t.Routes, func(i, j int) bool {
+		a, b := t.Routes[i], t.Routes[j]
+		if a.Pattern != b.Pattern {
+			return a.Pattern < b.Pattern
+		}
+		return a.Method < b.Method
+	})
+	return t
+}
+
+// WriteRoutes writes the route table as aligned columns.
+func (r *Router) WriteRoutes(w io.Writer) {
+	r.table().writeText(w)
+}
+
+func (t routeTable) writeText(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATTERN\tHANDLER\tMIDDLEWARE")
+	for _, rt := range t.Routes {
+		mw := strings.Join(rt.Middleware, ", ")
+		if mw == "" {
+			mw = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", rt.Method, rt.Pattern, rt.Handler, mw)
+	}
+	tw.Flush()
+}
+
+// registerDebug adds pages about the server itself to router, readable only
+// from localhost:
+//
+//	GET /debug/routes  the route table
+func registerDebug(router *Router) {
+	debug := router.Group("/debug", LocalOnly)
+	debug.HandleFunc("GET", "/routes", func(w http.ResponseWriter, r *http.Request) {
+		render(w, r, http.StatusOK, router.table())
+	})
+}
+
+// funcName names a handler or middleware function for the route table,
+// without its package path or the suffixes Go gives closures and method
+// values.
+func funcName(f any) string {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Func {
+		return fmt.Sprintf("%T", f)
+	}
+	name := runtime.FuncForPC(v.Pointer()).Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = strings.TrimSuffix(name, "-fm")
+	for {
+		i := strings.LastIndex(name, ".func")
+		if i < 0 || !isDigits(name[i+len(".func"):]) {
+			break
+		}
+		name = name[:i]
+	}
+	if _, rest, ok := strings.Cut(name, "."); ok {
+		name = rest
+	}
+	return name
+}
@@ -0,0 +1,127 @@
+package httpserver
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// show answers with the route's name and the named path values.
+func show(name string, params ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, name)
+		for _, p := range params {
+			fmt.Fprintf(w, " %s=%s", p, r.PathValue(p))
+		}
+	}
+}
+
+func TestRouterParams(t *testing.T) {
+	router := NewRouter()
+	router.HandleFunc("GET", "/", show("root"))
+	api := router.Group("/api")
+	api.HandleFunc("GET", "/users", show("list"))
+	api.HandleFunc("GET", "/users/{id:int}", show("by-id", "id"))
+	api.HandleFunc("DELETE", "/users/{id:int}", show("delete", "id"))
+	api.HandleFunc("GET", "/users/me", show("me"))
+	api.HandleFunc("GET", "/users/{name}", show("by-name", "name"))
+	api.HandleFunc("GET", "/users/{id}/posts/{post:int}", show("post", "id", "post"))
+	router.HandleFunc("GET", "/static/{file...}", show("static", "file"))
+	router.HandleFunc("GET", "/static/{dir}/{file...}", show("static-dir", "dir", "file"))
+	router.HandleFunc("GET", "/posts/{slug:slug}", show("post", "slug"))
+	router.HandleFunc("GET", "/tags/{tag:alpha}", show("tag", "tag"))
+	router.HandleFunc("GET", "/items/{id:uuid}", show("item", "id"))
+
+	tests := []struct {
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string // for 200, the route name and parameters
+		wantAllow  string // for 405
+	}{
+		{"GET", "/", 200, "root", ""},
+		{"GET", "/api/users", 200, "list", ""},
+		{"GET", "/api/users/", 200, "list", ""},
+		{"GET", "/api/users/42", 200, "by-id id=42", ""},
+		{"GET", "/api/users/me", 200, "me", ""},
+		{"GET", "/api/users/bob", 200, "by-name name=bob", ""},
+		{"GET", "/api/users/-1", 200, "by-name name=-1", ""},
+		{"GET", "/api/users/99999999999999999999", 200, "by-name name=99999999999999999999", ""},
+		{"GET", "/api/users/caf%C3%A9", 200, "by-name name=café", ""},
+		{"GET", "/api/users/a%2Fb", 200, "by-name name=a/b", ""},
+		{"GET", "/api/users/7/posts/3", 200, "post id=7 post=3", ""},
+		{"GET", "/api/users/7/posts/x", 404, "", ""},
+		{"GET", "/api/users/7/extra", 404, "", ""},
+		{"GET", "/static/site.css", 200, "static-dir dir=site.css file=", ""},
+		{"GET", "/static/css/site.css", 200, "static-dir dir=css file=site.css", ""},
+		{"GET", "/static/", 200, "static file=", ""},
+		{"GET", "/posts/hello-world", 200, "post slug=hello-world", ""},
+		{"GET", "/posts/Hello", 404, "", ""},
+		{"GET", "/posts/trailing-", 404, "", ""},
+		{"GET", "/tags/golang", 200, "tag tag=golang", ""},
+		{"GET", "/tags/go1", 404, "", ""},
+		{"GET", "/items/123e4567-e89b-12d3-a456-426614174000", 200, "item id=123e4567-e89b-12d3-a456-426614174000", ""},
+		{"GET", "/items/123", 404, "", ""},
+		{"HEAD", "/api/users/42", 200, "", ""},
+		{"DELETE", "/api/users/42", 200, "delete id=42", ""},
+		{"DELETE", "/api/users/bob", 405, "", "GET, HEAD"},
+		{"POST", "/api/users/42", 405, "", "DELETE, GET, HEAD"},
+		{"GET", "/nowhere", 404, "", ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		name := tt.method + " " + tt.path
+		if w.Code != tt.wantStatus {
+			t.Errorf("%s: status %d, want %d", name, w.Code, tt.wantStatus)
+			continue
+		}
+		if tt.wantStatus == 200 && tt.method != "HEAD" && w.Body.String() != tt.wantBody {
+			t.Errorf("%s: routed to %q, want %q", name, w.Body.String(), tt.wantBody)
+		}
+		if got := w.Header().Get("Allow"); got != tt.wantAllow {
+			t.Errorf("%s: Allow %q, want %q", name, got, tt.wantAllow)
+		}
+	}
+}
+
+func TestParsePatternPanics(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"users", "must start with /"},
+		{"/users/{id", "bad parameter"},
+		{"/users/{}", "bad parameter"},
+		{"/files/{path...}/raw", "must be the last segment"},
+		{"/users/{id:float}", "unknown parameter type"},
+		{"/users/{id}/friends/{id}", "appears twice"},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				r := recover()
+				if msg, _ := r.(string); !strings.Contains(msg, tt.want) {
+					t.Errorf("parsePattern(%q) panicked with %v, want %q", tt.pattern, r, tt.want)
+				}
+			}()
+			parsePattern(tt.pattern)
+		}()
+	}
+}
+
+func TestRouterDuplicateRoute(t *testing.T) {
+	router := NewRouter()
+	router.HandleFunc("GET", "/users/{id}", show("first"))
+	defer func() {
+		if msg, _ := recover().(string); !strings.Contains(msg, "registered twice") {
+			t.Errorf("second registration panicked with %q, want 'registered twice'", msg)
+		}
+	}()
+	router.HandleFunc("GET", "/users/{id}", show("second"))
+}
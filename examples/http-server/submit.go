@@ -0,0 +1,356 @@
+package httpserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	customerrors "github.com/saqib77official/go-by-example/examples/custom-errors"
+)
+
+// Limits for form submissions
+const (
+	maxUploadBytes = 10 << 20               // per file
+	maxSubmitBytes = maxUploadBytes + 1<<20 // whole request, with room for the fields
+	maxFieldBytes  = 1 << 10                // per text field
+	maxParts       = 8                      // parts in a multipart form
+	sniffLen       = 512                    // bytes http.DetectContentType looks at
+)
+
+// uploadTypes are the content types accepted for uploads, as sniffed from
+// the data rather than taken from the client, with the extension the file
+// is stored under.
+var uploadTypes = map[string]string{
+	"image/png":                 ".png",
+	"image/jpeg":                ".jpg",
+	"image/gif":                 ".gif",
+	"image/webp":                ".webp",
+	"application/pdf":           ".pdf",
+	"text/plain; charset=utf-8": ".txt",
+}
+
+var errNotForm = errors.New("request body must be a form (application/x-www-form-urlencoded or multipart/form-data)")
+
+// submission is a form that passed validation.
+type submission struct {
+	XMLName xml.Name `json:"-" xml:"submission"`
+	Name    string   `json:"name" xml:"name"`
+	Email   string   `json:"email" xml:"email"`
+	File    *upload  `json:"file,omitempty" xml:"file,omitempty"`
+}
+
+// upload is a file stored from a submission. Files are named by their
+// SHA-256, so the client's filename never reaches the file system.
+type upload struct {
+	Filename    string `json:"filename" xml:"filename"`
+	Size        int64  `json:"size" xml:"size"`
+	ContentType string `json:"content_type" xml:"content_type"`
+	SHA256      string `json:"sha256" xml:"sha256"`
+	StoredAs    string `json:"stored_as" xml:"stored_as"`
+
+	path  string
+	fresh bool // false if an identical file was already stored
+}
+
+func (s submission) writeText(w io.Writer) {
+	fmt.Fprintf(w, "Received: Name=%s, Email=%s\n", s.Name, s.Email)
+	if f := s.File; f != nil {
+		fmt.Fprintf(w, "File: %s (%s, %d bytes) stored as %s\nSHA-256: %s\n",
+			f.Filename, f.ContentType, f.Size, f.StoredAs, f.SHA256)
+	}
+}
+
+// registerSubmit adds the form at /submit to router. GET shows it; POST takes
+// it url-encoded or as multipart with a file, which is streamed into dir.
+// Browsers get the form back with any problems shown beside their fields;
+// other clients get the result in the format they accept.
+func registerSubmit(router *Router, dir string, pages *site) {
+	router.HandleFunc("GET", "/submit", func(w http.ResponseWriter, r *http.Request) {
+		showSubmit(w, r, pages, http.StatusOK, submitPage{})
+	})
+	router.HandleFunc("POST", "/submit", func(w http.ResponseWriter, r *http.Request) {
+		postSubmit(w, r, dir, pages)
+	})
+}
+
+func postSubmit(w http.ResponseWriter, r *http.Request, dir string, pages *site) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxSubmitBytes)
+	sub, err := readSubmission(r, dir)
+
+	var problems *customerrors.ErrorAggregator
+	var tooBig *http.MaxBytesError
+	var bad badRequest
+	switch {
+	case err == nil:
+		if wantsHTML(r) {
+			showSubmit(w, r, pages, http.StatusOK, submitPage{Name: sub.Name, Email: sub.Email, Result: &sub})
+		} else {
+			render(w, r, http.StatusOK, sub)
+		}
+		return
+	case errors.As(err, &tooBig):
+		problems = &customerrors.ErrorAggregator{}
+		problems.Add(&customerrors.ValidationError{
+			Field:   "file",
+			Rule:    "max_size",
+			Message: fmt.Sprintf("the upload is larger than %d MiB", maxUploadBytes>>20),
+		})
+		showProblems(w, r, pages, http.StatusRequestEntityTooLarge, sub, problems)
+	case errors.As(err, &problems):
+		showProblems(w, r, pages, http.StatusUnprocessableEntity, sub, problems)
+	case errors.Is(err, errNotForm):
+		renderError(w, r, http.StatusUnsupportedMediaType, err.Error())
+	case errors.As(err, &bad):
+		renderError(w, r, http.StatusBadRequest, err.Error())
+	default:
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// readSubmission reads and validates the form. A file is streamed to dir
+// as it arrives, hashing it on the way, and removed again if the form
+// turns out to be invalid. Validation failures are returned together as an
+// *customerrors.ErrorAggregator of *customerrors.ValidationError; the
+// fields read so far are returned either way, to fill the form in again.
+func readSubmission(r *http.Request, dir string) (submission, error) {
+	var sub submission
+	var problems customerrors.ErrorAggregator
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return sub, badRequest{err}
+		}
+		sub.Name, sub.Email = r.PostForm.Get("name"), r.PostForm.Get("email")
+	case "multipart/form-data":
+		mr, err := r.MultipartReader()
+		if err != nil {
+			return sub, badRequest{err}
+		}
+		for n := 0; ; n++ {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				sub.discard()
+				return sub, err
+			}
+			if n == maxParts {
+				sub.discard()
+				return sub, badRequest{fmt.Errorf("forms are limited to %d parts", maxParts)}
+			}
+
+			switch part.FormName() {
+			case "name":
+				sub.Name, err = readField(part, "name")
+			case "email":
+				sub.Email, err = readField(part, "email")
+			case "file":
+				switch {
+				case part.FileName() == "":
+					// A file input left empty still sends its part
+				case sub.File != nil:
+					err = &customerrors.ValidationError{Field: "file", Value: cleanFilename(part.FileName()),
+						Rule: "max_count", Message: "only one file can be uploaded at a time"}
+				default:
+					sub.File, err = saveUpload(part, dir, cleanFilename(part.FileName()))
+				}
+			}
+			part.Close()
+
+			var invalid *customerrors.ValidationError
+			if errors.As(err, &invalid) {
+				problems.Add(invalid)
+			} else if err != nil {
+				sub.discard()
+				return sub, err
+			}
+		}
+	default:
+		return sub, errNotForm
+	}
+
+	validateSubmission(sub, &problems)
+	if problems.HasErrors() {
+		sub.discard()
+		return sub, &problems
+	}
+	return sub, nil
+}
+
+// discard removes the submission's file, unless it was stored before.
+func (s *submission) discard() {
+	if s.File != nil && s.File.fresh {
+		os.Remove(s.File.path)
+	}
+	s.File = nil
+}
+
+// readField reads the text field called name, of at most maxFieldBytes.
+func readField(part io.Reader, name string) (string, error) {
+	b, err := io.ReadAll(io.LimitReader(part, maxFieldBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if len(b) > maxFieldBytes || !utf8.Valid(b) {
+		return "", &customerrors.ValidationError{Field: name, Rule: "max_length",
+			Message: fmt.Sprintf("%s must be text of at most %d bytes", name, maxFieldBytes)}
+	}
+	return string(b), nil
+}
+
+// saveUpload streams a file part into dir. Its type is sniffed from the
+// first bytes before anything is written, and it is copied through a
+// SHA-256 hash into a temporary file, which is stored under the digest
+// once the whole file has arrived within the size limit.
+func saveUpload(part io.Reader, dir string, filename string) (*upload, error) {
+	invalid := func(rule, format string, args ...any) error {
+		return &customerrors.ValidationError{Field: "file", Value: filename, Rule: rule, Message: fmt.Sprintf(format, args...)}
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(part, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	head = head[:n]
+	if n == 0 {
+		return nil, invalid("required", "%s is empty", filename)
+	}
+	contentType := http.DetectContentType(head)
+	ext, ok := uploadTypes[contentType]
+	if !ok {
+		return nil, invalid("content_type", "%s files are not accepted; upload an image, a PDF or plain text", contentType)
+	}
+
+	tmp, err := os.CreateTemp(dir, "upload-*.part")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	src := io.LimitReader(io.MultiReader(bytes.NewReader(head), part), maxUploadBytes+1)
+	size, err := io.Copy(io.MultiWriter(tmp, hash), src)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if size > maxUploadBytes {
+		return nil, invalid("max_size", "%s is larger than %d MiB", filename, maxUploadBytes>>20)
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	up := &upload{
+		Filename:    filename,
+		Size:        size,
+		ContentType: contentType,
+		SHA256:      sum,
+		StoredAs:    sum + ext,
+		path:        filepath.Join(dir, sum+ext),
+	}
+	// Link rather than rename: it fails if the name is taken, so of two
+	// identical uploads at once exactly one stores the file and counts it
+	// as fresh, the way a create with O_EXCL would, and the file never
+	// appears half written
+	switch err := os.Link(tmp.Name(), up.path); {
+	case err == nil:
+		up.fresh = true
+	case !errors.Is(err, os.ErrExist):
+		return nil, err
+	}
+	return up, nil
+}
+
+// cleanFilename keeps the base name a browser sent, for display only, with
+// anything unprintable removed.
+func cleanFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, name)
+	if len(name) > 255 {
+		name = name[:255]
+	}
+	return name
+}
+
+// validateSubmission checks the text fields, adding a ValidationError to
+// problems for each failure.
+func validateSubmission(sub submission, problems *customerrors.ErrorAggregator) {
+	name := strings.TrimSpace(sub.Name)
+	switch {
+	case name == "":
+		problems.Add(&customerrors.ValidationError{Field: "name", Value: sub.Name, Rule: "required", Message: "name cannot be empty"})
+	case utf8.RuneCountInString(name) > 100:
+		problems.Add(&customerrors.ValidationError{Field: "name", Value: sub.Name, Rule: "max_length", Message: "name must be at most 100 characters"})
+	}
+
+	if strings.TrimSpace(sub.Email) == "" {
+		problems.Add(&customerrors.ValidationError{Field: "email", Value: sub.Email, Rule: "required", Message: "email cannot be empty"})
+	} else if addr, err := mail.ParseAddress(sub.Email); err != nil || addr.Address != strings.TrimSpace(sub.Email) {
+		problems.Add(&customerrors.ValidationError{Field: "email", Value: sub.Email, Rule: "format", Message: "email must be an address like name@example.com"})
+	}
+}
+
+// wantsHTML reports whether the client prefers a page to data, as
+// browsers do.
+func wantsHTML(r *http.Request) bool {
+	return negotiate(r.Header.Get("Accept"), append([]string{"text/html"}, renderTypes...)...) == "text/html"
+}
+
+// showProblems reports validation failures: on the form for a browser,
+// or as an error listing each field for anyone else.
+func showProblems(w http.ResponseWriter, r *http.Request, pages *site, status int, sub submission, problems *customerrors.ErrorAggregator) {
+	var fields []fieldError
+	byField := make(map[string][]string)
+	for _, err := range problems.GetErrors() {
+		var ve *customerrors.ValidationError
+		if errors.As(err, &ve) {
+			fields = append(fields, fieldError{Field: ve.Field, Rule: ve.Rule, Message: ve.Message})
+			byField[ve.Field] = append(byField[ve.Field], ve.Message)
+		}
+	}
+
+	if wantsHTML(r) {
+		showSubmit(w, r, pages, status, submitPage{Name: sub.Name, Email: sub.Email, Errors: byField})
+		return
+	}
+	render(w, r, status, errorBody{Status: status, Message: "the form has errors", Fields: fields})
+}
+
+// submitPage is what the form template shows: the values to fill in, the
+// problems with them by field, and the result once the form is accepted.
+type submitPage struct {
+	Name, Email string
+	Errors      map[string][]string
+	Result      *submission
+}
+
+func showSubmit(w http.ResponseWriter, r *http.Request, pages *site, status int, page submitPage) {
+	w.Header().Add("Vary", "Accept")
+	data := struct {
+		submitPage
+		MaxUpload int
+		Accept    string
+	}{page, maxUploadBytes >> 20, ".png,.jpg,.jpeg,.gif,.webp,.pdf,.txt"}
+	pages.renderPage(w, r, status, "submit", data)
+}
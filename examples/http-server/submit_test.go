@@ -0,0 +1,50 @@
+package httpserver
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Of several identical uploads at once, exactly one stores the file.
+func TestSaveUploadConcurrent(t *testing.T) {
+	dir := t.TempDir()
+	const n = 20
+
+	var wg sync.WaitGroup
+	uploads := make([]*upload, n)
+	errs := make([]error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			uploads[i], errs[i] = saveUpload(strings.NewReader("the same notes\n"), dir, "notes.txt")
+		}()
+	}
+	wg.Wait()
+
+	fresh := 0
+	for i, up := range uploads {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if up.fresh {
+			fresh++
+		}
+	}
+	if fresh != 1 {
+		t.Errorf("%d uploads stored the file, want 1", fresh)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != uploads[0].StoredAs {
+		t.Errorf("directory holds %v, want just %s", entries, uploads[0].StoredAs)
+	}
+	if data, _ := os.ReadFile(uploads[0].path); string(data) != "the same notes\n" {
+		t.Errorf("stored file holds %q", data)
+	}
+}
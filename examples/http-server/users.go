@@ -0,0 +1,465 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	jsonexample "github.com/saqib77official/go-by-example/examples/json"
+	xmlexample "github.com/saqib77official/go-by-example/examples/xml"
+)
+
+// Paging defaults for GET /api/users
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxBodyBytes    = 1 << 20
+)
+
+var (
+	errUserNotFound       = errors.New("user not found")
+	errPreconditionFailed = errors.New("user has changed; fetch it again and retry")
+	errNotJSON            = errors.New("request body must be application/json")
+)
+
+// User is the resource served at /api/users: the Person from the json
+// example with an ID added.
+type User struct {
+	ID int `json:"id"`
+	jsonexample.Person
+}
+
+// userXML is the XML form of a User: the xml example's <person>, with the
+// ID as an attribute.
+type userXML struct {
+	ID int `xml:"id,attr"`
+	xmlexample.Person
+	Admin bool `xml:"admin,omitempty"`
+}
+
+func (u User) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(userXML{
+		ID:     u.ID,
+		Person: xmlexample.Person{Name: u.Name, Age: u.Age, Email: u.Email},
+		Admin:  u.Admin,
+	})
+}
+
+func (u User) writeText(w io.Writer) {
+	fmt.Fprintf(w, "#%d %s <%s>, age %d", u.ID, u.Name, u.Email, u.Age)
+	if u.Admin {
+		fmt.Fprint(w, ", admin")
+	}
+	fmt.Fprintln(w)
+}
+
+// userRecord is a stored user and its version, which changes on every
+// write and is sent to clients as the ETag.
+type userRecord struct {
+	User
+	version int
+}
+
+func (r userRecord) etag() string {
+	return fmt.Sprintf(`"%d-%d"`, r.ID, r.version)
+}
+
+// userStore is the in-memory store behind /api/users. It is safe for
+// concurrent use.
+type userStore struct {
+	mu     sync.RWMutex
+	nextID int
+	users  map[int]userRecord
+}
+
+func newUserStore(people ...jsonexample.Person) *userStore {
+	s := &userStore{nextID: 1, users: make(map[int]userRecord)}
+	for _, p := range people {
+		s.create(p)
+	}
+	return s
+}
+
+// list returns the users matching filter, ordered by ID.
+func (s *userStore) list(filter func(User) bool) []User {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := []User{}
+	for _, rec := range s.users {
+		if filter(rec.User) {
+			users = append(users, rec.User)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+func (s *userStore) get(id int) (userRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rec, ok := s.users[id]
+	if !ok {
+		return userRecord{}, errUserNotFound
+	}
+	return rec, nil
+}
+
+func (s *userStore) create(p jsonexample.Person) userRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := userRecord{User: User{ID: s.nextID, Person: p}, version: 1}
+	s.users[rec.ID] = rec
+	s.nextID++
+	return rec
+}
+
+// update applies change to a user, provided ifMatch (an If-Match header,
+// possibly empty) matches its current ETag. The check and the write happen
+// under one lock, so two clients cannot both update the same version.
+func (s *userStore) update(id int, ifMatch string, change func(*jsonexample.Person) error) (userRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.users[id]
+	if !ok {
+		return userRecord{}, errUserNotFound
+	}
+	if !etagMatches(ifMatch, rec.etag()) {
+		return userRecord{}, errPreconditionFailed
+	}
+
+	p := rec.Person
+	if err := change(&p); err != nil {
+		return userRecord{}, err
+	}
+	rec.Person = p
+	rec.version++
+	s.users[id] = rec
+	return rec, nil
+}
+
+func (s *userStore) delete(id int, ifMatch string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.users[id]
+	if !ok {
+		return errUserNotFound
+	}
+	if !etagMatches(ifMatch, rec.etag()) {
+		return errPreconditionFailed
+	}
+	delete(s.users, id)
+	return nil
+}
+
+// etagMatches reports whether an If-Match or If-None-Match header names a
+// resource whose current ETag is etag. An empty header always does; "*"
+// matches any existing resource.
+//
+// The comparison is weak, ignoring any W/ prefix, for If-Match as well. A
+// user's ETag names a version of the record, not the bytes it was sent
+// as, so the weak ETag Gzip turns it into names the same version, and a
+// client that echoes it back is not writing over anyone else's change.
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+var users = newUserStore(
+	jsonexample.Person{Name: "Alice", Age: 25, Email: "alice@example.com", Admin: true},
+	jsonexample.Person{Name: "Bob", Age: 30, Email: "bob@example.com"},
+)
+
+// registerUsers adds the users resource to the /api group:
+//
+//	GET    /api/users       list, with ?limit, ?offset, ?name, ?email, ?admin, ?min_age, ?max_age
+//	POST   /api/users       create
+//	GET    /api/users/{id}  fetch, honouring If-None-Match
+//	PUT    /api/users/{id}  replace, honouring If-Match
+//	PATCH  /api/users/{id}  update the fields given, honouring If-Match
+//	DELETE /api/users/{id}  delete, honouring If-Match
+func registerUsers(api *Group) {
+	api.HandleFunc("GET", "/users", listUsers)
+	api.HandleFunc("POST", "/users", createUser)
+	api.HandleFunc("GET", "/users/{id:int}", getUser)
+	api.HandleFunc("PUT", "/users/{id:int}", updateUser)
+	api.HandleFunc("PATCH", "/users/{id:int}", updateUser)
+	api.HandleFunc("DELETE", "/users/{id:int}", deleteUser)
+}
+
+// userID returns the {id} of the request's path, which the route has
+// already checked is a number.
+func userID(r *http.Request) int {
+	id, _ := strconv.Atoi(r.PathValue("id"))
+	return id
+}
+
+// userPage is the body of GET /api/users.
+type userPage struct {
+	XMLName xml.Name `json:"-" xml:"users"`
+	Users   []User   `json:"users" xml:"person"`
+	Total   int      `json:"total" xml:"total,attr"`
+	Offset  int      `json:"offset" xml:"offset,attr"`
+	Limit   int      `json:"limit" xml:"limit,attr"`
+}
+
+func (p userPage) writeText(w io.Writer) {
+	for _, u := range p.Users {
+		u.writeText(w)
+	}
+	if len(p.Users) == 0 {
+		fmt.Fprintf(w, "No users here (%d in all)\n", p.Total)
+		return
+	}
+	fmt.Fprintf(w, "Users %d-%d of %d\n", p.Offset+1, p.Offset+len(p.Users), p.Total)
+}
+
+func listUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	limit, err := intParam(q.Get("limit"), defaultPageSize, 1, maxPageSize)
+	if err != nil {
+		renderError(w, r, http.StatusBadRequest, "limit: "+err.Error())
+		return
+	}
+	offset, err := intParam(q.Get("offset"), 0, 0, -1)
+	if err != nil {
+		renderError(w, r, http.StatusBadRequest, "offset: "+err.Error())
+		return
+	}
+	filter, err := userFilter(q)
+	if err != nil {
+		renderError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	matched := users.list(filter)
+	page := userPage{Users: []User{}, Total: len(matched), Offset: offset, Limit: limit}
+	if offset < len(matched) {
+		page.Users = matched[offset:min(offset+limit, len(matched))]
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
+	render(w, r, http.StatusOK, page)
+}
+
+// userFilter builds the filter for the list query parameters. Text
+// matches ignore case; name matches any part of the name.
+func userFilter(q url.Values) (func(User) bool, error) {
+	get := q.Get
+	name := strings.ToLower(get("name"))
+	email := get("email")
+	var admin *bool
+	if v := get("admin"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("admin: %q is not true or false", v)
+		}
+		admin = &b
+	}
+	minAge, err := intParam(get("min_age"), 0, 0, -1)
+	if err != nil {
+		return nil, fmt.Errorf("min_age: %v", err)
+	}
+	maxAge, err := intParam(get("max_age"), -1, 0, -1)
+	if err != nil {
+		return nil, fmt.Errorf("max_age: %v", err)
+	}
+
+	return func(u User) bool {
+		return strings.Contains(strings.ToLower(u.Name), name) &&
+			(email == "" || strings.EqualFold(u.Email, email)) &&
+			(admin == nil || u.Admin == *admin) &&
+			u.Age >= minAge && (maxAge < 0 || u.Age <= maxAge)
+	}, nil
+}
+
+// intParam parses an integer query parameter, using def if it is empty.
+// A negative hi means no upper bound.
+func intParam(s string, def, lo, hi int) (int, error) {
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(s)
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("%q is not a number", s)
+	case n < lo:
+		return 0, fmt.Errorf("must be at least %d", lo)
+	case hi >= 0 && n > hi:
+		return 0, fmt.Errorf("must be at most %d", hi)
+	}
+	return n, nil
+}
+
+func getUser(w http.ResponseWriter, r *http.Request) {
+	id := userID(r)
+	rec, err := users.get(id)
+	if err != nil {
+		writeUserError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", rec.etag())
+	if inm := r.Header.Get("If-None-Match"); inm != "" && etagMatches(inm, rec.etag()) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	render(w, r, http.StatusOK, rec.User)
+}
+
+func createUser(w http.ResponseWriter, r *http.Request) {
+	var u User
+	if err := decodeJSON(w, r, &u); err != nil {
+		writeUserError(w, r, err)
+		return
+	}
+	if u.ID != 0 {
+		renderError(w, r, http.StatusBadRequest, "id is assigned by the server")
+		return
+	}
+	if err := validatePerson(u.Person); err != nil {
+		renderError(w, r, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	rec := users.create(u.Person)
+	w.Header().Set("Location", fmt.Sprintf("/api/users/%d", rec.ID))
+	w.Header().Set("ETag", rec.etag())
+	render(w, r, http.StatusCreated, rec.User)
+}
+
+// updateUser handles PUT, which replaces every field, and PATCH, which
+// changes only the fields present in the body. Either may repeat the ID,
+// but not change it.
+func updateUser(w http.ResponseWriter, r *http.Request) {
+	id := userID(r)
+	var body json.RawMessage
+	if err := decodeJSON(w, r, &body); err != nil {
+		writeUserError(w, r, err)
+		return
+	}
+
+	rec, err := users.update(id, r.Header.Get("If-Match"), func(p *jsonexample.Person) error {
+		u := User{ID: id, Person: *p}
+		if r.Method == http.MethodPut {
+			u.Person = jsonexample.Person{}
+		}
+		// Decoding over the current value leaves absent fields unchanged
+		if err := strictUnmarshal(body, &u); err != nil {
+			return err
+		}
+		if u.ID != id {
+			return badRequest{errors.New("id cannot be changed")}
+		}
+		*p = u.Person
+		return validatePerson(*p)
+	})
+	if err != nil {
+		writeUserError(w, r, err)
+		return
+	}
+	w.Header().Set("ETag", rec.etag())
+	render(w, r, http.StatusOK, rec.User)
+}
+
+func deleteUser(w http.ResponseWriter, r *http.Request) {
+	id := userID(r)
+	if err := users.delete(id, r.Header.Get("If-Match")); err != nil {
+		writeUserError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validatePerson checks the fields a user must have.
+func validatePerson(p jsonexample.Person) error {
+	switch {
+	case strings.TrimSpace(p.Name) == "":
+		return invalidUser("name is required")
+	case p.Age < 0 || p.Age > 150:
+		return invalidUser("age must be between 0 and 150")
+	case !strings.Contains(p.Email, "@"):
+		return invalidUser("email must be an email address")
+	}
+	return nil
+}
+
+// invalidUser is a user that fails validation (422), and badRequest a body
+// that could not be decoded (400).
+type (
+	invalidUser string
+	badRequest  struct{ err error }
+)
+
+func (e invalidUser) Error() string { return string(e) }
+func (e badRequest) Error() string  { return e.err.Error() }
+
+// decodeJSON reads a JSON request body of at most maxBodyBytes into v,
+// rejecting unknown fields and trailing data.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	if ct := r.Header.Get("Content-Type"); ct != "" &&
+		!strings.HasPrefix(ct, "application/json") && !strings.HasPrefix(ct, "application/merge-patch+json") {
+		return errNotJSON
+	}
+
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return badRequest{fmt.Errorf("invalid JSON body: %v", err)}
+	}
+	if dec.More() {
+		return badRequest{errors.New("invalid JSON body: unexpected data after the object")}
+	}
+	return nil
+}
+
+func strictUnmarshal(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return badRequest{fmt.Errorf("invalid JSON body: %v", err)}
+	}
+	return nil
+}
+
+// writeUserError maps a store, decoding or validation error to its status
+// code.
+func writeUserError(w http.ResponseWriter, r *http.Request, err error) {
+	var invalid invalidUser
+	var bad badRequest
+	switch {
+	case errors.Is(err, errUserNotFound):
+		renderError(w, r, http.StatusNotFound, err.Error())
+	case errors.Is(err, errPreconditionFailed):
+		renderError(w, r, http.StatusPreconditionFailed, err.Error())
+	case errors.Is(err, errNotJSON):
+		renderError(w, r, http.StatusUnsupportedMediaType, err.Error())
+	case errors.As(err, &invalid):
+		renderError(w, r, http.StatusUnprocessableEntity, err.Error())
+	case errors.As(err, &bad):
+		renderError(w, r, http.StatusBadRequest, err.Error())
+	default:
+		renderError(w, r, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,431 @@
+package httpserver
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// WebSocket support following RFC 6455, written against net/http's
+// connection hijacking so it needs nothing outside the standard library.
+
+// websocketGUID is mixed into the handshake key to prove the server
+// understood the upgrade request (RFC 6455 section 1.3).
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// Frame opcodes
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+// Close status codes
+const (
+	closeNormal          = 1000
+	closeGoingAway       = 1001
+	closeProtocolError   = 1002
+	closeUnsupportedData = 1003
+	closeNoStatus        = 1005 // never sent, stands for an empty close frame
+	closeInvalidPayload  = 1007
+	closePolicyViolation = 1008
+	closeTooBig          = 1009
+)
+
+// Limits and keepalive timing for WebSocket connections. A ping goes out
+// every wsPingInterval, and a connection with nothing read for
+// wsPingInterval+wsPongWait is taken to be dead.
+const (
+	wsMaxMessage   = 4 << 10 // bytes
+	wsPingInterval = 20 * time.Second
+	wsPongWait     = 10 * time.Second
+	wsWriteWait    = 10 * time.Second
+	wsCloseWait    = 2 * time.Second // for the client to answer our close
+)
+
+// closeError is a reason to end a WebSocket connection, with the status
+// code that goes in the close frame. fromPeer marks a close frame the
+// client sent.
+type closeError struct {
+	code     int
+	reason   string
+	fromPeer bool
+}
+
+func (e *closeError) Error() string {
+	return fmt.Sprintf("websocket closed: %d %s", e.code, e.reason)
+}
+
+func protocolError(reason string) error {
+	return &closeError{code: closeProtocolError, reason: reason}
+}
+
+// wsConn reads and writes frames on a hijacked connection. Writes come
+// from both the handler and the reader answering pings, so they are
+// serialized.
+type wsConn struct {
+	conn      net.Conn
+	br        *bufio.Reader
+	mu        sync.Mutex // guards writes and closeSent
+	closeSent bool
+}
+
+// writeFrame sends payload as a single unmasked frame, as servers must.
+// Nothing more may be sent after a close frame.
+func (c *wsConn) writeFrame(op byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closeSent {
+		return net.ErrClosed
+	}
+	if op == opClose {
+		c.closeSent = true
+	}
+
+	hdr := []byte{0x80 | op, 0} // FIN set: messages are never fragmented
+	switch n := len(payload); {
+	case n < 126:
+		hdr[1] = byte(n)
+	case n <= 0xFFFF:
+		hdr[1] = 126
+		hdr = binary.BigEndian.AppendUint16(hdr, uint16(n))
+	default:
+		hdr[1] = 127
+		hdr = binary.BigEndian.AppendUint64(hdr, uint64(n))
+	}
+
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	bufs := net.Buffers{hdr, payload}
+	_, err := bufs.WriteTo(c.conn)
+	return err
+}
+
+func (c *wsConn) writeClose(code int, reason string) error {
+	payload := binary.BigEndian.AppendUint16(nil, uint16(code))
+	return c.writeFrame(opClose, append(payload, reason...))
+}
+
+// frame is one frame as read from the client, already unmasked.
+type frame struct {
+	fin     bool
+	op      byte
+	payload []byte
+}
+
+// readFrame reads the next frame, refusing data frames longer than limit.
+func (c *wsConn) readFrame(limit int) (frame, error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(c.br, hdr[:]); err != nil {
+		return frame{}, err
+	}
+	f := frame{fin: hdr[0]&0x80 != 0, op: hdr[0] & 0x0F}
+	if hdr[0]&0x70 != 0 {
+		return frame{}, protocolError("reserved bits set without an extension")
+	}
+	if hdr[1]&0x80 == 0 {
+		return frame{}, protocolError("client frames must be masked")
+	}
+
+	n := uint64(hdr[1] & 0x7F)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return frame{}, err
+		}
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return frame{}, err
+		}
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+
+	switch {
+	case f.op >= opClose && (n > 125 || !f.fin):
+		return frame{}, protocolError("control frames must be short and unfragmented")
+	case f.op < opClose && n > uint64(limit):
+		return frame{}, &closeError{code: closeTooBig, reason: fmt.Sprintf("messages are limited to %d bytes", wsMaxMessage)}
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+		return frame{}, err
+	}
+	f.payload = make([]byte, n)
+	if _, err := io.ReadFull(c.br, f.payload); err != nil {
+		return frame{}, err
+	}
+	for i := range f.payload {
+		f.payload[i] ^= mask[i%4]
+	}
+	return f, nil
+}
+
+// readMessage returns the next text or binary message, putting fragments
+// back together and answering pings along the way. Every frame read
+// pushes the read deadline back, so a client that stops answering pings
+// times out. A close frame from the client comes back as a *closeError.
+func (c *wsConn) readMessage() (op byte, msg []byte, err error) {
+	for {
+		c.conn.SetReadDeadline(time.Now().Add(wsPingInterval + wsPongWait))
+		f, err := c.readFrame(wsMaxMessage - len(msg))
+		if err != nil {
+			return 0, nil, err
+		}
+
+		switch f.op {
+		case opPing:
+			if err := c.writeFrame(opPong, f.payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			return 0, nil, parseClose(f.payload)
+		case opText, opBinary:
+			if op != 0 {
+				return 0, nil, protocolError("new message before the last one finished")
+			}
+			op = f.op
+		case opContinuation:
+			if op == 0 {
+				return 0, nil, protocolError("continuation frame with no message to continue")
+			}
+		default:
+			return 0, nil, protocolError(fmt.Sprintf("unknown opcode %#x", f.op))
+		}
+
+		msg = append(msg, f.payload...)
+		if f.fin {
+			if op == opText && !utf8.Valid(msg) {
+				return 0, nil, &closeError{code: closeInvalidPayload, reason: "text messages must be UTF-8"}
+			}
+			return op, msg, nil
+		}
+	}
+}
+
+// parseClose reads the status code and reason from a client's close frame.
+func parseClose(payload []byte) error {
+	if len(payload) == 0 {
+		return &closeError{code: closeNoStatus, fromPeer: true}
+	}
+	if len(payload) == 1 {
+		return protocolError("close frame with a truncated status code")
+	}
+	code := int(binary.BigEndian.Uint16(payload))
+	reason := payload[2:]
+	switch {
+	case !validCloseCode(code):
+		return protocolError(fmt.Sprintf("invalid close code %d", code))
+	case !utf8.Valid(reason):
+		return &closeError{code: closeInvalidPayload, reason: "close reason must be UTF-8"}
+	}
+	return &closeError{code: code, reason: string(reason), fromPeer: true}
+}
+
+// validCloseCode reports whether an endpoint may send code: one of the
+// codes defined by RFC 6455, or one registered or private (3000-4999).
+func validCloseCode(code int) bool {
+	switch {
+	case code >= 1000 && code <= 1003, code >= 1007 && code <= 1011:
+		return true
+	case code >= 3000 && code <= 4999:
+		return true
+	}
+	return false
+}
+
+// headerHasToken reports whether a comma-separated header such as
+// Connection lists token, ignoring case.
+func headerHasToken(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// acceptKey answers the client's Sec-WebSocket-Key.
+func acceptKey(key string) string {
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// SetOrigins sets the origins, besides the server's own, whose pages may
+// open a WebSocket: the --cors-origins list, which a reload can change.
+func (h *eventHub) SetOrigins(origins []string) {
+	h.origins.Store(&origins)
+}
+
+// originAllowed reports whether the page that opened a WebSocket may use
+// it. Browsers send cookies and client certificates with a handshake from
+// any page, and the same-origin policy does not apply to WebSockets, so
+// without this check any site could read the events as its visitor. A "*"
+// in the CORS origins does not count: it is for plain requests, which
+// carry no credentials across origins. Clients other than browsers send
+// no Origin and are let through.
+func (h *eventHub) originAllowed(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	if origins := h.origins.Load(); origins != nil {
+		for _, o := range *origins {
+			if o != "*" && strings.EqualFold(o, origin) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// serveWebSocket upgrades the request to a WebSocket and pushes every
+// event to it as a JSON text message. Text messages from the client are
+// published to all subscribers as "message" events.
+func (h *eventHub) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	// The router lets HEAD through to GET routes, but a handshake is GET
+	if r.Method != "GET" || !headerHasToken(r.Header, "Connection", "upgrade") || !headerHasToken(r.Header, "Upgrade", "websocket") {
+		w.Header().Set("Connection", "Upgrade")
+		w.Header().Set("Upgrade", "websocket")
+		renderError(w, r, http.StatusUpgradeRequired, "this endpoint only speaks WebSocket")
+		return
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		renderError(w, r, http.StatusUpgradeRequired, "unsupported WebSocket version, want 13")
+		return
+	}
+	if !h.originAllowed(r) {
+		renderError(w, r, http.StatusForbidden, "WebSocket connections from this origin are not allowed")
+		return
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if nonce, err := base64.StdEncoding.DecodeString(key); err != nil || len(nonce) != 16 {
+		renderError(w, r, http.StatusBadRequest, "invalid Sec-WebSocket-Key")
+		return
+	}
+
+	conn, brw, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, "cannot upgrade this connection: "+err.Error())
+		return
+	}
+	defer conn.Close()
+
+	// The connection is ours now: the server's read and write timeouts no
+	// longer apply, and the handshake response is written by hand
+	conn.SetDeadline(time.Time{})
+	fmt.Fprintf(brw, "HTTP/1.1 101 Switching Protocols\r\n"+
+		"Upgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n"+
+		"X-Request-ID: %s\r\n\r\n", acceptKey(key), RequestIDFrom(r.Context()))
+	if err := brw.Flush(); err != nil {
+		return
+	}
+	ws := &wsConn{conn: conn, br: brw.Reader}
+
+	// Browsers cannot set headers on a WebSocket, so resuming uses the
+	// query string instead of Last-Event-ID
+	backlog, sub := h.subscribe(r.URL.Query().Get("last_event_id"))
+	defer h.unsubscribe(sub)
+
+	// Read from the client on a goroutine of its own; it ends with the
+	// reason the connection should close
+	incoming := make(chan error, 1)
+	go func() {
+		incoming <- h.readClient(ws, r.RemoteAddr)
+	}()
+
+	send := func(ev event) error {
+		b, _ := json.Marshal(ev)
+		return ws.writeFrame(opText, b)
+	}
+	for _, ev := range backlog {
+		if send(ev) != nil {
+			return
+		}
+	}
+
+	ping := time.NewTicker(wsPingInterval)
+	defer ping.Stop()
+	var closing *closeError
+	for closing == nil {
+		select {
+		case ev, ok := <-sub.ch:
+			if !ok {
+				closing = &closeError{code: closeGoingAway, reason: "server shutting down"}
+				if sub.slow {
+					closing = &closeError{code: closePolicyViolation, reason: "too far behind"}
+				}
+				break
+			}
+			if send(ev) != nil {
+				return
+			}
+		case <-ping.C:
+			if ws.writeFrame(opPing, nil) != nil {
+				return
+			}
+		case err := <-incoming:
+			// The client closed, broke the protocol, or went away
+			var ce *closeError
+			switch {
+			case !errors.As(err, &ce):
+			case ce.fromPeer && ce.code == closeNoStatus:
+				ws.writeFrame(opClose, nil)
+			case ce.fromPeer:
+				ws.writeClose(ce.code, "")
+			default:
+				ws.writeClose(ce.code, ce.reason)
+			}
+			return
+		}
+	}
+
+	// Closing from this end: say why, then give the client a moment to
+	// answer before hanging up
+	ws.writeClose(closing.code, closing.reason)
+	select {
+	case <-incoming:
+	case <-time.After(wsCloseWait):
+	}
+}
+
+// readClient publishes the client's text messages until it closes the
+// connection or breaks the protocol.
+func (h *eventHub) readClient(ws *wsConn, from string) error {
+	for {
+		op, msg, err := ws.readMessage()
+		if err != nil {
+			return err
+		}
+		if op == opBinary {
+			return &closeError{code: closeUnsupportedData, reason: "only text messages are accepted"}
+		}
+		h.publish("message", message{From: from, Text: string(msg)})
+	}
+}
@@ -0,0 +1,277 @@
+package httpserver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// clientFrame encodes a frame the way a client must send it, masked.
+// Options clear the mask bit or set a reserved bit.
+func clientFrame(fin bool, op byte, payload []byte, opts ...string) []byte {
+	b0 := op
+	if fin {
+		b0 |= 0x80
+	}
+	if hasOpt(opts, "rsv1") {
+		b0 |= 0x40
+	}
+	maskBit := byte(0x80)
+	if hasOpt(opts, "unmasked") {
+		maskBit = 0
+	}
+
+	b := []byte{b0}
+	switch n := len(payload); {
+	case n < 126:
+		b = append(b, maskBit|byte(n))
+	case n <= 0xFFFF:
+		b = binary.BigEndian.AppendUint16(append(b, maskBit|126), uint16(n))
+	default:
+		b = binary.BigEndian.AppendUint64(append(b, maskBit|127), uint64(n))
+	}
+	mask := []byte{0x12, 0x34, 0x56, 0x78}
+	b = append(b, mask...)
+	for i, c := range payload {
+		b = append(b, c^mask[i%4])
+	}
+	return b
+}
+
+func hasOpt(opts []string, opt string) bool {
+	for _, o := range opts {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+func closePayload(code int, reason string) []byte {
+	return append(binary.BigEndian.AppendUint16(nil, uint16(code)), reason...)
+}
+
+// pipeConn returns a server-side wsConn and the client end of a pipe.
+// Whatever the server writes is collected in the returned buffer once the
+// client end is closed.
+func pipeConn(t *testing.T) (*wsConn, net.Conn, func() []byte) {
+	server, client := net.Pipe()
+	t.Cleanup(func() { server.Close() })
+
+	var fromServer bytes.Buffer
+	done := make(chan struct{})
+	r, w := io.Pipe()
+	go func() {
+		io.Copy(&fromServer, r)
+		close(done)
+	}()
+	go func() {
+		io.Copy(w, client)
+		w.Close()
+	}()
+	ws := &wsConn{conn: server, br: bufio.NewReader(server)}
+	return ws, client, func() []byte {
+		client.Close()
+		server.Close()
+		<-done
+		return fromServer.Bytes()
+	}
+}
+
+func TestReadMessage(t *testing.T) {
+	big := bytes.Repeat([]byte("a"), wsMaxMessage+1)
+
+	tests := []struct {
+		name      string
+		frames    [][]byte
+		wantOp    byte
+		wantMsg   string
+		wantCode  int  // close code, if reading fails with one
+		fromPeer  bool // the close came from the client
+		wantReply []byte
+	}{
+		{name: "text", frames: [][]byte{clientFrame(true, opText, []byte("hello"))},
+			wantOp: opText, wantMsg: "hello"},
+		{name: "binary", frames: [][]byte{clientFrame(true, opBinary, []byte{0, 1, 2})},
+			wantOp: opBinary, wantMsg: "\x00\x01\x02"},
+		{name: "empty", frames: [][]byte{clientFrame(true, opText, nil)},
+			wantOp: opText, wantMsg: ""},
+		{name: "16-bit length", frames: [][]byte{clientFrame(true, opText, bytes.Repeat([]byte("b"), 300))},
+			wantOp: opText, wantMsg: strings.Repeat("b", 300)},
+		{name: "fragmented", frames: [][]byte{
+			clientFrame(false, opText, []byte("hel")),
+			clientFrame(false, opContinuation, []byte("l")),
+			clientFrame(true, opContinuation, []byte("o")),
+		}, wantOp: opText, wantMsg: "hello"},
+		{name: "ping between fragments", frames: [][]byte{
+			clientFrame(false, opText, []byte("hel")),
+			clientFrame(true, opPing, []byte("p")),
+			clientFrame(true, opContinuation, []byte("lo")),
+		}, wantOp: opText, wantMsg: "hello", wantReply: []byte{0x80 | opPong, 1, 'p'}},
+		{name: "pong ignored", frames: [][]byte{
+			clientFrame(true, opPong, nil),
+			clientFrame(true, opText, []byte("hi")),
+		}, wantOp: opText, wantMsg: "hi"},
+		{name: "unmasked", frames: [][]byte{clientFrame(true, opText, []byte("hi"), "unmasked")},
+			wantCode: closeProtocolError},
+		{name: "reserved bit", frames: [][]byte{clientFrame(true, opText, []byte("hi"), "rsv1")},
+			wantCode: closeProtocolError},
+		{name: "unknown opcode", frames: [][]byte{clientFrame(true, 0x3, nil)},
+			wantCode: closeProtocolError},
+		{name: "too big", frames: [][]byte{clientFrame(true, opBinary, big)},
+			wantCode: closeTooBig},
+		{name: "too big once put together", frames: [][]byte{
+			clientFrame(false, opBinary, big[:wsMaxMessage]),
+			clientFrame(true, opContinuation, []byte("a")),
+		}, wantCode: closeTooBig},
+		{name: "invalid UTF-8", frames: [][]byte{clientFrame(true, opText, []byte{0xff})},
+			wantCode: closeInvalidPayload},
+		{name: "continuation first", frames: [][]byte{clientFrame(true, opContinuation, []byte("x"))},
+			wantCode: closeProtocolError},
+		{name: "new message mid-message", frames: [][]byte{
+			clientFrame(false, opText, []byte("a")),
+			clientFrame(true, opText, []byte("b")),
+		}, wantCode: closeProtocolError},
+		{name: "fragmented ping", frames: [][]byte{clientFrame(false, opPing, nil)},
+			wantCode: closeProtocolError},
+		{name: "long ping", frames: [][]byte{clientFrame(true, opPing, bytes.Repeat([]byte("p"), 126))},
+			wantCode: closeProtocolError},
+		{name: "close", frames: [][]byte{clientFrame(true, opClose, closePayload(closeNormal, "bye"))},
+			wantCode: closeNormal, fromPeer: true},
+		{name: "close without status", frames: [][]byte{clientFrame(true, opClose, nil)},
+			wantCode: closeNoStatus, fromPeer: true},
+		{name: "close with private code", frames: [][]byte{clientFrame(true, opClose, closePayload(4000, ""))},
+			wantCode: 4000, fromPeer: true},
+		{name: "close with truncated status", frames: [][]byte{clientFrame(true, opClose, []byte{0x03})},
+			wantCode: closeProtocolError},
+		{name: "close with reserved code", frames: [][]byte{clientFrame(true, opClose, closePayload(closeNoStatus, ""))},
+			wantCode: closeProtocolError},
+		{name: "close with unassigned code", frames: [][]byte{clientFrame(true, opClose, closePayload(999, ""))},
+			wantCode: closeProtocolError},
+		{name: "close with invalid reason", frames: [][]byte{clientFrame(true, opClose, closePayload(closeNormal, "\xff"))},
+			wantCode: closeInvalidPayload},
+	}
+	for _, tt := range tests {
+		ws, client, written := pipeConn(t)
+		go func() {
+			for _, f := range tt.frames {
+				if _, err := client.Write(f); err != nil {
+					return
+				}
+			}
+		}()
+
+		op, msg, err := ws.readMessage()
+		reply := written()
+
+		var cerr *closeError
+		switch {
+		case tt.wantCode != 0:
+			if !errors.As(err, &cerr) || cerr.code != tt.wantCode || cerr.fromPeer != tt.fromPeer {
+				t.Errorf("%s: error %v, want close code %d (from peer %t)", tt.name, err, tt.wantCode, tt.fromPeer)
+			}
+		case err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case op != tt.wantOp || string(msg) != tt.wantMsg:
+			t.Errorf("%s: got %#x %q, want %#x %q", tt.name, op, msg, tt.wantOp, tt.wantMsg)
+		}
+		if !bytes.Equal(reply, tt.wantReply) {
+			t.Errorf("%s: server wrote % x, want % x", tt.name, reply, tt.wantReply)
+		}
+	}
+}
+
+func TestWriteFrame(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      byte
+		size    int
+		wantHdr []byte
+	}{
+		{"empty", opText, 0, []byte{0x81, 0}},
+		{"7-bit length", opText, 125, []byte{0x81, 125}},
+		{"16-bit length", opBinary, 126, []byte{0x82, 126, 0, 126}},
+		{"largest 16-bit length", opBinary, 0xFFFF, []byte{0x82, 126, 0xFF, 0xFF}},
+		{"64-bit length", opBinary, 0x10000, []byte{0x82, 127, 0, 0, 0, 0, 0, 1, 0, 0}},
+		{"pong", opPong, 3, []byte{0x8A, 3}},
+	}
+	for _, tt := range tests {
+		ws, _, written := pipeConn(t)
+		payload := bytes.Repeat([]byte("x"), tt.size)
+		if err := ws.writeFrame(tt.op, payload); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		want := append(tt.wantHdr, payload...)
+		if got := written(); !bytes.Equal(got, want) {
+			t.Errorf("%s: wrote % x..., want % x...", tt.name, got[:min(len(got), 12)], want[:min(len(want), 12)])
+		}
+	}
+}
+
+// Nothing may follow a close frame.
+func TestWriteAfterClose(t *testing.T) {
+	ws, _, written := pipeConn(t)
+	if err := ws.writeClose(closeGoingAway, "shutting down"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.writeFrame(opText, []byte("late")); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("write after close: %v, want net.ErrClosed", err)
+	}
+	want := append([]byte{0x88, 15, 0x03, 0xE9}, "shutting down"...)
+	if got := written(); !bytes.Equal(got, want) {
+		t.Errorf("wrote % x, want % x", got, want)
+	}
+}
+
+func TestWebSocketOrigin(t *testing.T) {
+	hub := newEventHub()
+	defer hub.close()
+	hub.SetOrigins([]string{"*", "https://app.example"})
+	router := NewRouter()
+	registerEvents(router, hub)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	tests := []struct {
+		name       string
+		origin     string
+		wantStatus int
+	}{
+		{"no origin", "", http.StatusSwitchingProtocols},
+		{"same origin", "http://" + host, http.StatusSwitchingProtocols},
+		{"configured origin", "https://app.example", http.StatusSwitchingProtocols},
+		{"configured origin, other case", "https://APP.example", http.StatusSwitchingProtocols},
+		{"other site", "https://evil.example", http.StatusForbidden},
+		{"other port", "http://127.0.0.1:1", http.StatusForbidden},
+		{"null", "null", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		conn, err := net.Dial("tcp", host)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := "GET /ws HTTP/1.1\r\nHost: " + host + "\r\n" +
+			"Connection: Upgrade\r\nUpgrade: websocket\r\nSec-WebSocket-Version: 13\r\n" +
+			"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n"
+		if tt.origin != "" {
+			req += "Origin: " + tt.origin + "\r\n"
+		}
+		io.WriteString(conn, req+"\r\n")
+		resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		conn.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Errorf("%s: status %d, want %d", tt.name, resp.StatusCode, tt.wantStatus)
+		}
+	}
+}
@@ -1,8 +1,22 @@
-package main
+package ifelse
 
-import "fmt"
+import (
+	"fmt"
 
-func main() {
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "if-else",
+		Category:    examples.Beginner,
+		Description: "Conditional Statements",
+		Icon:        "🔀",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== If/Else Examples ===")
 
 	// Basic if statement
@@ -33,12 +47,14 @@ func main() {
 		fmt.Println("Grade: F")
 	}
 
+	// readme:begin
 	// If with initialization statement
 	if num := 10; num%2 == 0 {
 		fmt.Printf("%d is even\n", num)
 	} else {
 		fmt.Printf("%d is odd\n", num)
 	}
+	// readme:end
 
 	// Nested if statements
 	hours := 14
@@ -1,18 +1,30 @@
-package main
+package json
 
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 type Person struct {
-	Name    string `json:"name"`
-	Age     int    `json:"age"`
-	Email   string `json:"email"`
-	Admin   bool   `json:"admin,omitempty"`
+	Name  string `json:"name"`
+	Age   int    `json:"age"`
+	Email string `json:"email"`
+	Admin bool   `json:"admin,omitempty"`
+}
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "json",
+		Category:    examples.Practical,
+		Description: "JSON Operations",
+		Icon:        "📄",
+		Run:         Run,
+	})
 }
 
-func main() {
+func Run() {
 	fmt.Println("=== JSON ===")
 
 	// Marshal (Go to JSON)
@@ -50,12 +62,14 @@ func main() {
 		{Name: "Charlie", Age: 35, Email: "charlie@example.com"},
 		{Name: "Diana", Age: 28, Email: "diana@example.com"},
 	}
-	
+
 	peopleJSON, _ := json.Marshal(people)
 	fmt.Printf("People JSON: %s\n", peopleJSON)
 
 	// Dynamic JSON with interface
+	// readme:begin
 	var data map[string]interface{}
 	json.Unmarshal([]byte(`{"name":"Eve","age":40,"skills":["Go","Python"]}`), &data)
+	// readme:end
 	fmt.Printf("Dynamic: %+v\n", data)
 }
@@ -1,13 +1,25 @@
-package main
+package linefilters
 
 import (
 	"bufio"
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "line-filters",
+		Category:    examples.Practical,
+		Description: "Line Filters",
+		Icon:        "🔍",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Line Filters ===")
 
 	// Create test data
@@ -39,9 +51,11 @@ func main() {
 	fmt.Println("Lines starting with 'a' or 'b':")
 	for scanner.Scan() {
 		line := scanner.Text()
+		// readme:begin
 		if strings.HasPrefix(line, "a") || strings.HasPrefix(line, "b") {
 			fmt.Printf("  %s\n", line)
 		}
+		// readme:end
 	}
 
 	// Filter by length
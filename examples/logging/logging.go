@@ -1,16 +1,31 @@
-package main
+package logging
 
 import (
+	"fmt"
 	"log"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "logging",
+		Category:    examples.Practical,
+		Description: "Logging",
+		Icon:        "📝",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Logging ===")
 
 	// Basic logging
+	// readme:begin
 	log.Println("This is a basic log message")
 	log.Printf("Formatted log: %s is %d years old", "Alice", 25)
+	// readme:end
 
 	// Log to file
 	file, err := os.OpenFile("app.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
@@ -26,7 +41,7 @@ func main() {
 
 	// Log with different flags
 	fmt.Println("\nDifferent log flags:")
-	
+
 	// Standard logger with flags
 	log.SetFlags(log.LstdFlags)
 	log.Println("With standard flags")
@@ -49,11 +64,11 @@ func main() {
 
 	// Log levels simulation
 	fmt.Println("\nSimulated log levels:")
-	
+
 	info := log.New(os.Stdout, "INFO: ", log.LstdFlags)
 	warning := log.New(os.Stdout, "WARN: ", log.LstdFlags)
 	error := log.New(os.Stdout, "ERROR: ", log.LstdFlags)
-	
+
 	info.Println("Application started")
 	warning.Println("Low disk space")
 	error.Println("Database connection failed")
@@ -81,12 +96,12 @@ func main() {
 	// Performance logging
 	startTime := log.New(os.Stdout, "PERF: ", log.LstdFlags)
 	startTime.Println("Starting operation")
-	
+
 	// Simulate work
 	for i := 0; i < 3; i++ {
 		log.Printf("Processing item %d", i+1)
 	}
-	
+
 	startTime.Println("Operation completed")
 
 	// Clean up
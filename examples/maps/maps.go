@@ -1,23 +1,39 @@
-package main
-
-import "fmt"
+package maps
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "maps",
+		Category:    examples.Beginner,
+		Description: "Map Operations",
+		Icon:        "🗺️",
+		Run:         Run,
+	})
+}
 
-func main() {
+func Run() {
 	fmt.Println("=== Maps Examples ===")
 
 	// Creating maps with make
+	// readme:begin
 	ages := make(map[string]int)
 	ages["Alice"] = 25
+	// readme:end
 	ages["Bob"] = 30
 	ages["Charlie"] = 35
 	fmt.Printf("Ages map: %v\n", ages)
 
 	// Map literal
 	grades := map[string]int{
-		"Math":     90,
-		"Science":  85,
-		"History":  78,
-		"English":  92,
+		"Math":    90,
+		"Science": 85,
+		"History": 78,
+		"English": 92,
 	}
 	fmt.Printf("Grades map: %v\n", grades)
 
@@ -115,7 +131,7 @@ func main() {
 			"Content": 2,
 		},
 	}
-	
+
 	for dept, teams := range departments {
 		fmt.Printf("%s Department:\n", dept)
 		for team, count := range teams {
@@ -125,22 +141,22 @@ func main() {
 
 	// Map operations
 	fmt.Println("\nMap operations:")
-	
+
 	// Counting occurrences
 	words := []string{"apple", "banana", "apple", "orange", "banana", "apple"}
 	wordCount := make(map[string]int)
-	
+
 	for _, word := range words {
 		wordCount[word]++
 	}
-	
+
 	fmt.Printf("Word count: %v\n", wordCount)
 
 	// Finding max value in map
 	fmt.Println("\nFinding maximum value:")
 	maxGrade := ""
 	maxScore := -1
-	
+
 	for subject, score := range grades {
 		if score > maxScore {
 			maxScore = score
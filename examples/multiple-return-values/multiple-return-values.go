@@ -1,12 +1,16 @@
-package main
+package multiplereturnvalues
 
 import (
 	"fmt"
 	"math"
 	"strings"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 // Function returning two values
+// readme:begin
+
 func divide(a, b float64) (float64, error) {
 	if b == 0 {
 		return 0, fmt.Errorf("division by zero")
@@ -14,13 +18,15 @@ func divide(a, b float64) (float64, error) {
 	return a / b, nil
 }
 
+// readme:end
+
 // Function returning multiple values of different types
 func getPersonInfo(id int) (string, int, string, bool) {
 	// Simulate database lookup
 	people := map[int]struct {
-		name  string
-		age   int
-		email string
+		name   string
+		age    int
+		email  string
 		active bool
 	}{
 		1: {"Alice", 25, "alice@example.com", true},
@@ -115,7 +121,7 @@ func analyzeText(text string) (int, int, int, []string) {
 	words := strings.Fields(text)
 	sentences := strings.Split(text, ".")
 	paragraphs := strings.Split(text, "\n\n")
-	
+
 	// Count words starting with capital letter
 	var capitalizedWords []string
 	for _, word := range words {
@@ -123,14 +129,53 @@ func analyzeText(text string) (int, int, int, []string) {
 			capitalizedWords = append(capitalizedWords, word)
 		}
 	}
-	
+
 	return len(words), len(sentences), len(paragraphs), capitalizedWords
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "multiple-return-values",
+		Category:    examples.Beginner,
+		Description: "Multiple Return Values",
+		Icon:        "🔄",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Function returning result and error", Run: functionReturningResultAndError},
+			{Number: 2, Title: "Function returning multiple different types", Run: functionReturningMultipleDifferentTypes},
+			{Number: 3, Title: "Function with named return values", Run: functionWithNamedReturnValues},
+			{Number: 4, Title: "Function returning coordinates", Run: functionReturningCoordinates},
+			{Number: 5, Title: "Function returning validation result", Run: functionReturningValidationResult},
+			{Number: 6, Title: "Function returning min/max and status", Run: functionReturningMinMaxAndStatus},
+			{Number: 7, Title: "Function returning split strings", Run: functionReturningSplitStrings},
+			{Number: 8, Title: "Function returning calculation and error", Run: functionReturningCalculationAndError},
+			{Number: 9, Title: "Function returning multiple analysis results", Run: functionReturningMultipleAnalysisResults},
+			{Number: 10, Title: "Ignoring return values", Run: ignoringReturnValues},
+			{Number: 11, Title: "Using multiple return values in function calls", Run: usingMultipleReturnValuesInFunctionCalls},
+			{Number: 12, Title: "Multiple assignment with function calls", Run: multipleAssignmentWithFunctionCalls},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Multiple Return Values Examples ===")
 
-	// 1. Function returning result and error
+	functionReturningResultAndError()
+	functionReturningMultipleDifferentTypes()
+	functionWithNamedReturnValues()
+	functionReturningCoordinates()
+	functionReturningValidationResult()
+	functionReturningMinMaxAndStatus()
+	functionReturningSplitStrings()
+	functionReturningCalculationAndError()
+	functionReturningMultipleAnalysisResults()
+	ignoringReturnValues()
+	usingMultipleReturnValuesInFunctionCalls()
+	multipleAssignmentWithFunctionCalls()
+}
+
+// 1. Function returning result and error
+func functionReturningResultAndError() {
 	fmt.Println("\n1. Result and error:")
 	result, err := divide(10, 2)
 	if err != nil {
@@ -145,33 +190,43 @@ func main() {
 	} else {
 		fmt.Printf("10 / 0 = %.2f\n", result)
 	}
+}
 
-	// 2. Function returning multiple different types
+// 2. Function returning multiple different types
+func functionReturningMultipleDifferentTypes() {
 	fmt.Println("\n2. Multiple different types:")
 	name, age, email, active := getPersonInfo(1)
 	fmt.Printf("Person: %s, Age: %d, Email: %s, Active: %t\n", name, age, email, active)
+}
 
-	// 3. Function with named return values
+// 3. Function with named return values
+func functionWithNamedReturnValues() {
 	fmt.Println("\n3. Named return values:")
 	numbers := []float64{10, 20, 30, 40, 50}
 	count, sum, avg, min, max := calculateStats(numbers)
 	fmt.Printf("Numbers: %v\n", numbers)
 	fmt.Printf("Count: %d, Sum: %.1f, Average: %.1f, Min: %.1f, Max: %.1f\n", count, sum, avg, min, max)
+}
 
-	// 4. Function returning coordinates
+// 4. Function returning coordinates
+func functionReturningCoordinates() {
 	fmt.Println("\n4. Coordinates:")
 	lat, lon := getCoordinates()
 	fmt.Printf("Location: (%.4f, %.4f)\n", lat, lon)
+}
 
-	// 5. Function returning validation result
+// 5. Function returning validation result
+func functionReturningValidationResult() {
 	fmt.Println("\n5. Validation:")
 	valid, message := validateInput("john", "password123")
 	fmt.Printf("Validation: %t, Message: %s\n", valid, message)
 
 	valid, message = validateInput("jo", "123")
 	fmt.Printf("Validation: %t, Message: %s\n", valid, message)
+}
 
-	// 6. Function returning min/max and status
+// 6. Function returning min/max and status
+func functionReturningMinMaxAndStatus() {
 	fmt.Println("\n6. Min/Max with status:")
 	numbersInt := []int{5, 2, 8, 1, 9, 3}
 	minVal, maxVal, found := findMinMax(numbersInt)
@@ -180,13 +235,17 @@ func main() {
 	} else {
 		fmt.Println("No numbers found")
 	}
+}
 
-	// 7. Function returning split strings
+// 7. Function returning split strings
+func functionReturningSplitStrings() {
 	fmt.Println("\n7. String splitting:")
 	firstName, lastName := splitName("John Doe Smith")
 	fmt.Printf("First name: %s, Last name: %s\n", firstName, lastName)
+}
 
-	// 8. Function returning calculation and error
+// 8. Function returning calculation and error
+func functionReturningCalculationAndError() {
 	fmt.Println("\n8. Square root with error:")
 	sqrt, err := squareRoot(16)
 	if err != nil {
@@ -201,29 +260,37 @@ func main() {
 	} else {
 		fmt.Printf("Square root of -4: %.2f\n", sqrt)
 	}
+}
 
-	// 9. Function returning multiple analysis results
+// 9. Function returning multiple analysis results
+func functionReturningMultipleAnalysisResults() {
 	fmt.Println("\n9. Text analysis:")
 	text := "Hello World. This is a test. Go programming is fun!"
 	wordCount, sentenceCount, paragraphCount, capitalizedWords := analyzeText(text)
 	fmt.Printf("Text: %s\n", text)
 	fmt.Printf("Words: %d, Sentences: %d, Paragraphs: %d\n", wordCount, sentenceCount, paragraphCount)
 	fmt.Printf("Capitalized words: %v\n", capitalizedWords)
+}
 
-	// 10. Ignoring return values
+// 10. Ignoring return values
+func ignoringReturnValues() {
 	fmt.Println("\n10. Ignoring return values:")
-	_, _, _, capitalizedWords = analyzeText("Another Example With Capitalized Words")
+	_, _, _, capitalizedWords := analyzeText("Another Example With Capitalized Words")
 	fmt.Printf("Only capitalized words: %v\n", capitalizedWords)
+}
 
-	// 11. Using multiple return values in function calls
+// 11. Using multiple return values in function calls
+func usingMultipleReturnValuesInFunctionCalls() {
 	fmt.Println("\n11. Chaining function calls:")
 	if valid, _ := validateInput("alice", "secure123"); valid {
 		fmt.Println("User validation passed")
 	}
+}
 
-	// 12. Multiple assignment with function calls
+// 12. Multiple assignment with function calls
+func multipleAssignmentWithFunctionCalls() {
 	fmt.Println("\n12. Multiple assignment:")
 	a, b := 10, 20
-	sum := func(x, y int) int { return x + y }(a, b)
-	fmt.Printf("Sum of %d and %d is %d\n", a, b, sum)
+	total := func(x, y int) int { return x + y }(a, b)
+	fmt.Printf("Sum of %d and %d is %d\n", a, b, total)
 }
@@ -1,9 +1,10 @@
-package main
+package mutexes
 
 import (
 	"fmt"
 	"sync"
-	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 type Counter struct {
@@ -11,14 +12,37 @@ type Counter struct {
 	value int
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "mutexes",
+		Category:    examples.Expert,
+		Description: "Mutex Synchronization",
+		Icon:        "🔐",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic mutex", Run: basicMutex},
+			{Number: 2, Title: "Mutex with defer", Run: mutexWithDefer},
+			{Number: 3, Title: "RWMutex", Run: rwmutex},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Mutexes Examples ===")
 
-	// 1. Basic mutex
+	basicMutex()
+	mutexWithDefer()
+	rwmutex()
+
+	fmt.Println("All mutex examples completed!")
+}
+
+// 1. Basic mutex
+func basicMutex() {
 	fmt.Println("\n1. Basic mutex:")
 	counter := Counter{}
 	var wg sync.WaitGroup
-	
+
 	for i := 0; i < 5; i++ {
 		wg.Add(1)
 		go func(id int) {
@@ -30,29 +54,37 @@ func main() {
 		}(i)
 	}
 	wg.Wait()
+}
 
-	// 2. Mutex with defer
+// 2. Mutex with defer
+func mutexWithDefer() {
 	fmt.Println("\n2. Mutex with defer:")
+	var wg sync.WaitGroup
 	var mu sync.Mutex
 	data := []int{}
-	
+
 	for i := 0; i < 3; i++ {
 		wg.Add(1)
 		go func(id int) {
 			defer wg.Done()
+			// readme:begin
 			mu.Lock()
 			defer mu.Unlock()
+			// readme:end
 			data = append(data, id)
 			fmt.Printf("Goroutine %d: Data = %v\n", id, data)
 		}(i)
 	}
 	wg.Wait()
+}
 
-	// 3. RWMutex
+// 3. RWMutex
+func rwmutex() {
 	fmt.Println("\n3. RWMutex:")
+	var wg sync.WaitGroup
 	var rwMu sync.RWMutex
 	sharedData := map[string]int{"a": 1, "b": 2}
-	
+
 	// Readers
 	for i := 0; i < 3; i++ {
 		wg.Add(1)
@@ -63,7 +95,7 @@ func main() {
 			fmt.Printf("Reader %d: %v\n", id, sharedData)
 		}(i)
 	}
-	
+
 	// Writer
 	wg.Add(1)
 	go func() {
@@ -74,6 +106,4 @@ func main() {
 		fmt.Printf("Writer: %v\n", sharedData)
 	}()
 	wg.Wait()
-
-	fmt.Println("All mutex examples completed!")
 }
@@ -1,79 +1,133 @@
-package main
+package nonblockingchanneloperations
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "non-blocking-channel-operations",
+		Category:    examples.Expert,
+		Description: "Non-Blocking Channel Operations",
+		Icon:        "🚫",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Non-blocking receive", Run: nonBlockingReceive},
+			{Number: 2, Title: "Non-blocking send", Run: nonBlockingSend},
+			{Number: 3, Title: "Non-blocking operations in loop", Run: nonBlockingOperationsInLoop},
+			{Number: 4, Title: "Non-blocking receive from multiple channels", Run: nonBlockingReceiveFromMultipleChannels},
+			{Number: 5, Title: "Non-blocking with timeout simulation", Run: nonBlockingWithTimeoutSimulation},
+			{Number: 6, Title: "Non-blocking producer-consumer", Run: nonBlockingProducerConsumer},
+			{Number: 7, Title: "Non-blocking with multiple sends", Run: nonBlockingWithMultipleSends},
+			{Number: 8, Title: "Non-blocking fan-in", Run: nonBlockingFanIn},
+			{Number: 9, Title: "Non-blocking with buffer management", Run: nonBlockingWithBufferManagement},
+			{Number: 10, Title: "Non-blocking with priority", Run: nonBlockingWithPriority},
+			{Number: 11, Title: "Non-blocking with backpressure handling", Run: nonBlockingWithBackpressureHandling},
+			{Number: 12, Title: "Non-blocking with load shedding", Run: nonBlockingWithLoadShedding},
+			{Number: 13, Title: "Non-blocking with health checks", Run: nonBlockingWithHealthChecks},
+			{Number: 14, Title: "Non-blocking with batch processing", Run: nonBlockingWithBatchProcessing},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Non-Blocking Channel Operations Examples ===")
 
-	// 1. Non-blocking receive
+	nonBlockingReceive()
+	nonBlockingSend()
+	nonBlockingOperationsInLoop()
+	nonBlockingReceiveFromMultipleChannels()
+	nonBlockingWithTimeoutSimulation()
+	nonBlockingProducerConsumer()
+	nonBlockingWithMultipleSends()
+	nonBlockingFanIn()
+	nonBlockingWithBufferManagement()
+	nonBlockingWithPriority()
+	nonBlockingWithBackpressureHandling()
+	nonBlockingWithLoadShedding()
+	nonBlockingWithHealthChecks()
+	nonBlockingWithBatchProcessing()
+
+	fmt.Println("All non-blocking examples completed!")
+}
+
+// 1. Non-blocking receive
+func nonBlockingReceive() {
 	fmt.Println("\n1. Non-blocking receive:")
 	ch := make(chan int)
-	
+
+	// readme:begin
 	select {
 	case value := <-ch:
 		fmt.Printf("Received: %d\n", value)
 	default:
 		fmt.Println("No data available (non-blocking receive)")
 	}
-	
+	// readme:end
+
 	// Send data and try non-blocking receive
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch <- 42
 	}()
-	
-	time.Sleep(100 * time.Millisecond) // Wait for send
-	
+
+	clock.Sleep(100 * time.Millisecond) // Wait for send
+
 	select {
 	case value := <-ch:
 		fmt.Printf("Now received: %d\n", value)
 	default:
 		fmt.Println("Still no data")
 	}
+}
 
-	// 2. Non-blocking send
+// 2. Non-blocking send
+func nonBlockingSend() {
 	fmt.Println("\n2. Non-blocking send:")
 	ch2 := make(chan string) // Unbuffered
-	
+
 	select {
 	case ch2 <- "hello":
 		fmt.Println("Sent successfully")
 	default:
 		fmt.Println("Could not send (channel blocking)")
 	}
-	
+
 	// Non-blocking send to buffered channel
 	ch3 := make(chan string, 1)
-	
+
 	select {
 	case ch3 <- "hello":
 		fmt.Println("Sent to buffered channel")
 	default:
 		fmt.Println("Could not send")
 	}
-	
+
 	// Try to send to full buffered channel
 	ch4 := make(chan int, 1)
 	ch4 <- 100 // Fill buffer
-	
+
 	select {
 	case ch4 <- 200:
 		fmt.Println("Sent to full buffered channel")
 	default:
 		fmt.Println("Buffered channel full, cannot send")
 	}
+}
 
-	// 3. Non-blocking operations in loop
+// 3. Non-blocking operations in loop
+func nonBlockingOperationsInLoop() {
 	fmt.Println("\n3. Non-blocking operations in loop:")
 	ch5 := make(chan int, 2)
-	
+
 	// Fill buffer partially
 	ch5 <- 1
 	ch5 <- 2
-	
+
 	// Try to send more
 	for i := 3; i <= 5; i++ {
 		select {
@@ -83,24 +137,26 @@ func main() {
 			fmt.Printf("Could not send %d (channel full)\n", i)
 		}
 	}
-	
+
 	// Drain channel
 	for len(ch5) > 0 {
 		value := <-ch5
 		fmt.Printf("Drained: %d\n", value)
 	}
+}
 
-	// 4. Non-blocking receive from multiple channels
+// 4. Non-blocking receive from multiple channels
+func nonBlockingReceiveFromMultipleChannels() {
 	fmt.Println("\n4. Non-blocking receive from multiple channels:")
 	ch6 := make(chan string)
 	ch7 := make(chan int)
-	
+
 	// Send to one channel
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch6 <- "message"
 	}()
-	
+
 	// Try non-blocking receive from both
 	for i := 0; i < 3; i++ {
 		select {
@@ -111,36 +167,41 @@ func main() {
 		default:
 			fmt.Printf("No data available (attempt %d)\n", i+1)
 		}
-		time.Sleep(30 * time.Millisecond)
+		clock.Sleep(30 * time.Millisecond)
 	}
+}
 
-	// 5. Non-blocking with timeout simulation
+// 5. Non-blocking with timeout simulation
+func nonBlockingWithTimeoutSimulation() {
 	fmt.Println("\n5. Non-blocking with timeout simulation:")
 	ch8 := make(chan int)
-	
+
 	// Simulate timeout using non-blocking select
-	deadline := time.Now().Add(100 * time.Millisecond)
-	
+	deadline := clock.Now().Add(100 * time.Millisecond)
+
+waitLoop:
 	for {
 		select {
 		case value := <-ch8:
 			fmt.Printf("Received: %d\n", value)
-			break
+			break waitLoop
 		default:
-			if time.Now().After(deadline) {
+			if clock.Now().After(deadline) {
 				fmt.Println("Timeout reached")
-				break
+				break waitLoop
 			}
 			fmt.Println("Waiting...")
-			time.Sleep(20 * time.Millisecond)
+			clock.Sleep(20 * time.Millisecond)
 		}
 	}
+}
 
-	// 6. Non-blocking producer-consumer
+// 6. Non-blocking producer-consumer
+func nonBlockingProducerConsumer() {
 	fmt.Println("\n6. Non-blocking producer-consumer:")
 	prodChan := make(chan int, 3)
 	consChan := make(chan int, 3)
-	
+
 	// Producer
 	go func() {
 		for i := 1; i <= 5; i++ {
@@ -150,11 +211,11 @@ func main() {
 			default:
 				fmt.Printf("Production buffer full, skipping: %d\n", i)
 			}
-			time.Sleep(50 * time.Millisecond)
+			clock.Sleep(50 * time.Millisecond)
 		}
 		close(prodChan)
 	}()
-	
+
 	// Consumer
 	go func() {
 		for {
@@ -173,23 +234,25 @@ func main() {
 			}
 		}
 	}()
-	
+
 	// Collect results
 	for item := range consChan {
 		fmt.Printf("Final result: %d\n", item)
-		time.Sleep(30 * time.Millisecond)
+		clock.Sleep(30 * time.Millisecond)
 	}
+}
 
-	// 7. Non-blocking with multiple sends
+// 7. Non-blocking with multiple sends
+func nonBlockingWithMultipleSends() {
 	fmt.Println("\n7. Non-blocking with multiple sends:")
 	outputs := []chan int{
 		make(chan int, 1),
 		make(chan int, 1),
 		make(chan int, 1),
 	}
-	
+
 	data := []int{10, 20, 30, 40, 50}
-	
+
 	for _, item := range data {
 		sent := false
 		for i, ch := range outputs {
@@ -205,28 +268,30 @@ func main() {
 		if !sent {
 			fmt.Printf("Could not send %d to any output\n", item)
 		}
-		time.Sleep(30 * time.Millisecond)
+		clock.Sleep(30 * time.Millisecond)
 	}
+}
 
-	// 8. Non-blocking fan-in
+// 8. Non-blocking fan-in
+func nonBlockingFanIn() {
 	fmt.Println("\n8. Non-blocking fan-in:")
 	inputs := []chan int{
 		make(chan int),
 		make(chan int),
 		make(chan int),
 	}
-	
+
 	// Start producers
 	for i, input := range inputs {
 		go func(id int, ch chan int) {
 			for j := 1; j <= 3; j++ {
 				ch <- id*10 + j
-				time.Sleep(50 * time.Millisecond)
+				clock.Sleep(50 * time.Millisecond)
 			}
 			close(ch)
 		}(i, input)
 	}
-	
+
 	// Non-blocking fan-in
 	for {
 		received := false
@@ -258,20 +323,22 @@ func main() {
 				break
 			}
 		}
-		time.Sleep(20 * time.Millisecond)
+		clock.Sleep(20 * time.Millisecond)
 	}
+}
 
-	// 9. Non-blocking with buffer management
+// 9. Non-blocking with buffer management
+func nonBlockingWithBufferManagement() {
 	fmt.Println("\n9. Non-blocking with buffer management:")
 	bufferedChan := make(chan int, 3)
-	
+
 	// Fill buffer
 	bufferedChan <- 1
 	bufferedChan <- 2
 	bufferedChan <- 3
-	
+
 	fmt.Printf("Buffer length: %d, capacity: %d\n", len(bufferedChan), cap(bufferedChan))
-	
+
 	// Try to add more
 	for i := 4; i <= 6; i++ {
 		select {
@@ -279,30 +346,32 @@ func main() {
 			fmt.Printf("Added %d to buffer\n", i)
 		default:
 			fmt.Printf("Buffer full, removing oldest item\n")
-			<-bufferedChan // Remove oldest
+			<-bufferedChan    // Remove oldest
 			bufferedChan <- i // Add new item
 			fmt.Printf("Added %d after removal\n", i)
 		}
 		fmt.Printf("Buffer length: %d\n", len(bufferedChan))
 	}
+}
 
-	// 10. Non-blocking with priority
+// 10. Non-blocking with priority
+func nonBlockingWithPriority() {
 	fmt.Println("\n10. Non-blocking with priority:")
 	highPriority := make(chan int, 2)
 	normalPriority := make(chan int, 2)
 	lowPriority := make(chan int, 2)
-	
+
 	// Send to different priority channels
 	go func() {
 		highPriority <- 1
-		time.Sleep(20 * time.Millisecond)
+		clock.Sleep(20 * time.Millisecond)
 		normalPriority <- 2
-		time.Sleep(20 * time.Millisecond)
+		clock.Sleep(20 * time.Millisecond)
 		lowPriority <- 3
-		time.Sleep(20 * time.Millisecond)
+		clock.Sleep(20 * time.Millisecond)
 		highPriority <- 4
 	}()
-	
+
 	// Process with priority
 	for i := 0; i < 4; i++ {
 		select {
@@ -315,13 +384,15 @@ func main() {
 		default:
 			fmt.Println("No items available")
 		}
-		time.Sleep(30 * time.Millisecond)
+		clock.Sleep(30 * time.Millisecond)
 	}
+}
 
-	// 11. Non-blocking with backpressure handling
+// 11. Non-blocking with backpressure handling
+func nonBlockingWithBackpressureHandling() {
 	fmt.Println("\n11. Non-blocking with backpressure:")
 	workChan := make(chan int, 2)
-	
+
 	// Producer with backpressure handling
 	go func() {
 		for i := 1; i <= 8; i++ {
@@ -331,22 +402,24 @@ func main() {
 			default:
 				fmt.Printf("Rejected work: %d (backpressure)\n", i)
 			}
-			time.Sleep(30 * time.Millisecond)
+			clock.Sleep(30 * time.Millisecond)
 		}
 		close(workChan)
 	}()
-	
+
 	// Consumer
 	for work := range workChan {
 		fmt.Printf("Processing work: %d\n", work)
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 	}
+}
 
-	// 12. Non-blocking with load shedding
+// 12. Non-blocking with load shedding
+func nonBlockingWithLoadShedding() {
 	fmt.Println("\n12. Non-blocking with load shedding:")
 	requestChan := make(chan int, 3)
 	processedChan := make(chan int, 3)
-	
+
 	// Request generator
 	go func() {
 		for i := 1; i <= 10; i++ {
@@ -356,11 +429,11 @@ func main() {
 			default:
 				fmt.Printf("Request %d dropped (load shedding)\n", i)
 			}
-			time.Sleep(20 * time.Millisecond)
+			clock.Sleep(20 * time.Millisecond)
 		}
 		close(requestChan)
 	}()
-	
+
 	// Processor
 	go func() {
 		for req := range requestChan {
@@ -370,35 +443,37 @@ func main() {
 			default:
 				fmt.Printf("Request %d dropped (processing overflow)\n", req)
 			}
-			time.Sleep(40 * time.Millisecond)
+			clock.Sleep(40 * time.Millisecond)
 		}
 		close(processedChan)
 	}()
-	
+
 	// Collect processed requests
 	for processed := range processedChan {
 		fmt.Printf("Final processed: %d\n", processed)
 	}
+}
 
-	// 13. Non-blocking with health checks
+// 13. Non-blocking with health checks
+func nonBlockingWithHealthChecks() {
 	fmt.Println("\n13. Non-blocking with health checks:")
 	dataChan := make(chan int)
 	healthChan := make(chan string)
-	
+
 	// Data producer
 	go func() {
 		for i := 1; i <= 5; i++ {
 			dataChan <- i
-			time.Sleep(100 * time.Millisecond)
+			clock.Sleep(100 * time.Millisecond)
 		}
 		close(dataChan)
 	}()
-	
+
 	// Health checker
 	go func() {
-		ticker := time.NewTicker(50 * time.Millisecond)
+		ticker := clock.NewTicker(50 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		for {
 			select {
 			case <-ticker.C:
@@ -411,7 +486,7 @@ func main() {
 			}
 		}
 	}()
-	
+
 	// Consumer with health monitoring
 	for {
 		select {
@@ -424,27 +499,29 @@ func main() {
 			fmt.Printf("Health: %s\n", health)
 		}
 	}
-	
+
 done:
 	fmt.Println("Data channel closed")
+}
 
-	// 14. Non-blocking with batch processing
+// 14. Non-blocking with batch processing
+func nonBlockingWithBatchProcessing() {
 	fmt.Println("\n14. Non-blocking batch processing:")
 	batchChan := make(chan int, 10)
-	
+
 	// Producer
 	go func() {
 		for i := 1; i <= 15; i++ {
 			batchChan <- i
-			time.Sleep(20 * time.Millisecond)
+			clock.Sleep(20 * time.Millisecond)
 		}
 		close(batchChan)
 	}()
-	
+
 	// Batch processor
 	for {
 		batch := make([]int, 0, 5)
-		
+
 		// Collect batch non-blocking
 		for len(batch) < 5 {
 			select {
@@ -462,7 +539,7 @@ done:
 				break
 			}
 		}
-		
+
 		if len(batch) > 0 {
 			fmt.Printf("Processing batch: %v\n", batch)
 		} else {
@@ -474,11 +551,10 @@ done:
 				}
 			default:
 				// Wait for more items
-				time.Sleep(30 * time.Millisecond)
+				clock.Sleep(30 * time.Millisecond)
 			}
 		}
 	}
-	
+
 batchDone:
-	fmt.Println("All non-blocking examples completed!")
 }
@@ -1,11 +1,23 @@
-package main
+package numberparsing
 
 import (
 	"fmt"
 	"strconv"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "number-parsing",
+		Category:    examples.Practical,
+		Description: "Number Parsing",
+		Icon:        "🔢",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Number Parsing ===")
 
 	// Parse int
@@ -44,11 +56,11 @@ func main() {
 	// Parse bool
 	trueStr := "true"
 	falseStr := "false"
-	
+
 	if b, err := strconv.ParseBool(trueStr); err == nil {
 		fmt.Printf("Parsed true: %t\n", b)
 	}
-	
+
 	if b, err := strconv.ParseBool(falseStr); err == nil {
 		fmt.Printf("Parsed false: %t\n", b)
 	}
@@ -56,7 +68,7 @@ func main() {
 	// Quote and unquote
 	quoted := strconv.Quote("Hello, World!")
 	fmt.Printf("Quoted: %s\n", quoted)
-	
+
 	if unquoted, err := strconv.Unquote(quoted); err == nil {
 		fmt.Printf("Unquoted: %s\n", unquoted)
 	}
@@ -64,8 +76,10 @@ func main() {
 	// Parse with different bases
 	bases := []int{2, 8, 10, 16}
 	for _, base := range bases {
+		// readme:begin
 		if num, err := strconv.ParseInt("1010", base, 64); err == nil {
 			fmt.Printf("1010 in base %d = %d\n", base, num)
 		}
+		// readme:end
 	}
 }
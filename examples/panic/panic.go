@@ -0,0 +1,109 @@
+package panic
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "panic",
+		Category:    examples.Expert,
+		Description: "Panic Handling",
+		Icon:        "😱",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic panic", Run: basicPanic},
+			{Number: 2, Title: "Panic from invalid operation", Run: panicFromInvalidOperation},
+			{Number: 3, Title: "Panic from nil pointer", Run: panicFromNilPointer},
+			{Number: 4, Title: "Panic from type assertion", Run: panicFromTypeAssertion},
+			{Number: 5, Title: "Safe type assertion", Run: safeTypeAssertion},
+			{Number: 6, Title: "Function that might panic", Run: functionThatMightPanic},
+			{Number: 7, Title: "Panic with formatted message", Run: panicWithFormattedMessage},
+		},
+	})
+}
+
+func Run() {
+	fmt.Println("=== Panic Examples ===")
+
+	basicPanic()
+	panicFromInvalidOperation()
+	panicFromNilPointer()
+	panicFromTypeAssertion()
+	safeTypeAssertion()
+	functionThatMightPanic()
+	panicWithFormattedMessage()
+
+	fmt.Println("All panic examples completed!")
+}
+
+// 1. Basic panic
+func basicPanic() {
+	fmt.Println("\n1. Basic panic:")
+	fmt.Println("About to panic...")
+	// panic("Something went wrong!")
+	fmt.Println("This line won't execute if panic is uncommented")
+}
+
+// 2. Panic from invalid operation
+func panicFromInvalidOperation() {
+	fmt.Println("\n2. Panic from invalid operation:")
+	var slice []int
+	// slice[0] = 1 // This would panic
+	fmt.Printf("slice == nil: %t\n", slice == nil)
+	fmt.Println("Slice access commented out to avoid panic")
+}
+
+// 3. Panic from nil pointer
+func panicFromNilPointer() {
+	fmt.Println("\n3. Panic from nil pointer:")
+	var ptr *int
+	// *ptr = 42 // This would panic
+	fmt.Printf("ptr == nil: %t\n", ptr == nil)
+	fmt.Println("Nil pointer access commented out to avoid panic")
+}
+
+// 4. Panic from type assertion
+func panicFromTypeAssertion() {
+	fmt.Println("\n4. Panic from type assertion:")
+	var i interface{} = "hello"
+	// num := i.(int) // This would panic
+	fmt.Printf("i holds a %T\n", i)
+	fmt.Println("Type assertion commented out to avoid panic")
+}
+
+// 5. Safe type assertion
+func safeTypeAssertion() {
+	fmt.Println("\n5. Safe type assertion:")
+	var i interface{} = "hello"
+	if num, ok := i.(int); ok {
+		fmt.Printf("Number: %d\n", num)
+	} else {
+		fmt.Printf("Not a number, it's a %T\n", i)
+	}
+}
+
+// 6. Function that might panic
+func functionThatMightPanic() {
+	fmt.Println("\n6. Function that might panic:")
+	// readme:begin
+	mightPanic := func(shouldPanic bool) {
+		if shouldPanic {
+			panic("Intentional panic!")
+		}
+		fmt.Println("Function completed successfully")
+	}
+	// readme:end
+
+	mightPanic(false)
+	// mightPanic(true) // This would panic
+}
+
+// 7. Panic with formatted message
+func panicWithFormattedMessage() {
+	fmt.Println("\n7. Panic with formatted message:")
+	// panic(fmt.Sprintf("Error code: %d", 404))
+	fmt.Println("Formatted panic commented out to avoid panic")
+}
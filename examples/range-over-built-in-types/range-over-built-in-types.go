@@ -1,55 +1,108 @@
-package main
+package rangeoverbuiltintypes
 
-import "fmt"
+import (
+	"fmt"
 
-func main() {
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "range-over-built-in-types",
+		Category:    examples.Intermediate,
+		Description: "Range Over Built-in Types",
+		Icon:        "🔄",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Range over slice", Run: rangeOverSlice},
+			{Number: 2, Title: "Range over array", Run: rangeOverArray},
+			{Number: 3, Title: "Range over string", Run: rangeOverString},
+			{Number: 4, Title: "Range over map", Run: rangeOverMap},
+			{Number: 5, Title: "Range over map with different value types", Run: rangeOverMapWithDifferentValueTypes},
+			{Number: 6, Title: "Range over channels", Run: rangeOverChannels},
+			{Number: 7, Title: "Range over byte slice", Run: rangeOverByteSlice},
+			{Number: 8, Title: "Range over rune slice", Run: rangeOverRuneSlice},
+			{Number: 9, Title: "Range over empty collections", Run: rangeOverEmptyCollections},
+			{Number: 10, Title: "Range with modification", Run: rangeWithModification},
+			{Number: 11, Title: "Range over multidimensional structures", Run: rangeOverMultidimensionalStructures},
+			{Number: 12, Title: "Range over interface{} slice", Run: rangeOverInterfaceSlice},
+			{Number: 13, Title: "Range with early termination", Run: rangeWithEarlyTermination},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Range Over Built-in Types Examples ===")
 
-	// 1. Range over slice
+	rangeOverSlice()
+	rangeOverArray()
+	rangeOverString()
+	rangeOverMap()
+	rangeOverMapWithDifferentValueTypes()
+	rangeOverChannels()
+	rangeOverByteSlice()
+	rangeOverRuneSlice()
+	rangeOverEmptyCollections()
+	rangeWithModification()
+	rangeOverMultidimensionalStructures()
+	rangeOverInterfaceSlice()
+	rangeWithEarlyTermination()
+}
+
+// 1. Range over slice
+func rangeOverSlice() {
 	fmt.Println("\n1. Range over slice:")
 	numbers := []int{10, 20, 30, 40, 50}
 	fmt.Printf("Numbers: %v\n", numbers)
-	
+
 	fmt.Println("With index and value:")
+	// readme:begin
 	for index, value := range numbers {
 		fmt.Printf("Index: %d, Value: %d\n", index, value)
 	}
-	
+	// readme:end
+
 	fmt.Println("Only values:")
 	for _, value := range numbers {
 		fmt.Printf("Value: %d\n", value)
 	}
-	
+
 	fmt.Println("Only indices:")
 	for index := range numbers {
 		fmt.Printf("Index: %d\n", index)
 	}
+}
 
-	// 2. Range over array
+// 2. Range over array
+func rangeOverArray() {
 	fmt.Println("\n2. Range over array:")
 	colors := [3]string{"red", "green", "blue"}
 	fmt.Printf("Colors: %v\n", colors)
-	
+
 	for i, color := range colors {
 		fmt.Printf("Index %d: %s\n", i, color)
 	}
+}
 
-	// 3. Range over string
+// 3. Range over string
+func rangeOverString() {
 	fmt.Println("\n3. Range over string:")
 	message := "Hello, 世界"
 	fmt.Printf("Message: %s\n", message)
-	
+
 	fmt.Println("With index and rune:")
 	for index, runeValue := range message {
 		fmt.Printf("Index: %d, Rune: %c, Unicode: %U\n", index, runeValue, runeValue)
 	}
-	
+
 	fmt.Println("Only runes:")
 	for _, runeValue := range message {
 		fmt.Printf("Rune: %c\n", runeValue)
 	}
+}
 
-	// 4. Range over map
+// 4. Range over map
+func rangeOverMap() {
 	fmt.Println("\n4. Range over map:")
 	ages := map[string]int{
 		"Alice":   25,
@@ -58,38 +111,42 @@ func main() {
 		"Diana":   28,
 	}
 	fmt.Printf("Ages map: %v\n", ages)
-	
+
 	fmt.Println("Key-value pairs:")
 	for name, age := range ages {
 		fmt.Printf("%s is %d years old\n", name, age)
 	}
-	
+
 	fmt.Println("Only keys:")
 	for name := range ages {
 		fmt.Printf("Name: %s\n", name)
 	}
-	
+
 	fmt.Println("Only values:")
 	for _, age := range ages {
 		fmt.Printf("Age: %d\n", age)
 	}
+}
 
-	// 5. Range over map with different value types
+// 5. Range over map with different value types
+func rangeOverMapWithDifferentValueTypes() {
 	fmt.Println("\n5. Range over map with slice values:")
 	studentsByGrade := map[string][]string{
 		"A": {"Alice", "Adam"},
 		"B": {"Bob", "Bella"},
 		"C": {"Charlie", "Carol"},
 	}
-	
+
 	for grade, students := range studentsByGrade {
 		fmt.Printf("Grade %s: %v\n", grade, students)
 	}
+}
 
-	// 6. Range over channels
+// 6. Range over channels
+func rangeOverChannels() {
 	fmt.Println("\n6. Range over channel:")
 	ch := make(chan int)
-	
+
 	// Start goroutine to send values
 	go func() {
 		defer close(ch)
@@ -97,70 +154,80 @@ func main() {
 			ch <- i * 10
 		}
 	}()
-	
+
 	fmt.Println("Receiving from channel:")
 	for value := range ch {
 		fmt.Printf("Received: %d\n", value)
 	}
+}
 
-	// 7. Range over byte slice
+// 7. Range over byte slice
+func rangeOverByteSlice() {
 	fmt.Println("\n7. Range over byte slice:")
 	data := []byte{72, 101, 108, 108, 111} // "Hello" in ASCII
 	fmt.Printf("Byte slice: %v\n", data)
-	
+
 	for i, b := range data {
 		fmt.Printf("Index %d: Byte %d, Char: %c\n", i, b, b)
 	}
+}
 
-	// 8. Range over rune slice
+// 8. Range over rune slice
+func rangeOverRuneSlice() {
 	fmt.Println("\n8. Range over rune slice:")
 	runes := []rune{'H', 'e', 'l', 'l', 'o', '世', '界'}
 	fmt.Printf("Rune slice: %v\n", runes)
-	
+
 	for i, r := range runes {
 		fmt.Printf("Index %d: Rune %c, Unicode: %U\n", i, r, r)
 	}
+}
 
-	// 9. Range over empty collections
+// 9. Range over empty collections
+func rangeOverEmptyCollections() {
 	fmt.Println("\n9. Range over empty collections:")
 	var emptySlice []int
 	var emptyMap map[string]int
 	var emptyString string
-	
+
 	fmt.Println("Empty slice:")
 	for i, v := range emptySlice {
 		fmt.Printf("This won't print: %d, %d\n", i, v)
 	}
-	
+
 	fmt.Println("Empty map:")
 	for k, v := range emptyMap {
 		fmt.Printf("This won't print: %s, %d\n", k, v)
 	}
-	
+
 	fmt.Println("Empty string:")
 	for i, r := range emptyString {
 		fmt.Printf("This won't print: %d, %c\n", i, r)
 	}
+}
 
-	// 10. Range with modification
+// 10. Range with modification
+func rangeWithModification() {
 	fmt.Println("\n10. Range with modification:")
 	slice := []int{1, 2, 3, 4, 5}
 	fmt.Printf("Original slice: %v\n", slice)
-	
+
 	// Modify values using range
 	for i, v := range slice {
 		slice[i] = v * 2
 	}
 	fmt.Printf("After modification: %v\n", slice)
+}
 
-	// 11. Range over multidimensional structures
+// 11. Range over multidimensional structures
+func rangeOverMultidimensionalStructures() {
 	fmt.Println("\n11. Range over multidimensional slice:")
 	matrix := [][]int{
 		{1, 2, 3},
 		{4, 5, 6},
 		{7, 8, 9},
 	}
-	
+
 	for rowIdx, row := range matrix {
 		fmt.Printf("Row %d: ", rowIdx)
 		for colIdx, value := range row {
@@ -168,19 +235,23 @@ func main() {
 		}
 		fmt.Println()
 	}
+}
 
-	// 12. Range over interface{} slice
+// 12. Range over interface{} slice
+func rangeOverInterfaceSlice() {
 	fmt.Println("\n12. Range over interface{} slice:")
 	mixed := []interface{}{1, "hello", 3.14, true, []int{1, 2, 3}}
-	
+
 	for i, value := range mixed {
 		fmt.Printf("Index %d: Value %v (Type: %T)\n", i, value, value)
 	}
+}
 
-	// 13. Range with early termination
+// 13. Range with early termination
+func rangeWithEarlyTermination() {
 	fmt.Println("\n13. Range with early termination:")
-	numbers = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
 	fmt.Println("Find first even number:")
 	for i, num := range numbers {
 		if num%2 == 0 {
@@ -188,7 +259,7 @@ func main() {
 			break
 		}
 	}
-	
+
 	fmt.Println("Skip first 3 elements:")
 	for i, num := range numbers {
 		if i < 3 {
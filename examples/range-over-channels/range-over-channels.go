@@ -1,17 +1,68 @@
-package main
+package rangeoverchannels
 
 import (
 	"fmt"
+	"sync"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "range-over-channels",
+		Category:    examples.Expert,
+		Description: "Range Over Channels",
+		Icon:        "📡",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic range over channel", Run: basicRangeOverChannel},
+			{Number: 2, Title: "Range over buffered channel", Run: rangeOverBufferedChannel},
+			{Number: 3, Title: "Range with early termination", Run: rangeWithEarlyTermination},
+			{Number: 4, Title: "Range over multiple channels (sequentially)", Run: rangeOverMultipleChannels},
+			{Number: 5, Title: "Range with select (multiplexing)", Run: rangeWithSelect},
+			{Number: 6, Title: "Range with timeout", Run: rangeWithTimeout},
+			{Number: 7, Title: "Range with filtering", Run: rangeWithFiltering},
+			{Number: 8, Title: "Range with transformation", Run: rangeWithTransformation},
+			{Number: 9, Title: "Range with aggregation", Run: rangeWithAggregation},
+			{Number: 10, Title: "Range with batch processing", Run: rangeWithBatchProcessing},
+			{Number: 11, Title: "Range with context cancellation", Run: rangeWithContextCancellation},
+			{Number: 12, Title: "Range with error handling", Run: rangeWithErrorHandling},
+			{Number: 13, Title: "Range with rate limiting", Run: rangeWithRateLimiting},
+			{Number: 14, Title: "Range with fan-in pattern", Run: rangeWithFanInPattern},
+			{Number: 15, Title: "Range with statistics collection", Run: rangeWithStatisticsCollection},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Range Over Channels Examples ===")
 
-	// 1. Basic range over channel
+	basicRangeOverChannel()
+	rangeOverBufferedChannel()
+	rangeWithEarlyTermination()
+	rangeOverMultipleChannels()
+	rangeWithSelect()
+	rangeWithTimeout()
+	rangeWithFiltering()
+	rangeWithTransformation()
+	rangeWithAggregation()
+	rangeWithBatchProcessing()
+	rangeWithContextCancellation()
+	rangeWithErrorHandling()
+	rangeWithRateLimiting()
+	rangeWithFanInPattern()
+	rangeWithStatisticsCollection()
+
+	fmt.Println("All range over channels examples completed!")
+}
+
+// 1. Basic range over channel
+func basicRangeOverChannel() {
 	fmt.Println("\n1. Basic range over channel:")
 	ch := make(chan int)
-	
+
 	go func() {
 		defer close(ch)
 		for i := 1; i <= 5; i++ {
@@ -19,39 +70,45 @@ func main() {
 			fmt.Printf("Sent: %d\n", i)
 		}
 	}()
-	
+
 	fmt.Println("Receiving with range:")
+	// readme:begin
 	for value := range ch {
 		fmt.Printf("Received: %d\n", value)
 	}
+	// readme:end
 	fmt.Println("Range completed")
+}
 
-	// 2. Range over buffered channel
+// 2. Range over buffered channel
+func rangeOverBufferedChannel() {
 	fmt.Println("\n2. Range over buffered channel:")
 	buffered := make(chan string, 3)
-	
+
 	// Pre-fill buffer
 	buffered <- "first"
 	buffered <- "second"
 	buffered <- "third"
-	
+
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		buffered <- "fourth"
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		buffered <- "fifth"
 		close(buffered)
 	}()
-	
+
 	fmt.Println("Ranging over buffered channel:")
 	for value := range buffered {
 		fmt.Printf("Received: %s\n", value)
 	}
+}
 
-	// 3. Range with early termination
+// 3. Range with early termination
+func rangeWithEarlyTermination() {
 	fmt.Println("\n3. Range with early termination:")
 	ch2 := make(chan int)
-	
+
 	go func() {
 		defer close(ch2)
 		for i := 1; i <= 10; i++ {
@@ -59,7 +116,7 @@ func main() {
 			fmt.Printf("Sent: %d\n", i)
 		}
 	}()
-	
+
 	fmt.Println("Ranging with early termination:")
 	count := 0
 	for value := range ch2 {
@@ -70,57 +127,61 @@ func main() {
 			break
 		}
 	}
+}
 
-	// 4. Range over multiple channels (sequentially)
+// 4. Range over multiple channels (sequentially)
+func rangeOverMultipleChannels() {
 	fmt.Println("\n4. Range over multiple channels:")
 	ch3 := make(chan int)
 	ch4 := make(chan string)
-	
+
 	go func() {
 		defer close(ch3)
 		for i := 1; i <= 3; i++ {
 			ch3 <- i
 		}
 	}()
-	
+
 	go func() {
 		defer close(ch4)
 		for i := 1; i <= 3; i++ {
 			ch4 <- fmt.Sprintf("item-%d", i)
 		}
 	}()
-	
+
 	fmt.Println("Ranging over ch3:")
 	for value := range ch3 {
 		fmt.Printf("From ch3: %d\n", value)
 	}
-	
+
 	fmt.Println("Ranging over ch4:")
 	for value := range ch4 {
 		fmt.Printf("From ch4: %s\n", value)
 	}
+}
 
-	// 5. Range with select (multiplexing)
+// 5. Range with select (multiplexing)
+func rangeWithSelect() {
 	fmt.Println("\n5. Range with select:")
 	ch5 := make(chan int)
 	ch6 := make(chan int)
-	
+
 	go func() {
 		defer close(ch5)
 		for i := 1; i <= 5; i++ {
 			ch5 <- i * 10
-			time.Sleep(50 * time.Millisecond)
+			clock.Sleep(50 * time.Millisecond)
 		}
 	}()
-	
+
 	go func() {
 		defer close(ch6)
 		for i := 1; i <= 5; i++ {
 			ch6 <- i * 100
-			time.Sleep(30 * time.Millisecond)
+			clock.Sleep(30 * time.Millisecond)
 		}
 	}()
-	
+
 	// Use select to multiplex
 	ch5Open, ch6Open := true, true
 	for ch5Open || ch6Open {
@@ -131,6 +192,7 @@ func main() {
 			} else {
 				fmt.Println("ch5 closed")
 				ch5Open = false
+				ch5 = nil // A nil channel is never selected again
 			}
 		case value, ok := <-ch6:
 			if ok {
@@ -138,25 +200,28 @@ func main() {
 			} else {
 				fmt.Println("ch6 closed")
 				ch6Open = false
+				ch6 = nil // A nil channel is never selected again
 			}
 		}
 	}
+}
 
-	// 6. Range with timeout
+// 6. Range with timeout
+func rangeWithTimeout() {
 	fmt.Println("\n6. Range with timeout:")
 	ch7 := make(chan int)
-	
+
 	go func() {
 		defer close(ch7)
 		for i := 1; i <= 10; i++ {
 			ch7 <- i
-			time.Sleep(200 * time.Millisecond)
+			clock.Sleep(200 * time.Millisecond)
 		}
 	}()
-	
+
 	fmt.Println("Ranging with timeout:")
-	timeout := time.After(1 * time.Second)
-	
+	timeout := clock.After(1 * time.Second)
+
 Loop:
 	for {
 		select {
@@ -171,12 +236,14 @@ Loop:
 			break Loop
 		}
 	}
+}
 
-	// 7. Range with filtering
+// 7. Range with filtering
+func rangeWithFiltering() {
 	fmt.Println("\n7. Range with filtering:")
 	input := make(chan int)
 	filtered := make(chan int)
-	
+
 	// Producer
 	go func() {
 		defer close(input)
@@ -184,7 +251,7 @@ Loop:
 			input <- i
 		}
 	}()
-	
+
 	// Filter
 	go func() {
 		defer close(filtered)
@@ -195,17 +262,19 @@ Loop:
 			}
 		}
 	}()
-	
+
 	fmt.Println("Filtered results:")
 	for value := range filtered {
 		fmt.Printf("Even number: %d\n", value)
 	}
+}
 
-	// 8. Range with transformation
+// 8. Range with transformation
+func rangeWithTransformation() {
 	fmt.Println("\n8. Range with transformation:")
 	source := make(chan int)
 	transformed := make(chan int)
-	
+
 	// Source
 	go func() {
 		defer close(source)
@@ -213,7 +282,7 @@ Loop:
 			source <- i
 		}
 	}()
-	
+
 	// Transformer
 	go func() {
 		defer close(transformed)
@@ -223,23 +292,25 @@ Loop:
 			fmt.Printf("Transformed %d -> %d\n", value, result)
 		}
 	}()
-	
+
 	fmt.Println("Transformed results:")
 	for value := range transformed {
 		fmt.Printf("Square: %d\n", value)
 	}
+}
 
-	// 9. Range with aggregation
+// 9. Range with aggregation
+func rangeWithAggregation() {
 	fmt.Println("\n9. Range with aggregation:")
 	numbers := make(chan int)
-	
+
 	go func() {
 		defer close(numbers)
 		for i := 1; i <= 10; i++ {
 			numbers <- i
 		}
 	}()
-	
+
 	sum := 0
 	count := 0
 	for value := range numbers {
@@ -247,40 +318,44 @@ Loop:
 		count++
 		fmt.Printf("Accumulating: %d (sum: %d, count: %d)\n", value, sum, count)
 	}
-	
+
 	fmt.Printf("Final: sum=%d, count=%d, average=%.2f\n", sum, count, float64(sum)/float64(count))
+}
 
-	// 10. Range with batch processing
+// 10. Range with batch processing
+func rangeWithBatchProcessing() {
 	fmt.Println("\n10. Range with batch processing:")
 	items := make(chan int)
-	
+
 	go func() {
 		defer close(items)
 		for i := 1; i <= 12; i++ {
 			items <- i
 		}
 	}()
-	
+
 	batch := make([]int, 0, 3)
 	for item := range items {
 		batch = append(batch, item)
-		
+
 		if len(batch) == 3 {
 			fmt.Printf("Processing batch: %v\n", batch)
 			batch = batch[:0] // Reset batch
 		}
 	}
-	
+
 	// Process remaining items
 	if len(batch) > 0 {
 		fmt.Printf("Processing final batch: %v\n", batch)
 	}
+}
 
-	// 11. Range with context cancellation
+// 11. Range with context cancellation
+func rangeWithContextCancellation() {
 	fmt.Println("\n11. Range with context cancellation:")
 	work := make(chan int)
 	stop := make(chan struct{})
-	
+
 	go func() {
 		defer close(work)
 		for i := 1; i <= 20; i++ {
@@ -291,30 +366,32 @@ Loop:
 				fmt.Println("Producer stopped")
 				return
 			}
-			time.Sleep(50 * time.Millisecond)
+			clock.Sleep(50 * time.Millisecond)
 		}
 	}()
-	
+
 	// Consumer with cancellation
 	go func() {
-		time.Sleep(300 * time.Millisecond)
+		clock.Sleep(300 * time.Millisecond)
 		close(stop)
 	}()
-	
+
 	fmt.Println("Consuming with cancellation:")
 	for value := range work {
 		fmt.Printf("Received work: %d\n", value)
 	}
+}
 
-	// 12. Range with error handling
+// 12. Range with error handling
+func rangeWithErrorHandling() {
 	fmt.Println("\n12. Range with error handling:")
 	type Result struct {
 		Value int
 		Error error
 	}
-	
+
 	results := make(chan Result)
-	
+
 	go func() {
 		defer close(results)
 		for i := 1; i <= 8; i++ {
@@ -325,7 +402,7 @@ Loop:
 			results <- Result{Value: i * 10}
 		}
 	}()
-	
+
 	fmt.Println("Processing with error handling:")
 	for result := range results {
 		if result.Error != nil {
@@ -334,34 +411,38 @@ Loop:
 			fmt.Printf("Success: %d\n", result.Value)
 		}
 	}
+}
 
-	// 13. Range with rate limiting
+// 13. Range with rate limiting
+func rangeWithRateLimiting() {
 	fmt.Println("\n13. Range with rate limiting:")
 	data := make(chan int)
-	limiter := time.NewTicker(100 * time.Millisecond)
+	limiter := clock.NewTicker(100 * time.Millisecond)
 	defer limiter.Stop()
-	
+
 	go func() {
 		defer close(data)
 		for i := 1; i <= 5; i++ {
 			data <- i
 		}
 	}()
-	
+
 	fmt.Println("Consuming with rate limiting:")
 	for value := range data {
 		<-limiter.C // Wait for ticker
-		fmt.Printf("Processed: %d at %v\n", value, time.Now().Format("15:04:05.000"))
+		fmt.Printf("Processed: %d at %v\n", value, clock.Now().Format("15:04:05.000"))
 	}
+}
 
-	// 14. Range with fan-in pattern
+// 14. Range with fan-in pattern
+func rangeWithFanInPattern() {
 	fmt.Println("\n14. Range with fan-in:")
 	inputs := []chan int{
 		make(chan int),
 		make(chan int),
 		make(chan int),
 	}
-	
+
 	// Start producers
 	for i, input := range inputs {
 		go func(id int, ch chan int) {
@@ -371,42 +452,45 @@ Loop:
 			}
 		}(i+1, input)
 	}
-	
+
 	// Fan-in
 	fanIn := make(chan int)
-	
+	var wg sync.WaitGroup
+
 	for _, input := range inputs {
+		wg.Add(1)
 		go func(ch <-chan int) {
+			defer wg.Done()
 			for value := range ch {
 				fanIn <- value
 			}
 		}(input)
 	}
-	
-	// Close fan-in when all inputs done
+
+	// Close fan-in once every forwarder has drained its input
 	go func() {
-		for _, input := range inputs {
-			<-input // Wait for close (this is a trick to wait)
-		}
+		wg.Wait()
 		close(fanIn)
 	}()
-	
+
 	fmt.Println("Fan-in results:")
 	for value := range fanIn {
 		fmt.Printf("Received: %d\n", value)
 	}
+}
 
-	// 15. Range with statistics collection
+// 15. Range with statistics collection
+func rangeWithStatisticsCollection() {
 	fmt.Println("\n15. Range with statistics:")
 	metrics := make(chan int)
-	
+
 	type Stats struct {
 		Count int
 		Sum   int
 		Min   int
 		Max   int
 	}
-	
+
 	go func() {
 		defer close(metrics)
 		numbers := []int{5, 2, 8, 1, 9, 3, 7, 4, 6}
@@ -414,7 +498,7 @@ Loop:
 			metrics <- num
 		}
 	}()
-	
+
 	stats := Stats{Min: 999999, Max: -999999}
 	for value := range metrics {
 		stats.Count++
@@ -425,13 +509,11 @@ Loop:
 		if value > stats.Max {
 			stats.Max = value
 		}
-		fmt.Printf("Processing: %d (min: %d, max: %d, sum: %d)\n", 
+		fmt.Printf("Processing: %d (min: %d, max: %d, sum: %d)\n",
 			value, stats.Min, stats.Max, stats.Sum)
 	}
-	
+
 	fmt.Printf("Final stats: Count=%d, Sum=%d, Min=%d, Max=%d, Avg=%.2f\n",
-		stats.Count, stats.Sum, stats.Min, stats.Max, 
+		stats.Count, stats.Sum, stats.Min, stats.Max,
 		float64(stats.Sum)/float64(stats.Count))
-
-	fmt.Println("All range over channels examples completed!")
 }
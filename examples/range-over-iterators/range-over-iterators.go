@@ -1,9 +1,10 @@
-package main
+package rangeoveriterators
 
 import (
 	"fmt"
 	"iter"
-	"strings"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 // 1. Basic iterator function
@@ -149,7 +150,7 @@ func merge(seq1, seq2 iter.Seq[int]) iter.Seq[int] {
 		// Create channels to collect values from both sequences
 		ch1 := make(chan int, 10)
 		ch2 := make(chan int, 10)
-		
+
 		// Start goroutines to feed channels
 		go func() {
 			defer close(ch1)
@@ -158,7 +159,7 @@ func merge(seq1, seq2 iter.Seq[int]) iter.Seq[int] {
 				return true
 			})
 		}()
-		
+
 		go func() {
 			defer close(ch2)
 			seq2(func(n int) bool {
@@ -166,7 +167,7 @@ func merge(seq1, seq2 iter.Seq[int]) iter.Seq[int] {
 				return true
 			})
 		}()
-		
+
 		// Yield values from both channels
 		for ch1 != nil || ch2 != nil {
 			select {
@@ -255,7 +256,9 @@ func permutations(arr []int) iter.Seq[[]int] {
 				}
 			} else {
 				for i := 0; i < n; i++ {
-					generate(arr, n-1)
+					if !generate(arr, n-1) {
+						return false
+					}
 					if n%2 == 0 {
 						arr[i], arr[n-1] = arr[n-1], arr[i]
 					} else {
@@ -284,25 +287,78 @@ func slowProducer(max int, delay int) iter.Seq[int] {
 	}
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "range-over-iterators",
+		Category:    examples.Advanced,
+		Description: "Custom Iterators",
+		Icon:        "🔄",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic iterator", Run: basicIterator},
+			{Number: 2, Title: "Key-value iterator", Run: keyValueIterator},
+			{Number: 3, Title: "Iterator with early stopping", Run: iteratorWithEarlyStopping},
+			{Number: 4, Title: "Tree traversal iterators", Run: treeTraversalIterators},
+			{Number: 5, Title: "Filtering iterator", Run: filteringIterator},
+			{Number: 6, Title: "String words iterator", Run: stringWordsIterator},
+			{Number: 7, Title: "Transforming iterator", Run: transformingIterator},
+			{Number: 8, Title: "Merging iterators", Run: mergingIterators},
+			{Number: 9, Title: "Stateful iterator", Run: statefulIterator},
+			{Number: 10, Title: "File lines iterator", Run: fileLinesIterator},
+			{Number: 11, Title: "Iterator with error handling", Run: iteratorWithErrorHandling},
+			{Number: 12, Title: "Permutations iterator", Run: permutationsIterator},
+			{Number: 13, Title: "Iterator with backpressure", Run: iteratorWithBackpressure},
+			{Number: 14, Title: "Using iterators with built-in functions", Run: usingIteratorsWithBuiltInFunctions},
+			{Number: 15, Title: "Chaining iterators", Run: chainingIterators},
+			{Number: 16, Title: "Iterator with custom logic", Run: iteratorWithCustomLogic},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Range Over Iterators Examples ===")
 
-	// 1. Basic iterator
+	basicIterator()
+	keyValueIterator()
+	iteratorWithEarlyStopping()
+	treeTraversalIterators()
+	filteringIterator()
+	stringWordsIterator()
+	transformingIterator()
+	mergingIterators()
+	statefulIterator()
+	fileLinesIterator()
+	iteratorWithErrorHandling()
+	permutationsIterator()
+	iteratorWithBackpressure()
+	usingIteratorsWithBuiltInFunctions()
+	chainingIterators()
+	iteratorWithCustomLogic()
+}
+
+// 1. Basic iterator
+func basicIterator() {
 	fmt.Println("\n1. Basic iterator:")
 	fmt.Print("Numbers 1-5: ")
+	// readme:begin
 	for num := range numbers() {
 		fmt.Printf("%d ", num)
 	}
+	// readme:end
 	fmt.Println()
+}
 
-	// 2. Key-value iterator
+// 2. Key-value iterator
+func keyValueIterator() {
 	fmt.Println("\n2. Key-value iterator:")
 	fmt.Println("Fruit counts:")
 	for fruit, count := range keyValuePairs() {
 		fmt.Printf("  %s: %d\n", fruit, count)
 	}
+}
 
-	// 3. Iterator with early stopping
+// 3. Iterator with early stopping
+func iteratorWithEarlyStopping() {
 	fmt.Println("\n3. Iterator with early stopping:")
 	fmt.Print("First 5 Fibonacci numbers: ")
 	count := 0
@@ -314,8 +370,10 @@ func main() {
 		}
 	}
 	fmt.Println()
+}
 
-	// 4. Tree traversal iterators
+// 4. Tree traversal iterators
+func treeTraversalIterators() {
 	fmt.Println("\n4. Tree traversal iterators:")
 	root := &TreeNode{
 		Value: 5,
@@ -330,28 +388,32 @@ func main() {
 			Right: &TreeNode{Value: 8},
 		},
 	}
-	
+
 	fmt.Print("In-order traversal: ")
 	for val := range root.InOrder() {
 		fmt.Printf("%d ", val)
 	}
 	fmt.Println()
-	
+
 	fmt.Print("Pre-order traversal: ")
 	for val := range root.PreOrder() {
 		fmt.Printf("%d ", val)
 	}
 	fmt.Println()
+}
 
-	// 5. Filtering iterator
+// 5. Filtering iterator
+func filteringIterator() {
 	fmt.Println("\n5. Filtering iterator:")
 	fmt.Print("Even numbers up to 10: ")
 	for num := range evenNumbers(10) {
 		fmt.Printf("%d ", num)
 	}
 	fmt.Println()
+}
 
-	// 6. String words iterator
+// 6. String words iterator
+func stringWordsIterator() {
 	fmt.Println("\n6. String words iterator:")
 	text := "Hello world from Go iterators"
 	fmt.Printf("Words in '%s': ", text)
@@ -359,24 +421,30 @@ func main() {
 		fmt.Printf("[%s] ", word)
 	}
 	fmt.Println()
+}
 
-	// 7. Transforming iterator
+// 7. Transforming iterator
+func transformingIterator() {
 	fmt.Println("\n7. Transforming iterator:")
 	fmt.Print("Squares of 1-5: ")
 	for square := range squares(numbers()) {
 		fmt.Printf("%d ", square)
 	}
 	fmt.Println()
+}
 
-	// 8. Merging iterators
+// 8. Merging iterators
+func mergingIterators() {
 	fmt.Println("\n8. Merging iterators:")
 	fmt.Print("Merged sequences: ")
 	for num := range merge(numbers(), evenNumbers(8)) {
 		fmt.Printf("%d ", num)
 	}
 	fmt.Println()
+}
 
-	// 9. Stateful iterator
+// 9. Stateful iterator
+func statefulIterator() {
 	fmt.Println("\n9. Stateful iterator:")
 	counter := Counter{current: 1, max: 10, step: 2}
 	fmt.Print("Counter (1 to 10, step 2): ")
@@ -384,8 +452,10 @@ func main() {
 		fmt.Printf("%d ", num)
 	}
 	fmt.Println()
+}
 
-	// 10. File lines iterator
+// 10. File lines iterator
+func fileLinesIterator() {
 	fmt.Println("\n10. File lines iterator:")
 	file := File{
 		lines: []string{
@@ -399,8 +469,10 @@ func main() {
 	for line := range file.Lines() {
 		fmt.Printf("  %s\n", line)
 	}
+}
 
-	// 11. Iterator with error handling
+// 11. Iterator with error handling
+func iteratorWithErrorHandling() {
 	fmt.Println("\n11. Iterator with error handling:")
 	fmt.Println("Safe division results:")
 	for result, err := range safeDivide(100, 10) {
@@ -410,12 +482,14 @@ func main() {
 			fmt.Printf("  Result: %d\n", result)
 		}
 	}
+}
 
-	// 12. Permutations iterator
+// 12. Permutations iterator
+func permutationsIterator() {
 	fmt.Println("\n12. Permutations iterator:")
 	arr := []int{1, 2, 3}
 	fmt.Printf("Permutations of %v (first 3):\n", arr)
-	count = 0
+	count := 0
 	for perm := range permutations(arr) {
 		fmt.Printf("  %v\n", perm)
 		count++
@@ -423,11 +497,13 @@ func main() {
 			break
 		}
 	}
+}
 
-	// 13. Iterator with backpressure
+// 13. Iterator with backpressure
+func iteratorWithBackpressure() {
 	fmt.Println("\n13. Iterator with backpressure:")
 	fmt.Print("Slow producer (first 3): ")
-	count = 0
+	count := 0
 	for num := range slowProducer(10, 1000000) {
 		fmt.Printf("%d ", num)
 		count++
@@ -436,17 +512,19 @@ func main() {
 		}
 	}
 	fmt.Println()
+}
 
-	// 14. Using iterators with built-in functions
+// 14. Using iterators with built-in functions
+func usingIteratorsWithBuiltInFunctions() {
 	fmt.Println("\n14. Using iterators with built-in functions:")
-	
+
 	// Collect all values from iterator
 	var allNumbers []int
 	for num := range numbers() {
 		allNumbers = append(allNumbers, num)
 	}
 	fmt.Printf("Collected numbers: %v\n", allNumbers)
-	
+
 	// Find first matching value
 	var firstEven int
 	found := false
@@ -460,10 +538,12 @@ func main() {
 	if found {
 		fmt.Printf("First even number > 10: %d\n", firstEven)
 	}
+}
 
-	// 15. Chaining iterators
+// 15. Chaining iterators
+func chainingIterators() {
 	fmt.Println("\n15. Chaining iterators:")
-	
+
 	// Chain: numbers -> squares -> filter for > 10
 	fmt.Print("Numbers -> squares -> > 10: ")
 	for num := range squares(numbers()) {
@@ -472,10 +552,12 @@ func main() {
 		}
 	}
 	fmt.Println()
+}
 
-	// 16. Iterator with custom logic
+// 16. Iterator with custom logic
+func iteratorWithCustomLogic() {
 	fmt.Println("\n16. Iterator with custom logic:")
-	
+
 	// Iterator that yields prime numbers
 	primes := func(max int) iter.Seq[int] {
 		return func(yield func(int) bool) {
@@ -495,7 +577,7 @@ func main() {
 			}
 		}
 	}
-	
+
 	fmt.Print("Prime numbers up to 20: ")
 	for prime := range primes(20) {
 		fmt.Printf("%d ", prime)
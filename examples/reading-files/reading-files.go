@@ -1,13 +1,25 @@
-package main
+package readingfiles
 
 import (
 	"bufio"
 	"fmt"
 	"io"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "reading-files",
+		Category:    examples.Practical,
+		Description: "Reading Files",
+		Icon:        "📖",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Reading Files ===")
 
 	// Create a test file
@@ -19,10 +31,12 @@ func main() {
 	defer os.Remove("test.txt")
 
 	// Read entire file
+	// readme:begin
 	data, err := os.ReadFile("test.txt")
 	if err != nil {
 		panic(err)
 	}
+	// readme:end
 	fmt.Printf("Read all: %s\n", string(data))
 
 	// Read file line by line
@@ -44,7 +58,7 @@ func main() {
 	file.Seek(0, 0) // Reset to beginning
 	reader := bufio.NewReader(file)
 	buffer := make([]byte, 10)
-	
+
 	fmt.Println("Reading with buffer:")
 	for {
 		n, err := reader.Read(buffer)
@@ -1,13 +1,44 @@
-package main
+package recover
 
 import (
 	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "recover",
+		Category:    examples.Expert,
+		Description: "Panic Recovery",
+		Icon:        "🛡️",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic recover", Run: basicRecover},
+			{Number: 2, Title: "Recover in different function", Run: recoverInDifferentFunction},
+			{Number: 3, Title: "Recover with error handling", Run: recoverWithErrorHandling},
+			{Number: 4, Title: "Recover with cleanup", Run: recoverWithCleanup},
+			{Number: 5, Title: "Recover with multiple panics", Run: recoverWithMultiplePanics},
+			{Number: 6, Title: "Recover with goroutines", Run: recoverWithGoroutines},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Recover Examples ===")
 
-	// 1. Basic recover
+	basicRecover()
+	recoverInDifferentFunction()
+	recoverWithErrorHandling()
+	recoverWithCleanup()
+	recoverWithMultiplePanics()
+	recoverWithGoroutines()
+
+	fmt.Println("All recover examples completed!")
+}
+
+// 1. Basic recover
+func basicRecover() {
 	fmt.Println("\n1. Basic recover:")
 	basicRecover := func() {
 		defer func() {
@@ -15,55 +46,63 @@ func main() {
 				fmt.Printf("Recovered: %v\n", r)
 			}
 		}()
-		
+
 		panic("Basic panic example")
 	}
-	
+
 	basicRecover()
+}
 
-	// 2. Recover in different function
+// 2. Recover in different function
+func recoverInDifferentFunction() {
 	fmt.Println("\n2. Recover in different function:")
 	panickingFunction := func() {
 		panic("Panic in nested function")
 	}
-	
+
 	recoveringFunction := func() {
 		defer func() {
 			if r := recover(); r != nil {
 				fmt.Printf("Recovered from nested: %v\n", r)
 			}
 		}()
-		
+
 		panickingFunction()
 	}
-	
+
 	recoveringFunction()
+}
 
-	// 3. Recover with error handling
+// 3. Recover with error handling
+func recoverWithErrorHandling() {
 	fmt.Println("\n3. Recover with error handling:")
 	safeOperation := func() (err error) {
+		// readme:begin
 		defer func() {
 			if r := recover(); r != nil {
 				err = fmt.Errorf("panic recovered: %v", r)
 			}
 		}()
-		
+		// readme:end
+
 		// Simulate operation that might panic
 		var slice []int
 		slice[0] = 1 // This will panic
-		
+
 		return nil
 	}
-	
+
 	if err := safeOperation(); err != nil {
 		fmt.Printf("Operation failed: %v\n", err)
 	}
+}
 
-	// 4. Recover with cleanup
+// 4. Recover with cleanup
+func recoverWithCleanup() {
 	fmt.Println("\n4. Recover with cleanup:")
 	operationWithCleanup := func() {
 		fmt.Println("Starting operation")
-		
+
 		defer func() {
 			if r := recover(); r != nil {
 				fmt.Printf("Panic occurred: %v\n", r)
@@ -71,14 +110,16 @@ func main() {
 			}
 			fmt.Println("Cleanup completed")
 		}()
-		
+
 		fmt.Println("Performing work...")
 		panic("Something went wrong")
 	}
-	
+
 	operationWithCleanup()
+}
 
-	// 5. Recover with multiple panics
+// 5. Recover with multiple panics
+func recoverWithMultiplePanics() {
 	fmt.Println("\n5. Recover with multiple panics:")
 	multiplePanics := func() {
 		defer func() {
@@ -86,19 +127,21 @@ func main() {
 				fmt.Printf("First recover: %v\n", r)
 			}
 		}()
-		
+
 		defer func() {
 			if r := recover(); r != nil {
 				fmt.Printf("Second recover: %v\n", r)
 			}
 		}()
-		
+
 		panic("Multiple panic test")
 	}
-	
+
 	multiplePanics()
+}
 
-	// 6. Recover with goroutines
+// 6. Recover with goroutines
+func recoverWithGoroutines() {
 	fmt.Println("\n6. Recover with goroutines:")
 	go func() {
 		defer func() {
@@ -106,13 +149,11 @@ func main() {
 				fmt.Printf("Goroutine recovered: %v\n", r)
 			}
 		}()
-		
+
 		panic("Goroutine panic")
 	}()
-	
+
 	// Give goroutine time to execute
 	fmt.Println("Main function continuing...")
 	fmt.Println("Goroutine panic handled separately")
-
-	fmt.Println("All recover examples completed!")
 }
@@ -1,8 +1,14 @@
-package main
+package recursion
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
 
 // Basic factorial recursion
+// readme:begin
+
 func factorial(n int) int {
 	if n <= 1 {
 		return 1
@@ -10,6 +16,8 @@ func factorial(n int) int {
 	return n * factorial(n-1)
 }
 
+// readme:end
+
 // Fibonacci recursion (inefficient but demonstrates recursion)
 func fibonacci(n int) int {
 	if n <= 1 {
@@ -50,9 +58,9 @@ func binarySearch(arr []int, target, left, right int) int {
 	if left > right {
 		return -1
 	}
-	
+
 	mid := left + (right-left)/2
-	
+
 	if arr[mid] == target {
 		return mid
 	} else if arr[mid] > target {
@@ -92,12 +100,12 @@ func (n *TreeNode) inOrderTraversal() []int {
 	if n == nil {
 		return []int{}
 	}
-	
+
 	result := []int{}
 	result = append(result, n.Left.inOrderTraversal()...)
 	result = append(result, n.Value)
 	result = append(result, n.Right.inOrderTraversal()...)
-	
+
 	return result
 }
 
@@ -122,20 +130,20 @@ func permutations(arr []int) [][]int {
 	if len(arr) <= 1 {
 		return [][]int{arr}
 	}
-	
+
 	var result [][]int
-	
+
 	for i, num := range arr {
 		rest := make([]int, 0, len(arr)-1)
 		rest = append(rest, arr[:i]...)
 		rest = append(rest, arr[i+1:]...)
-		
+
 		for _, perm := range permutations(rest) {
 			newPerm := append([]int{num}, perm...)
 			result = append(result, newPerm)
 		}
 	}
-	
+
 	return result
 }
 
@@ -150,16 +158,16 @@ func combinations(arr []int, r int) [][]int {
 	if len(arr) == r {
 		return [][]int{arr}
 	}
-	
+
 	// Include first element
 	withFirst := combinations(arr[1:], r-1)
 	for i := range withFirst {
 		withFirst[i] = append([]int{arr[0]}, withFirst[i]...)
 	}
-	
+
 	// Exclude first element
 	withoutFirst := combinations(arr[1:], r)
-	
+
 	// Combine both results
 	return append(withFirst, withoutFirst...)
 }
@@ -170,7 +178,7 @@ func towerOfHanoi(n int, source, destination, auxiliary string, moves *[]string)
 		*moves = append(*moves, fmt.Sprintf("Move disk 1 from %s to %s", source, destination))
 		return
 	}
-	
+
 	towerOfHanoi(n-1, source, auxiliary, destination, moves)
 	*moves = append(*moves, fmt.Sprintf("Move disk %d from %s to %s", n, source, destination))
 	towerOfHanoi(n-1, auxiliary, destination, source, moves)
@@ -185,13 +193,13 @@ type Graph struct {
 func (g *Graph) DFS(vertex int) []int {
 	g.Visited[vertex] = true
 	result := []int{vertex}
-	
+
 	for _, neighbor := range g.Vertices[vertex] {
 		if !g.Visited[neighbor] {
 			result = append(result, g.DFS(neighbor)...)
 		}
 	}
-	
+
 	return result
 }
 
@@ -200,48 +208,103 @@ func memoizedFibonacci(n int, memo map[int]int) int {
 	if n <= 1 {
 		return n
 	}
-	
+
 	if val, exists := memo[n]; exists {
 		return val
 	}
-	
+
 	memo[n] = memoizedFibonacci(n-1, memo) + memoizedFibonacci(n-2, memo)
 	return memo[n]
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "recursion",
+		Category:    examples.Beginner,
+		Description: "Recursive Functions",
+		Icon:        "🌳",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Factorial", Run: factorial2},
+			{Number: 2, Title: "Fibonacci", Run: fibonacci2},
+			{Number: 3, Title: "Sum of array", Run: sumOfArray},
+			{Number: 4, Title: "Reverse string", Run: reverseString2},
+			{Number: 5, Title: "Power function", Run: powerFunction},
+			{Number: 6, Title: "Binary search", Run: binarySearch2},
+			{Number: 7, Title: "GCD", Run: gcd2},
+			{Number: 8, Title: "Palindrome check", Run: palindromeCheck},
+			{Number: 9, Title: "Tree traversal", Run: treeTraversal},
+			{Number: 10, Title: "File structure", Run: fileStructure},
+			{Number: 11, Title: "Permutations", Run: permutations2},
+			{Number: 12, Title: "Combinations", Run: combinations2},
+			{Number: 13, Title: "Tower of Hanoi", Run: towerOfHanoi2},
+			{Number: 14, Title: "Graph DFS", Run: graphDFS},
+			{Number: 15, Title: "Memoized Fibonacci", Run: memoizedFibonacci2},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Recursion Examples ===")
 
-	// 1. Factorial
+	factorial2()
+	fibonacci2()
+	sumOfArray()
+	reverseString2()
+	powerFunction()
+	binarySearch2()
+	gcd2()
+	palindromeCheck()
+	treeTraversal()
+	fileStructure()
+	permutations2()
+	combinations2()
+	towerOfHanoi2()
+	graphDFS()
+	memoizedFibonacci2()
+}
+
+// 1. Factorial
+func factorial2() {
 	fmt.Println("\n1. Factorial:")
 	fmt.Printf("Factorial of 5: %d\n", factorial(5))
 	fmt.Printf("Factorial of 6: %d\n", factorial(6))
 	fmt.Printf("Factorial of 0: %d\n", factorial(0))
+}
 
-	// 2. Fibonacci
+// 2. Fibonacci
+func fibonacci2() {
 	fmt.Println("\n2. Fibonacci:")
 	fmt.Printf("Fibonacci of 10: %d\n", fibonacci(10))
 	fmt.Printf("Fibonacci of 7: %d\n", fibonacci(7))
+}
 
-	// 3. Sum of array
+// 3. Sum of array
+func sumOfArray() {
 	fmt.Println("\n3. Sum of array:")
 	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
 	fmt.Printf("Sum of %v: %d\n", numbers, sumArray(numbers, 0))
+}
 
-	// 4. Reverse string
+// 4. Reverse string
+func reverseString2() {
 	fmt.Println("\n4. Reverse string:")
 	original := "Hello, World!"
 	reversed := reverseString(original)
 	fmt.Printf("Original: %s\n", original)
 	fmt.Printf("Reversed: %s\n", reversed)
+}
 
-	// 5. Power function
+// 5. Power function
+func powerFunction() {
 	fmt.Println("\n5. Power function:")
 	fmt.Printf("2^8 = %d\n", power(2, 8))
 	fmt.Printf("3^4 = %d\n", power(3, 4))
 	fmt.Printf("5^0 = %d\n", power(5, 0))
+}
 
-	// 6. Binary search
+// 6. Binary search
+func binarySearch2() {
 	fmt.Println("\n6. Binary search:")
 	sorted := []int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19}
 	target := 7
@@ -251,20 +314,26 @@ func main() {
 	target = 8
 	index = binarySearch(sorted, target, 0, len(sorted)-1)
 	fmt.Printf("Found %d at index %d in %v\n", target, index, sorted)
+}
 
-	// 7. GCD
+// 7. GCD
+func gcd2() {
 	fmt.Println("\n7. Greatest Common Divisor:")
 	fmt.Printf("GCD of 48 and 18: %d\n", gcd(48, 18))
 	fmt.Printf("GCD of 17 and 23: %d\n", gcd(17, 23))
+}
 
-	// 8. Palindrome check
+// 8. Palindrome check
+func palindromeCheck() {
 	fmt.Println("\n8. Palindrome check:")
 	palindromes := []string{"racecar", "madam", "hello", "level"}
 	for _, word := range palindromes {
 		fmt.Printf("Is '%s' a palindrome? %t\n", word, isPalindrome(word))
 	}
+}
 
-	// 9. Tree traversal
+// 9. Tree traversal
+func treeTraversal() {
 	fmt.Println("\n9. Tree traversal:")
 	root := &TreeNode{
 		Value: 5,
@@ -280,8 +349,10 @@ func main() {
 		},
 	}
 	fmt.Printf("In-order traversal: %v\n", root.inOrderTraversal())
+}
 
-	// 10. File structure
+// 10. File structure
+func fileStructure() {
 	fmt.Println("\n10. File structure:")
 	fileSystem := File{
 		Name:  "root",
@@ -301,8 +372,10 @@ func main() {
 	}
 	fmt.Println("Directory structure:")
 	printFileStructure([]File{fileSystem}, "")
+}
 
-	// 11. Permutations
+// 11. Permutations
+func permutations2() {
 	fmt.Println("\n11. Permutations:")
 	arr := []int{1, 2, 3}
 	perms := permutations(arr)
@@ -310,16 +383,20 @@ func main() {
 	for i, perm := range perms {
 		fmt.Printf("  %d: %v\n", i+1, perm)
 	}
+}
 
-	// 12. Combinations
+// 12. Combinations
+func combinations2() {
 	fmt.Println("\n12. Combinations:")
 	combs := combinations([]int{1, 2, 3, 4}, 2)
 	fmt.Printf("Combinations of [1,2,3,4] taken 2 at a time:\n")
 	for i, comb := range combs {
 		fmt.Printf("  %d: %v\n", i+1, comb)
 	}
+}
 
-	// 13. Tower of Hanoi
+// 13. Tower of Hanoi
+func towerOfHanoi2() {
 	fmt.Println("\n13. Tower of Hanoi:")
 	var moves []string
 	towerOfHanoi(3, "A", "C", "B", &moves)
@@ -327,8 +404,10 @@ func main() {
 	for i, move := range moves {
 		fmt.Printf("  %d: %s\n", i+1, move)
 	}
+}
 
-	// 14. Graph DFS
+// 14. Graph DFS
+func graphDFS() {
 	fmt.Println("\n14. Graph DFS:")
 	graph := Graph{
 		Vertices: map[int][]int{
@@ -340,8 +419,10 @@ func main() {
 		Visited: make(map[int]bool),
 	}
 	fmt.Printf("DFS starting from vertex 2: %v\n", graph.DFS(2))
+}
 
-	// 15. Memoized Fibonacci
+// 15. Memoized Fibonacci
+func memoizedFibonacci2() {
 	fmt.Println("\n15. Memoized Fibonacci:")
 	memo := make(map[int]int)
 	fmt.Printf("Memoized Fibonacci of 50: %d\n", memoizedFibonacci(50, memo))
@@ -1,19 +1,33 @@
-package main
+package regularexpressions
 
 import (
 	"fmt"
 	"regexp"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "regular-expressions",
+		Category:    examples.Practical,
+		Description: "Regular Expressions",
+		Icon:        "🔍",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Regular Expressions ===")
 
 	// Basic match
 	text := "The quick brown fox jumps over the lazy dog"
-	
+
 	// Find all words
+	// readme:begin
 	words := regexp.MustCompile(`\w+`)
 	fmt.Printf("Words: %v\n", words.FindAllString(text, -1))
+	// readme:end
 
 	// Find email pattern
 	email := regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`)
@@ -1,46 +1,100 @@
-package main
+package selects
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "select",
+		Category:    examples.Expert,
+		Description: "Select Statements",
+		Icon:        "🔀",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic select with multiple channels", Run: basicSelectWithMultipleChannels},
+			{Number: 2, Title: "Select with default case (non-blocking)", Run: selectWithDefaultCase},
+			{Number: 3, Title: "Select with timeout", Run: selectWithTimeout},
+			{Number: 4, Title: "Select with multiple cases", Run: selectWithMultipleCases},
+			{Number: 5, Title: "Select in loop (channel multiplexing)", Run: selectInLoop},
+			{Number: 6, Title: "Select for random selection", Run: selectForRandomSelection},
+			{Number: 7, Title: "Select with empty case", Run: selectWithEmptyCase},
+			{Number: 8, Title: "Select with send operations", Run: selectWithSendOperations},
+			{Number: 9, Title: "Select with nil channels", Run: selectWithNilChannels},
+			{Number: 10, Title: "Select for load balancing", Run: selectForLoadBalancing},
+			{Number: 11, Title: "Select with multiple timeouts", Run: selectWithMultipleTimeouts},
+			{Number: 12, Title: "Select for channel closing detection", Run: selectForChannelClosingDetection},
+			{Number: 13, Title: "Select with ticker integration", Run: selectWithTickerIntegration},
+			{Number: 14, Title: "Select pattern: Quit channel", Run: selectPatternQuitChannel},
+			{Number: 15, Title: "Select for heartbeat pattern", Run: selectForHeartbeatPattern},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Select Examples ===")
 
-	// 1. Basic select with multiple channels
+	basicSelectWithMultipleChannels()
+	selectWithDefaultCase()
+	selectWithTimeout()
+	selectWithMultipleCases()
+	selectInLoop()
+	selectForRandomSelection()
+	selectWithEmptyCase()
+	selectWithSendOperations()
+	selectWithNilChannels()
+	selectForLoadBalancing()
+	selectWithMultipleTimeouts()
+	selectForChannelClosingDetection()
+	selectWithTickerIntegration()
+	selectPatternQuitChannel()
+	selectForHeartbeatPattern()
+
+	fmt.Println("All select examples completed!")
+}
+
+// 1. Basic select with multiple channels
+func basicSelectWithMultipleChannels() {
 	fmt.Println("\n1. Basic select:")
 	ch1 := make(chan string)
 	ch2 := make(chan string)
-	
+
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		ch1 <- "from channel 1"
 	}()
-	
+
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch2 <- "from channel 2"
 	}()
-	
+
 	select {
 	case msg1 := <-ch1:
 		fmt.Printf("Received: %s\n", msg1)
 	case msg2 := <-ch2:
 		fmt.Printf("Received: %s\n", msg2)
 	}
+}
 
-	// 2. Select with default case (non-blocking)
+// 2. Select with default case (non-blocking)
+func selectWithDefaultCase() {
 	fmt.Println("\n2. Select with default:")
 	ch3 := make(chan int)
-	
+
+	// readme:begin
 	select {
 	case value := <-ch3:
 		fmt.Printf("Received: %d\n", value)
 	default:
 		fmt.Println("No data available, default case executed")
 	}
-	
+	// readme:end
+
 	// Send with default
 	select {
 	case ch3 <- 42:
@@ -48,44 +102,48 @@ func main() {
 	default:
 		fmt.Println("Could not send, channel blocked")
 	}
+}
 
-	// 3. Select with timeout
+// 3. Select with timeout
+func selectWithTimeout() {
 	fmt.Println("\n3. Select with timeout:")
 	ch4 := make(chan string)
-	
+
 	go func() {
-		time.Sleep(200 * time.Millisecond)
+		clock.Sleep(200 * time.Millisecond)
 		ch4 <- "delayed message"
 	}()
-	
+
 	select {
 	case msg := <-ch4:
 		fmt.Printf("Received: %s\n", msg)
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Timeout occurred")
 	}
+}
 
-	// 4. Select with multiple cases
+// 4. Select with multiple cases
+func selectWithMultipleCases() {
 	fmt.Println("\n4. Select with multiple cases:")
 	ch5 := make(chan int)
 	ch6 := make(chan string)
 	ch7 := make(chan bool)
-	
+
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch5 <- 100
 	}()
-	
+
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		ch6 <- "hello"
 	}()
-	
+
 	go func() {
-		time.Sleep(150 * time.Millisecond)
+		clock.Sleep(150 * time.Millisecond)
 		ch7 <- true
 	}()
-	
+
 	for i := 0; i < 3; i++ {
 		select {
 		case num := <-ch5:
@@ -96,29 +154,31 @@ func main() {
 			fmt.Printf("Received boolean: %t\n", flag)
 		}
 	}
+}
 
-	// 5. Select in loop (channel multiplexing)
+// 5. Select in loop (channel multiplexing)
+func selectInLoop() {
 	fmt.Println("\n5. Select in loop:")
 	input1 := make(chan int)
 	input2 := make(chan int)
-	
+
 	// Producers
 	go func() {
 		defer close(input1)
 		for i := 1; i <= 3; i++ {
 			input1 <- i
-			time.Sleep(50 * time.Millisecond)
+			clock.Sleep(50 * time.Millisecond)
 		}
 	}()
-	
+
 	go func() {
 		defer close(input2)
 		for i := 4; i <= 6; i++ {
 			input2 <- i
-			time.Sleep(30 * time.Millisecond)
+			clock.Sleep(30 * time.Millisecond)
 		}
 	}()
-	
+
 	// Consumer with select
 	for {
 		select {
@@ -135,26 +195,28 @@ func main() {
 				fmt.Printf("From input2: %d\n", val)
 			}
 		}
-		
+
 		if input1 == nil && input2 == nil {
 			break
 		}
 	}
+}
 
-	// 6. Select for random selection
+// 6. Select for random selection
+func selectForRandomSelection() {
 	fmt.Println("\n6. Select for random selection:")
 	ch8 := make(chan string)
 	ch9 := make(chan string)
-	
+
 	// Send to both channels
 	go func() {
 		ch8 <- "first"
 	}()
-	
+
 	go func() {
 		ch9 <- "second"
 	}()
-	
+
 	// Random selection
 	select {
 	case msg1 := <-ch8:
@@ -162,27 +224,31 @@ func main() {
 	case msg2 := <-ch9:
 		fmt.Printf("Selected: %s\n", msg2)
 	}
+}
 
-	// 7. Select with empty case
+// 7. Select with empty case
+func selectWithEmptyCase() {
 	fmt.Println("\n7. Select with empty case:")
 	ch10 := make(chan int)
-	
+
 	// This select will block until ch10 receives data
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		ch10 <- 42
 	}()
-	
+
 	select {
 	case value := <-ch10:
 		fmt.Printf("Received: %d\n", value)
 	}
+}
 
-	// 8. Select with send operations
+// 8. Select with send operations
+func selectWithSendOperations() {
 	fmt.Println("\n8. Select with send operations:")
 	ch11 := make(chan int)
 	ch12 := make(chan int)
-	
+
 	// Receiver
 	go func() {
 		for i := 0; i < 3; i++ {
@@ -194,7 +260,7 @@ func main() {
 			}
 		}
 	}()
-	
+
 	// Senders
 	for i := 1; i <= 3; i++ {
 		select {
@@ -203,19 +269,21 @@ func main() {
 		case ch12 <- i * 100:
 			fmt.Printf("Sent %d to ch12\n", i*100)
 		}
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 	}
+}
 
-	// 9. Select with nil channels
+// 9. Select with nil channels
+func selectWithNilChannels() {
 	fmt.Println("\n9. Select with nil channels:")
 	var ch13 chan int // nil channel
 	ch14 := make(chan int)
-	
+
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch14 <- 200
 	}()
-	
+
 	select {
 	case val := <-ch13:
 		fmt.Printf("From nil channel: %d\n", val) // This will never execute
@@ -224,21 +292,23 @@ func main() {
 	default:
 		fmt.Println("Default case")
 	}
+}
 
-	// 10. Select for load balancing
+// 10. Select for load balancing
+func selectForLoadBalancing() {
 	fmt.Println("\n10. Select for load balancing:")
 	workers := []chan int{make(chan int), make(chan int), make(chan int)}
-	
+
 	// Start workers
 	for i, worker := range workers {
 		go func(id int, w chan int) {
 			for job := range w {
 				fmt.Printf("Worker %d processing job %d\n", id, job)
-				time.Sleep(50 * time.Millisecond)
+				clock.Sleep(50 * time.Millisecond)
 			}
 		}(i, worker)
 	}
-	
+
 	// Distribute jobs using select
 	for job := 1; job <= 6; job++ {
 		select {
@@ -250,42 +320,47 @@ func main() {
 			fmt.Printf("Sent job %d to worker 2\n", job)
 		}
 	}
-	
+
 	// Close workers
 	for _, worker := range workers {
 		close(worker)
 	}
-	time.Sleep(400 * time.Millisecond)
+	clock.Sleep(400 * time.Millisecond)
+}
 
-	// 11. Select with multiple timeouts
+// 11. Select with multiple timeouts
+func selectWithMultipleTimeouts() {
 	fmt.Println("\n11. Select with multiple timeouts:")
 	ch15 := make(chan string)
-	
+
 	go func() {
-		time.Sleep(150 * time.Millisecond)
+		clock.Sleep(150 * time.Millisecond)
 		ch15 <- "ready"
 	}()
-	
+
 	select {
 	case msg := <-ch15:
 		fmt.Printf("Received: %s\n", msg)
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Short timeout")
-	case <-time.After(200 * time.Millisecond):
+	case <-clock.After(200 * time.Millisecond):
 		fmt.Println("Long timeout") // This won't execute if short timeout fires first
 	}
+}
 
-	// 12. Select for channel closing detection
+// 12. Select for channel closing detection
+func selectForChannelClosingDetection() {
 	fmt.Println("\n12. Select for channel closing:")
 	ch16 := make(chan int)
-	
+
 	go func() {
 		for i := 1; i <= 3; i++ {
 			ch16 <- i
 		}
 		close(ch16)
 	}()
-	
+
+closeLoop:
 	for {
 		select {
 		case value, ok := <-ch16:
@@ -293,22 +368,24 @@ func main() {
 				fmt.Printf("Received: %d\n", value)
 			} else {
 				fmt.Println("Channel closed")
-				return
+				break closeLoop
 			}
 		}
 	}
+}
 
-	// 13. Select with ticker integration
+// 13. Select with ticker integration
+func selectWithTickerIntegration() {
 	fmt.Println("\n13. Select with ticker:")
 	ch17 := make(chan int)
-	ticker := time.NewTicker(100 * time.Millisecond)
+	ticker := clock.NewTicker(100 * time.Millisecond)
 	defer ticker.Stop()
-	
+
 	go func() {
-		time.Sleep(250 * time.Millisecond)
+		clock.Sleep(250 * time.Millisecond)
 		ch17 <- 42
 	}()
-	
+
 	for i := 0; i < 5; i++ {
 		select {
 		case value := <-ch17:
@@ -318,12 +395,14 @@ func main() {
 			fmt.Printf("Tick %d\n", i+1)
 		}
 	}
+}
 
-	// 14. Select pattern: Quit channel
+// 14. Select pattern: Quit channel
+func selectPatternQuitChannel() {
 	fmt.Println("\n14. Select with quit channel:")
 	workChan := make(chan int)
 	quitChan := make(chan bool)
-	
+
 	// Worker
 	go func() {
 		for {
@@ -336,46 +415,48 @@ func main() {
 			}
 		}
 	}()
-	
+
 	// Send some work
 	for i := 1; i <= 3; i++ {
 		workChan <- i
 	}
-	
-	time.Sleep(100 * time.Millisecond)
-	
+
+	clock.Sleep(100 * time.Millisecond)
+
 	// Send quit signal
 	quitChan <- true
-	time.Sleep(50 * time.Millisecond)
+	clock.Sleep(50 * time.Millisecond)
+}
 
-	// 15. Select for heartbeat pattern
+// 15. Select for heartbeat pattern
+func selectForHeartbeatPattern() {
 	fmt.Println("\n15. Select for heartbeat:")
 	dataChan := make(chan int)
 	heartbeatChan := make(chan time.Time)
-	
+
 	// Data producer
 	go func() {
 		for i := 1; i <= 3; i++ {
 			dataChan <- i
-			time.Sleep(200 * time.Millisecond)
+			clock.Sleep(200 * time.Millisecond)
 		}
 		close(dataChan)
 	}()
-	
+
 	// Heartbeat generator
 	go func() {
-		ticker := time.NewTicker(100 * time.Millisecond)
+		ticker := clock.NewTicker(100 * time.Millisecond)
 		defer ticker.Stop()
-		
-		for {
+
+		for t := range ticker.C {
 			select {
-			case heartbeatChan <- time.Now():
-			case <-time.After(50 * time.Millisecond):
-				return
+			case heartbeatChan <- t:
+			case <-clock.After(50 * time.Millisecond):
+				return // Consumer has gone away
 			}
 		}
 	}()
-	
+
 	// Consumer with heartbeat
 	for {
 		select {
@@ -389,7 +470,6 @@ func main() {
 			fmt.Printf("Heartbeat: %v\n", heartbeat.Format("15:04:05.000"))
 		}
 	}
-	
+
 done:
-	fmt.Println("All select examples completed!")
 }
@@ -1,17 +1,32 @@
-package main
+package sha256hashes
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "sha256-hashes",
+		Category:    examples.Practical,
+		Description: "SHA256 Hashes",
+		Icon:        "🔐",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== SHA256 Hashes ===")
 
 	// Hash string
+	// readme:begin
 	text := "Hello, World!"
 	hash := sha256.Sum256([]byte(text))
+	// readme:end
 	fmt.Printf("SHA256 of '%s': %x\n", text, hash)
 
 	// Hash with hex encoding
@@ -26,7 +41,7 @@ func main() {
 	// Compare hashes
 	text2 := "Hello, World!"
 	hash2 := sha256.Sum256([]byte(text2))
-	
+
 	fmt.Printf("Hashes equal: %t\n", hash == hash2)
 
 	// Hash different text
@@ -51,7 +66,7 @@ func main() {
 	fmt.Printf("Incremental hash: %x\n", incrementalHash)
 
 	// Verify incremental hash equals direct hash
-	fmt.Printf("Incremental equals direct: %t\n", incrementalHash == hash[:])
+	fmt.Printf("Incremental equals direct: %t\n", bytes.Equal(incrementalHash, hash[:]))
 
 	// Hash large data
 	largeData := make([]byte, 1000)
@@ -1,14 +1,28 @@
-package main
+package signals
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "signals",
+		Category:    examples.Practical,
+		Description: "Signal Handling",
+		Icon:        "📡",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Signals ===")
 
 	// Create channel for signals
@@ -31,7 +45,7 @@ func main() {
 		case syscall.SIGINT:
 			fmt.Println("\nReceived SIGINT (Interrupt)")
 			fmt.Println("Cleaning up...")
-			time.Sleep(1 * time.Second)
+			clock.Sleep(1 * time.Second)
 			fmt.Println("Goodbye!")
 			return
 		case syscall.SIGTERM:
@@ -50,20 +64,20 @@ func main() {
 // Signal handling with context
 func signalWithContext() {
 	fmt.Println("\n--- Signal with Context ---")
-	
+
 	ctx, cancel := context.WithCancel(context.Background())
 	sigChan := make(chan os.Signal, 1)
-	
+
 	// Notify for SIGINT and SIGTERM
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	
+
 	// Handle signals in goroutine
 	go func() {
 		sig := <-sigChan
 		fmt.Printf("Received signal %v, cancelling context\n", sig)
 		cancel()
 	}()
-	
+
 	// Wait for context cancellation
 	<-ctx.Done()
 	fmt.Println("Context cancelled, shutting down")
@@ -72,15 +86,17 @@ func signalWithContext() {
 // Multiple signal handlers
 func multipleSignalHandlers() {
 	fmt.Println("\n--- Multiple Signal Handlers ---")
-	
+
 	intChan := make(chan os.Signal, 1)
 	termChan := make(chan os.Signal, 1)
 	hupChan := make(chan os.Signal, 1)
-	
+
+	// readme:begin
 	signal.Notify(intChan, syscall.SIGINT)
 	signal.Notify(termChan, syscall.SIGTERM)
+	// readme:end
 	signal.Notify(hupChan, syscall.SIGHUP)
-	
+
 	for {
 		select {
 		case <-intChan:
@@ -99,24 +115,21 @@ func multipleSignalHandlers() {
 // Signal masking
 func signalMasking() {
 	fmt.Println("\n--- Signal Masking ---")
-	
+
 	// Block SIGINT during critical section
 	sigChan := make(chan os.Signal, 1)
-	
-	// Create a mask to block SIGINT
-	mask := syscall.SIGINT
-	oldMask := syscall.Sigset{}
-	
+
+	// Go does not expose sigprocmask, so ignore SIGINT instead
 	fmt.Println("Blocking SIGINT during critical operation...")
-	// syscall.Sigprocmask(syscall.SIG_BLOCK, &mask, &oldMask)
-	
+	signal.Ignore(syscall.SIGINT)
+
 	// Simulate critical work
-	time.Sleep(2 * time.Second)
-	
-	// Restore original mask
-	// syscall.Sigprocmask(syscall.SIG_SETMASK, &oldMask, nil)
+	clock.Sleep(2 * time.Second)
+
+	// Restore default handling
+	signal.Reset(syscall.SIGINT)
 	fmt.Println("SIGINT unblocked")
-	
+
 	signal.Notify(sigChan, syscall.SIGINT)
 	<-sigChan
 	fmt.Println("SIGINT received after unblock")
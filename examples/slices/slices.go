@@ -1,8 +1,22 @@
-package main
-
-import "fmt"
+package slices
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "slices",
+		Category:    examples.Beginner,
+		Description: "Slice Operations",
+		Icon:        "🔪",
+		Run:         Run,
+	})
+}
 
-func main() {
+func Run() {
 	fmt.Println("=== Slices Examples ===")
 
 	// Creating slices from arrays
@@ -28,9 +42,11 @@ func main() {
 	fmt.Printf("After append: %v, Length: %d, Capacity: %d\n", numbers, len(numbers), cap(numbers))
 
 	// Appending another slice
+	// readme:begin
 	moreNumbers := []int{4, 5, 6}
 	numbers = append(numbers, moreNumbers...)
 	fmt.Printf("After appending slice: %v\n", numbers)
+	// readme:end
 
 	// Copying slices
 	source := []int{10, 20, 30, 40, 50}
@@ -43,16 +59,16 @@ func main() {
 	// Slice operations
 	fmt.Println("\nSlice operations:")
 	data := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	
+
 	// Slicing
 	fmt.Printf("Original: %v\n", data)
-	fmt.Printf("data[2:5]: %v\n", data[2:5])   // Elements 2,3,4
-	fmt.Printf("data[:3]: %v\n", data[:3])     // Elements 0,1,2
-	fmt.Printf("data[7:]: %v\n", data[7:])     // Elements 7,8,9
-	fmt.Printf("data[:]: %v\n", data[:])       // All elements
+	fmt.Printf("data[2:5]: %v\n", data[2:5]) // Elements 2,3,4
+	fmt.Printf("data[:3]: %v\n", data[:3])   // Elements 0,1,2
+	fmt.Printf("data[7:]: %v\n", data[7:])   // Elements 7,8,9
+	fmt.Printf("data[:]: %v\n", data[:])     // All elements
 
 	// Modifying slice elements
-	slice := []int{1, 2, 3, 4, 5}
+	slice = []int{1, 2, 3, 4, 5}
 	slice[2] = 99
 	fmt.Printf("Modified slice: %v\n", slice)
 
@@ -88,14 +104,14 @@ func main() {
 		{4, 5, 6},
 		{7, 8, 9},
 	}
-	
+
 	for i, row := range matrix {
 		fmt.Printf("Row %d: %v\n", i, row)
 	}
 
 	// Filtering slices
 	fmt.Println("\nFiltering slice:")
-	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	numbers = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
 	var even []int
 	for _, num := range numbers {
 		if num%2 == 0 {
@@ -1,9 +1,10 @@
-package main
+package sortingbyfunctions
 
 import (
 	"fmt"
 	"sort"
-	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 type Product struct {
@@ -13,42 +14,85 @@ type Product struct {
 	Rating   float64
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "sorting-by-functions",
+		Category:    examples.Expert,
+		Description: "Custom Sorting",
+		Icon:        "🎯",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Sort by price", Run: sortByPrice},
+			{Number: 2, Title: "Sort by name", Run: sortByName},
+			{Number: 3, Title: "Sort by rating (descending)", Run: sortByRating},
+			{Number: 4, Title: "Multi-criteria sorting", Run: multiCriteriaSorting},
+			{Number: 5, Title: "Sort with custom comparator function", Run: sortWithCustomComparatorFunction},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Sorting by Functions Examples ===")
 
-	// 1. Sort by price
-	fmt.Println("\n1. Sort by price:")
-	products := []Product{
+	sortByPrice()
+	sortByName()
+	sortByRating()
+	multiCriteriaSorting()
+	sortWithCustomComparatorFunction()
+
+	fmt.Println("All sorting by functions examples completed!")
+}
+
+// sampleProducts returns a fresh, unsorted product list for each section
+func sampleProducts() []Product {
+	return []Product{
 		{"Laptop", 999.99, "Electronics", 4.5},
 		{"Phone", 699.99, "Electronics", 4.7},
 		{"Book", 19.99, "Books", 4.2},
 		{"Headphones", 149.99, "Electronics", 4.3},
 		{"Coffee", 9.99, "Food", 4.8},
 	}
-	
+}
+
+// 1. Sort by price
+func sortByPrice() {
+	fmt.Println("\n1. Sort by price:")
+	products := sampleProducts()
+
 	fmt.Printf("Original: %v\n", products)
-	
+
+	// readme:begin
 	sort.Slice(products, func(i, j int) bool {
 		return products[i].Price < products[j].Price
 	})
+	// readme:end
 	fmt.Printf("Sorted by price: %v\n", products)
+}
 
-	// 2. Sort by name
+// 2. Sort by name
+func sortByName() {
 	fmt.Println("\n2. Sort by name:")
+	products := sampleProducts()
 	sort.Slice(products, func(i, j int) bool {
 		return products[i].Name < products[j].Name
 	})
 	fmt.Printf("Sorted by name: %v\n", products)
+}
 
-	// 3. Sort by rating (descending)
+// 3. Sort by rating (descending)
+func sortByRating() {
 	fmt.Println("\n3. Sort by rating (descending):")
+	products := sampleProducts()
 	sort.Slice(products, func(i, j int) bool {
 		return products[i].Rating > products[j].Rating
 	})
 	fmt.Printf("Sorted by rating: %v\n", products)
+}
 
-	// 4. Multi-criteria sorting
+// 4. Multi-criteria sorting
+func multiCriteriaSorting() {
 	fmt.Println("\n4. Multi-criteria sorting (category, then price):")
+	products := sampleProducts()
 	sort.Slice(products, func(i, j int) bool {
 		if products[i].Category != products[j].Category {
 			return products[i].Category < products[j].Category
@@ -56,9 +100,12 @@ func main() {
 		return products[i].Price < products[j].Price
 	})
 	fmt.Printf("Multi-criteria sorted: %v\n", products)
+}
 
-	// 5. Sort with custom comparator function
+// 5. Sort with custom comparator function
+func sortWithCustomComparatorFunction() {
 	fmt.Println("\n5. Sort with custom comparator:")
+	products := sampleProducts()
 	compareProducts := func(a, b Product) int {
 		if a.Category != b.Category {
 			if a.Category < b.Category {
@@ -73,11 +120,9 @@ func main() {
 		}
 		return 0
 	}
-	
+
 	sort.Slice(products, func(i, j int) bool {
 		return compareProducts(products[i], products[j]) < 0
 	})
 	fmt.Printf("Custom comparator sorted: %v\n", products)
-
-	fmt.Println("All sorting by functions examples completed!")
 }
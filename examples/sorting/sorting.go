@@ -1,72 +1,115 @@
-package main
+package sorting
 
 import (
 	"fmt"
 	"sort"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "sorting",
+		Category:    examples.Expert,
+		Description: "Sorting Operations",
+		Icon:        "📊",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic slice sorting", Run: basicSliceSorting},
+			{Number: 2, Title: "String sorting", Run: stringSorting},
+			{Number: 3, Title: "Check if sorted", Run: checkIfSorted},
+			{Number: 4, Title: "Reverse sorting", Run: reverseSorting},
+			{Number: 5, Title: "Partial sorting", Run: partialSorting},
+			{Number: 6, Title: "Sorting with custom type", Run: sortingWithCustomType},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Sorting Examples ===")
 
-	// 1. Basic slice sorting
+	basicSliceSorting()
+	stringSorting()
+	checkIfSorted()
+	reverseSorting()
+	partialSorting()
+	sortingWithCustomType()
+
+	fmt.Println("All sorting examples completed!")
+}
+
+// 1. Basic slice sorting
+func basicSliceSorting() {
 	fmt.Println("\n1. Basic slice sorting:")
 	numbers := []int{5, 2, 8, 1, 9, 3, 7, 4, 6}
 	fmt.Printf("Original: %v\n", numbers)
-	
+
 	sort.Ints(numbers)
 	fmt.Printf("Sorted: %v\n", numbers)
+}
 
-	// 2. String sorting
+// 2. String sorting
+func stringSorting() {
 	fmt.Println("\n2. String sorting:")
 	words := []string{"zebra", "apple", "orange", "banana", "grape"}
 	fmt.Printf("Original: %v\n", words)
-	
+
 	sort.Strings(words)
 	fmt.Printf("Sorted: %v\n", words)
+}
 
-	// 3. Check if sorted
+// 3. Check if sorted
+func checkIfSorted() {
 	fmt.Println("\n3. Check if sorted:")
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	words := []string{"apple", "banana", "grape", "orange", "zebra"}
 	fmt.Printf("Numbers sorted: %t\n", sort.IntsAreSorted(numbers))
 	fmt.Printf("Words sorted: %t\n", sort.StringsAreSorted(words))
+}
 
-	// 4. Reverse sorting
+// 4. Reverse sorting
+func reverseSorting() {
 	fmt.Println("\n4. Reverse sorting:")
 	reverseNumbers := []int{1, 2, 3, 4, 5}
 	fmt.Printf("Original: %v\n", reverseNumbers)
-	
+
 	sort.Sort(sort.Reverse(sort.IntSlice(reverseNumbers)))
 	fmt.Printf("Reverse sorted: %v\n", reverseNumbers)
+}
 
-	// 5. Partial sorting
+// 5. Partial sorting
+func partialSorting() {
 	fmt.Println("\n5. Partial sorting:")
 	partial := []int{9, 1, 8, 2, 7, 3, 6, 4, 5}
 	fmt.Printf("Original: %v\n", partial)
-	
+
+	// readme:begin
 	sort.Slice(partial[:5], func(i, j int) bool {
 		return partial[i] < partial[j]
 	})
+	// readme:end
 	fmt.Printf("First 5 sorted: %v\n", partial)
+}
 
-	// 6. Sorting with custom type
+// 6. Sorting with custom type
+func sortingWithCustomType() {
 	fmt.Println("\n6. Custom type sorting:")
 	type Person struct {
 		Name string
 		Age  int
 	}
-	
+
 	people := []Person{
 		{"Alice", 25},
 		{"Bob", 20},
 		{"Charlie", 30},
 		{"Diana", 22},
 	}
-	
+
 	fmt.Printf("Original: %v\n", people)
-	
+
 	sort.Slice(people, func(i, j int) bool {
 		return people[i].Age < people[j].Age
 	})
 	fmt.Printf("Sorted by age: %v\n", people)
-
-	fmt.Println("All sorting examples completed!")
 }
@@ -1,39 +1,65 @@
-package main
+package statefulgoroutines
 
 import (
 	"fmt"
 	"sync"
-	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
+// readme:begin
+
 type StatefulWorker struct {
-	id     int
-	state  int
-	mu     sync.Mutex
+	id    int
+	state int
+	mu    sync.Mutex
 }
 
 func (w *StatefulWorker) Process(input int) int {
 	w.mu.Lock()
 	defer w.mu.Unlock()
-	
+
 	w.state += input
 	fmt.Printf("Worker %d: state = %d (added %d)\n", w.id, w.state, input)
 	return w.state
 }
 
+// readme:end
+
 func (w *StatefulWorker) GetState() int {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 	return w.state
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "stateful-goroutines",
+		Category:    examples.Expert,
+		Description: "Stateful Goroutines",
+		Icon:        "🧠",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic stateful worker", Run: basicStatefulWorker},
+			{Number: 2, Title: "Multiple stateful workers", Run: multipleStatefulWorkers},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Stateful Goroutines Examples ===")
 
-	// 1. Basic stateful worker
+	basicStatefulWorker()
+	multipleStatefulWorkers()
+
+	fmt.Println("All stateful goroutine examples completed!")
+}
+
+// 1. Basic stateful worker
+func basicStatefulWorker() {
 	fmt.Println("\n1. Basic stateful worker:")
 	worker := StatefulWorker{id: 1, state: 0}
-	
+
 	var wg sync.WaitGroup
 	for i := 1; i <= 5; i++ {
 		wg.Add(1)
@@ -43,17 +69,20 @@ func main() {
 		}(i)
 	}
 	wg.Wait()
-	
+
 	fmt.Printf("Final state: %d\n", worker.GetState())
+}
 
-	// 2. Multiple stateful workers
+// 2. Multiple stateful workers
+func multipleStatefulWorkers() {
 	fmt.Println("\n2. Multiple stateful workers:")
+	var wg sync.WaitGroup
 	workers := []*StatefulWorker{
 		{id: 1, state: 0},
 		{id: 2, state: 0},
 		{id: 3, state: 0},
 	}
-	
+
 	for i := 1; i <= 6; i++ {
 		wg.Add(1)
 		go func(val int) {
@@ -63,10 +92,8 @@ func main() {
 		}(i)
 	}
 	wg.Wait()
-	
+
 	for _, w := range workers {
 		fmt.Printf("Worker %d final state: %d\n", w.id, w.GetState())
 	}
-
-	fmt.Println("All stateful goroutine examples completed!")
 }
@@ -1,10 +1,23 @@
-package main
+package stringformatting
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "string-formatting",
+		Category:    examples.Practical,
+		Description: "String Formatting",
+		Icon:        "🎨",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== String Formatting ===")
 
 	// Basic formatting
@@ -18,8 +31,10 @@ func main() {
 	fmt.Printf("Pi: %.4f\n", pi)
 
 	// Sprintf (returns string)
+	// readme:begin
 	formatted := fmt.Sprintf("User: %s (%d years old)", name, age)
 	fmt.Printf("Sprintf result: %s\n", formatted)
+	// readme:end
 
 	// Width and alignment
 	fmt.Printf("|%-10s|%10s|\n", "Left", "Right")
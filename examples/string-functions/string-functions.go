@@ -1,11 +1,23 @@
-package main
+package stringfunctions
 
 import (
 	"fmt"
 	"strings"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "string-functions",
+		Category:    examples.Practical,
+		Description: "String Functions",
+		Icon:        "📝",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== String Functions ===")
 
 	// Length
@@ -30,8 +42,10 @@ func main() {
 	fmt.Printf("Trimmed: '%s'\n", strings.TrimSpace(whitespace))
 
 	// Split
+	// readme:begin
 	words := strings.Split(text, " ")
 	fmt.Printf("Split: %v\n", words)
+	// readme:end
 
 	// Join
 	joined := strings.Join(words, "-")
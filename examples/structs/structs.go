@@ -0,0 +1,371 @@
+package structs
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "structs",
+		Category:    examples.Intermediate,
+		Description: "Struct Operations",
+		Icon:        "🏗️",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic struct definition and usage", Run: basicStructDefinitionAndUsage},
+			{Number: 2, Title: "Struct with different field types", Run: structWithDifferentFieldTypes},
+			{Number: 3, Title: "Struct with nested structs", Run: structWithNestedStructs},
+			{Number: 4, Title: "Struct pointers", Run: structPointers},
+			{Number: 5, Title: "Struct comparison", Run: structComparison},
+			{Number: 6, Title: "Anonymous structs", Run: anonymousStructs},
+			{Number: 7, Title: "Struct with methods (will be covered more in methods.go)", Run: structWithMethods},
+			{Number: 8, Title: "Struct tags", Run: structTags},
+			{Number: 9, Title: "Zero values of structs", Run: zeroValuesOfStructs},
+			{Number: 10, Title: "Struct copying", Run: structCopying},
+			{Number: 11, Title: "Struct as function parameters and return values", Run: structAsFunctionParametersAndReturnValues},
+			{Number: 12, Title: "Struct composition basics", Run: structCompositionBasics},
+			{Number: 13, Title: "Struct with interface fields", Run: structWithInterfaceFields},
+		},
+	})
+}
+
+func Run() {
+	fmt.Println("=== Structs Examples ===")
+
+	basicStructDefinitionAndUsage()
+	structWithDifferentFieldTypes()
+	structWithNestedStructs()
+	structPointers()
+	structComparison()
+	anonymousStructs()
+	structWithMethods()
+	structTags()
+	zeroValuesOfStructs()
+	structCopying()
+	structAsFunctionParametersAndReturnValues()
+	structCompositionBasics()
+	structWithInterfaceFields()
+}
+
+// 1. Basic struct definition and usage
+func basicStructDefinitionAndUsage() {
+	fmt.Println("\n1. Basic struct definition:")
+
+	// Creating struct instances
+	var person1 Person
+	person1.Name = "Alice"
+	person1.Age = 25
+	person1.City = "New York"
+
+	person2 := Person{Name: "Bob", Age: 30, City: "Los Angeles"}
+	person3 := Person{"Charlie", 35, "Chicago"} // Positional initialization
+
+	fmt.Printf("Person1: %+v\n", person1)
+	fmt.Printf("Person2: %+v\n", person2)
+	fmt.Printf("Person3: %+v\n", person3)
+}
+
+// 2. Struct with different field types
+func structWithDifferentFieldTypes() {
+	fmt.Println("\n2. Struct with different field types:")
+
+	emp := Employee{
+		ID:       1001,
+		Name:     "John Doe",
+		Salary:   75000.50,
+		IsActive: true,
+		Tags:     []string{"developer", "senior"},
+	}
+
+	fmt.Printf("Employee: %+v\n", emp)
+	fmt.Printf("Name: %s, Salary: %.2f\n", emp.Name, emp.Salary)
+}
+
+// 3. Struct with nested structs
+func structWithNestedStructs() {
+	fmt.Println("\n3. Nested structs:")
+
+	person := PersonWithAddress{
+		Name: "Jane Smith",
+		Age:  28,
+		Address: Address{
+			Street:  "123 Main St",
+			City:    "Boston",
+			State:   "MA",
+			ZipCode: "02101",
+		},
+	}
+
+	fmt.Printf("Person: %+v\n", person)
+	fmt.Printf("Address: %s, %s, %s %s\n",
+		person.Address.Street, person.Address.City,
+		person.Address.State, person.Address.ZipCode)
+}
+
+// 4. Struct pointers
+func structPointers() {
+	fmt.Println("\n4. Struct pointers:")
+	personPtr := &Person{Name: "Mike", Age: 40, City: "Seattle"}
+
+	fmt.Printf("Pointer: %p\n", personPtr)
+	fmt.Printf("Dereferenced: %+v\n", *personPtr)
+	fmt.Printf("Field access: %s\n", personPtr.Name) // Automatic dereferencing
+
+	// Modifying through pointer
+	personPtr.Age = 41
+	fmt.Printf("After modification: %+v\n", *personPtr)
+}
+
+// 5. Struct comparison
+func structComparison() {
+	fmt.Println("\n5. Struct comparison:")
+	p1 := Person{Name: "Alice", Age: 25, City: "NYC"}
+	p2 := Person{Name: "Alice", Age: 25, City: "NYC"}
+	p3 := Person{Name: "Alice", Age: 26, City: "NYC"}
+
+	fmt.Printf("p1 == p2: %t\n", p1 == p2) // true
+	fmt.Printf("p1 == p3: %t\n", p1 == p3) // false
+
+	pt1 := PersonWithTags{Name: "Alice", Tags: []string{"developer"}}
+	pt2 := PersonWithTags{Name: "Alice", Tags: []string{"developer"}}
+	// pt1 == pt2 would cause compile error
+	fmt.Printf("pt1.Name == pt2.Name: %t\n", pt1.Name == pt2.Name)
+}
+
+// 6. Anonymous structs
+func anonymousStructs() {
+	fmt.Println("\n6. Anonymous structs:")
+	anonymous := struct {
+		Name string
+		Age  int
+	}{
+		Name: "Anonymous User",
+		Age:  99,
+	}
+
+	fmt.Printf("Anonymous struct: %+v\n", anonymous)
+
+	// Slice of anonymous structs
+	// readme:begin
+	people := []struct {
+		Name string
+		Age  int
+	}{
+		{"Person A", 25},
+		{"Person B", 30},
+		{"Person C", 35},
+	}
+	// readme:end
+
+	fmt.Printf("People slice: %+v\n", people)
+}
+
+// 7. Struct with methods (will be covered more in methods.go)
+func structWithMethods() {
+	fmt.Println("\n7. Basic struct methods:")
+
+	rect := Rectangle{Width: 10, Height: 5}
+	fmt.Printf("Rectangle: %+v\n", rect)
+	fmt.Printf("Area: %.2f\n", rect.Area())
+
+	rect.Scale(2)
+	fmt.Printf("After scaling: %+v\n", rect)
+	fmt.Printf("New area: %.2f\n", rect.Area())
+}
+
+// 8. Struct tags
+func structTags() {
+	fmt.Println("\n8. Struct tags:")
+
+	user := User{
+		ID:       1,
+		Username: "johndoe",
+		Email:    "john@example.com",
+		Active:   true,
+	}
+
+	fmt.Printf("User: %+v\n", user)
+	// In real applications, tags are used by encoding/json, database libraries, etc.
+}
+
+// 9. Zero values of structs
+func zeroValuesOfStructs() {
+	fmt.Println("\n9. Zero values:")
+	var zeroPerson Person
+	var zeroEmployee Employee
+
+	fmt.Printf("Zero Person: %+v\n", zeroPerson)
+	fmt.Printf("Zero Employee: %+v\n", zeroEmployee)
+
+	// Checking if struct is zero value
+	isZero := zeroPerson.Name == "" && zeroPerson.Age == 0
+	fmt.Printf("Is zeroPerson zero value? %t\n", isZero)
+}
+
+// 10. Struct copying
+func structCopying() {
+	fmt.Println("\n10. Struct copying:")
+	original := Person{Name: "Original", Age: 25, City: "Original City"}
+	copy := original
+
+	fmt.Printf("Original: %+v\n", original)
+	fmt.Printf("Copy: %+v\n", copy)
+
+	// Modifying copy doesn't affect original
+	copy.Name = "Modified"
+	fmt.Printf("After modifying copy:\n")
+	fmt.Printf("Original: %+v\n", original)
+	fmt.Printf("Copy: %+v\n", copy)
+}
+
+// 11. Struct as function parameters and return values
+func structAsFunctionParametersAndReturnValues() {
+	fmt.Println("\n11. Structs in functions:")
+
+	newPerson := createPerson("New Person", 22)
+	printPerson(newPerson)
+
+	updateAge(&newPerson, 23)
+	printPerson(newPerson)
+}
+
+// 12. Struct composition basics
+func structCompositionBasics() {
+	fmt.Println("\n12. Struct composition:")
+
+	car := Car{
+		Make:  "Toyota",
+		Model: "Camry",
+		Year:  2022,
+		Engine: Engine{
+			Type:  "V6",
+			Power: 300,
+		},
+	}
+
+	fmt.Printf("Car: %+v\n", car)
+	fmt.Printf("Engine type: %s\n", car.Engine.Type)
+	fmt.Printf("Engine type (promoted): %s\n", car.Type) // Promoted field
+}
+
+// 13. Struct with interface fields
+func structWithInterfaceFields() {
+	fmt.Println("\n13. Struct with interface fields:")
+
+	service := Service{
+		Name:   "MyService",
+		Logger: ConsoleLogger{},
+	}
+
+	service.Logger.Log("Service started")
+	fmt.Printf("Service: %+v\n", service)
+}
+
+// 1. Basic struct definition and usage
+type Person struct {
+	Name string
+	Age  int
+	City string
+}
+
+// 2. Struct with different field types
+type Employee struct {
+	ID       int
+	Name     string
+	Salary   float64
+	IsActive bool
+	Tags     []string
+}
+
+// 3. Struct with nested structs
+type Address struct {
+	Street  string
+	City    string
+	State   string
+	ZipCode string
+}
+
+type PersonWithAddress struct {
+	Name    string
+	Age     int
+	Address Address
+}
+
+// 5. Struct comparison
+// Structs with slices cannot be compared directly
+type PersonWithTags struct {
+	Name string
+	Tags []string
+}
+
+// 7. Struct with methods (will be covered more in methods.go)
+type Rectangle struct {
+	Width  float64
+	Height float64
+}
+
+// Method with value receiver
+func (r Rectangle) Area() float64 {
+	return r.Width * r.Height
+}
+
+// Method with pointer receiver
+func (r *Rectangle) Scale(factor float64) {
+	r.Width *= factor
+	r.Height *= factor
+}
+
+// 8. Struct tags
+type User struct {
+	ID       int    `json:"id" db:"user_id"`
+	Username string `json:"username" db:"username"`
+	Email    string `json:"email" db:"email"`
+	Active   bool   `json:"active" db:"is_active"`
+}
+
+// 11. Struct as function parameters and return values
+// Pass by value
+func printPerson(p Person) {
+	fmt.Printf("Person: %+v\n", p)
+}
+
+// Pass by pointer
+func updateAge(p *Person, newAge int) {
+	p.Age = newAge
+}
+
+// Return struct
+func createPerson(name string, age int) Person {
+	return Person{Name: name, Age: age, City: "Unknown"}
+}
+
+// 12. Struct composition basics
+type Engine struct {
+	Type  string
+	Power int
+}
+
+type Car struct {
+	Make   string
+	Model  string
+	Year   int
+	Engine // Embedded struct
+}
+
+// 13. Struct with interface fields
+type Logger interface {
+	Log(message string)
+}
+
+type ConsoleLogger struct{}
+
+func (cl ConsoleLogger) Log(message string) {
+	fmt.Printf("LOG: %s\n", message)
+}
+
+type Service struct {
+	Name   string
+	Logger Logger
+}
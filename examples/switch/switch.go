@@ -1,11 +1,26 @@
-package main
+package switches
 
-import "fmt"
+import (
+	"fmt"
 
-func main() {
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "switch",
+		Category:    examples.Beginner,
+		Description: "Switch Statements",
+		Icon:        "🎛️",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Switch Examples ===")
 
 	// Basic switch statement
+	// readme:begin
 	day := 3
 	switch day {
 	case 1:
@@ -23,6 +38,7 @@ func main() {
 	default:
 		fmt.Println("Invalid day")
 	}
+	// readme:end
 
 	// Switch with initialization
 	switch grade := 85; {
@@ -0,0 +1,246 @@
+package tcpserver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// chatHub tracks nicknames and named rooms. Messages fan out to each
+// member's outgoing queue, so one slow member cannot hold up a room.
+type chatHub struct {
+	mu    sync.Mutex
+	nicks map[string]*client              // by lower-case nickname
+	rooms map[string]map[*client]struct{} // members of each room
+}
+
+// lock locks the hub, creating its maps on first use.
+func (h *chatHub) lock() {
+	h.mu.Lock()
+	if h.nicks == nil {
+		h.nicks = make(map[string]*client)
+		h.rooms = make(map[string]map[*client]struct{})
+	}
+}
+
+// broadcastLocked sends text to every member of room except from.
+func (h *chatHub) broadcastLocked(room string, from *client, text string) int {
+	sent := 0
+	for member := range h.rooms[room] {
+		if member != from && member.push(text, nil) {
+			sent++
+		}
+	}
+	return sent
+}
+
+// roomsOfLocked returns the rooms c is in, ordered by name.
+func (h *chatHub) roomsOfLocked(c *client) []string {
+	var rooms []string
+	for room, members := range h.rooms {
+		if _, ok := members[c]; ok {
+			rooms = append(rooms, room)
+		}
+	}
+	sort.Strings(rooms)
+	return rooms
+}
+
+// partLocked removes c from room, dropping the room once it is empty.
+func (h *chatHub) partLocked(room string, c *client) {
+	delete(h.rooms[room], c)
+	if len(h.rooms[room]) == 0 {
+		delete(h.rooms, room)
+	}
+}
+
+// leave removes a disconnected client from every room and frees its
+// nickname.
+func (h *chatHub) leave(c *client) {
+	h.lock()
+	defer h.mu.Unlock()
+
+	nick := c.session.Nick
+	for _, room := range h.roomsOfLocked(c) {
+		h.partLocked(room, c)
+		h.broadcastLocked(room, c, fmt.Sprintf("* %s has quit", nick))
+	}
+	if nick != "" && h.nicks[strings.ToLower(nick)] == c {
+		delete(h.nicks, strings.ToLower(nick))
+	}
+}
+
+// Chat commands
+func init() {
+	registerCommand(Command{
+		Name:      "nick",
+		Args:      "<name>",
+		Help:      "Choose your chat nickname",
+		Arity:     2,
+		NeedsConn: true,
+		Run:       nickCommand,
+	})
+	registerCommand(Command{
+		Name:      "join",
+		Args:      "<#room>",
+		Help:      "Join a chat room, creating it if need be",
+		Arity:     2,
+		NeedsConn: true,
+		Run:       joinCommand,
+	})
+	registerCommand(Command{
+		Name:      "part",
+		Args:      "<#room>",
+		Help:      "Leave a chat room",
+		Arity:     2,
+		NeedsConn: true,
+		Run:       partCommand,
+	})
+	registerCommand(Command{
+		Name:      "msg",
+		Args:      "<#room|nick> <text>",
+		Help:      "Send a message to a room or a person",
+		Arity:     -3,
+		NeedsConn: true,
+		Run:       msgCommand,
+	})
+	registerCommand(Command{
+		Name:  "who",
+		Args:  "[#room]",
+		Help:  "List the people in a room, or everyone online",
+		Arity: -1,
+		Run:   whoCommand,
+	})
+}
+
+func nickCommand(s *Session, args []string) (any, error) {
+	name := args[0]
+	if strings.HasPrefix(name, "#") {
+		return nil, protocolErrorf("ERR", "nicknames cannot start with '#'")
+	}
+
+	h := &s.server.chat
+	h.lock()
+	defer h.mu.Unlock()
+
+	key := strings.ToLower(name)
+	if other, taken := h.nicks[key]; taken && other != s.client {
+		return nil, protocolErrorf("ERR", "nickname '%s' is already in use", name)
+	}
+
+	old := s.Nick
+	if old != "" {
+		delete(h.nicks, strings.ToLower(old))
+	}
+	h.nicks[key] = s.client
+	s.Nick = name
+
+	if old != "" {
+		for _, room := range h.roomsOfLocked(s.client) {
+			h.broadcastLocked(room, s.client, fmt.Sprintf("* %s is now known as %s", old, name))
+		}
+	}
+	return Status("OK"), nil
+}
+
+func joinCommand(s *Session, args []string) (any, error) {
+	room := args[0]
+	if err := checkRoom(room); err != nil {
+		return nil, err
+	}
+	if s.Nick == "" {
+		return nil, errNoNick
+	}
+
+	h := &s.server.chat
+	h.lock()
+	defer h.mu.Unlock()
+
+	members := h.rooms[room]
+	if members == nil {
+		members = make(map[*client]struct{})
+		h.rooms[room] = members
+	}
+	if _, ok := members[s.client]; ok {
+		return nil, protocolErrorf("ERR", "you are already in %s", room)
+	}
+	members[s.client] = struct{}{}
+	h.broadcastLocked(room, s.client, fmt.Sprintf("* %s has joined %s", s.Nick, room))
+	return fmt.Sprintf("Joined %s (%d here)", room, len(members)), nil
+}
+
+func partCommand(s *Session, args []string) (any, error) {
+	room := args[0]
+
+	h := &s.server.chat
+	h.lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.rooms[room][s.client]; !ok {
+		return nil, protocolErrorf("ERR", "you are not in %s", room)
+	}
+	h.partLocked(room, s.client)
+	h.broadcastLocked(room, s.client, fmt.Sprintf("* %s has left %s", s.Nick, room))
+	return Status("OK"), nil
+}
+
+func msgCommand(s *Session, args []string) (any, error) {
+	if s.Nick == "" {
+		return nil, errNoNick
+	}
+	target, text := args[0], strings.Join(args[1:], " ")
+
+	h := &s.server.chat
+	h.lock()
+	defer h.mu.Unlock()
+
+	if strings.HasPrefix(target, "#") {
+		if _, ok := h.rooms[target][s.client]; !ok {
+			return nil, protocolErrorf("ERR", "you are not in %s", target)
+		}
+		h.broadcastLocked(target, s.client, fmt.Sprintf("[%s] %s: %s", target, s.Nick, text))
+		return Status("OK"), nil
+	}
+
+	to, ok := h.nicks[strings.ToLower(target)]
+	if !ok {
+		return nil, protocolErrorf("ERR", "no one is called '%s'", target)
+	}
+	if !to.push(fmt.Sprintf("[private] %s: %s", s.Nick, text), nil) {
+		return nil, protocolErrorf("ERR", "%s is not receiving messages", target)
+	}
+	return Status("OK"), nil
+}
+
+func whoCommand(s *Session, args []string) (any, error) {
+	if len(args) > 1 {
+		return nil, protocolErrorf("ERR", "wrong number of arguments for 'who', usage: who [#room]")
+	}
+
+	h := &s.server.chat
+	h.lock()
+	defer h.mu.Unlock()
+
+	nicks := []string{}
+	if len(args) == 0 {
+		for _, c := range h.nicks {
+			nicks = append(nicks, c.session.Nick)
+		}
+	} else {
+		for c := range h.rooms[args[0]] {
+			nicks = append(nicks, c.session.Nick)
+		}
+	}
+	sort.Strings(nicks)
+	return nicks, nil
+}
+
+var errNoNick = protocolErrorf("ERR", "choose a nickname first with 'nick <name>'")
+
+func checkRoom(room string) error {
+	if len(room) < 2 || room[0] != '#' {
+		return protocolErrorf("ERR", "room names start with '#', e.g. #general")
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package tcpserver
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saqib77official/go-by-example/internal/certs"
+)
+
+// Session is the state of one client connection. Every command receives
+// the session of the client that sent it.
+type Session struct {
+	Addr      string    // remote address of the client
+	Connected time.Time // when the client connected
+	Commands  int       // commands run so far, including this one
+	Nick      string    // chat nickname, empty until set with nick
+
+	// TLS describes the connection's TLS session, or is nil for plain TCP
+	TLS *tls.ConnectionState
+
+	server *Server
+	client *client
+	quit   bool // set by the quit command to close the connection
+}
+
+// Command is a server command. Arity counts the command name itself, the
+// way Redis does: 2 means exactly one argument and -2 means at least one.
+// NeedsConn marks commands that send the client messages later, which
+// datagram clients have no connection to receive.
+//
+// Run returns one of the reply types both protocols can encode: a string,
+// a Status, an int, a []string or []any, Replies, or nil for "no value".
+type Command struct {
+	Name      string
+	Args      string // argument synopsis shown by help, e.g. "<text>"
+	Help      string
+	Arity     int
+	NeedsConn bool
+	Run       func(s *Session, args []string) (any, error)
+}
+
+// Status is a short reply such as "OK" that RESP sends as a simple string
+// rather than a bulk string.
+type Status string
+
+// Replies is several replies to one command, sent one after another. Redis
+// answers SUBSCRIBE this way, with one confirmation per channel.
+type Replies []any
+
+// Usage returns the command name followed by its argument synopsis.
+func (c Command) Usage() string {
+	if c.Args == "" {
+		return c.Name
+	}
+	return c.Name + " " + c.Args
+}
+
+func (c Command) acceptsArgs(n int) bool {
+	if c.Arity < 0 {
+		return n+1 >= -c.Arity
+	}
+	return n+1 == c.Arity
+}
+
+var (
+	commandsMu sync.RWMutex
+	commands   = make(map[string]Command)
+)
+
+// registerCommand makes a command available to every client. Add new
+// commands from an init function in their own file. It panics if the name
+// is empty, Run is nil, or the name was already registered.
+func registerCommand(c Command) {
+	commandsMu.Lock()
+	defer commandsMu.Unlock()
+
+	name := strings.ToLower(c.Name)
+	if name == "" {
+		panic("tcpserver: registerCommand called with empty name")
+	}
+	if c.Run == nil {
+		panic("tcpserver: registerCommand called with nil Run for " + name)
+	}
+	if _, dup := commands[name]; dup {
+		panic("tcpserver: registerCommand called twice for " + name)
+	}
+	c.Name = name
+	commands[name] = c
+}
+
+// lookupCommand finds a command by name, ignoring case.
+func lookupCommand(name string) (Command, bool) {
+	commandsMu.RLock()
+	defer commandsMu.RUnlock()
+
+	c, ok := commands[strings.ToLower(name)]
+	return c, ok
+}
+
+// allCommands returns every registered command ordered by name.
+func allCommands() []Command {
+	commandsMu.RLock()
+	all := make([]Command, 0, len(commands))
+	for _, c := range commands {
+		all = append(all, c)
+	}
+	commandsMu.RUnlock()
+
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+	return all
+}
+
+// ProtocolError is a failure reported to the client as a single line
+// starting with an upper-case code, such as "ERR unknown command 'foo'".
+// Clients can tell errors from ordinary replies by that prefix.
+type ProtocolError struct {
+	Code    string
+	Message string
+}
+
+func (e *ProtocolError) Error() string {
+	return e.Code + " " + e.Message
+}
+
+func protocolErrorf(code, format string, args ...any) error {
+	return &ProtocolError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// runCommand looks up args[0] and runs it with the remaining arguments.
+func runCommand(s *Session, args []string) (any, error) {
+	if len(args) == 0 {
+		return nil, protocolErrorf("ERR", "empty command, try 'help'")
+	}
+	s.Commands++
+
+	c, ok := lookupCommand(args[0])
+	if !ok {
+		return nil, protocolErrorf("ERR", "unknown command '%s', try 'help'", args[0])
+	}
+	if !c.acceptsArgs(len(args) - 1) {
+		return nil, protocolErrorf("ERR", "wrong number of arguments for '%s', usage: %s", c.Name, c.Usage())
+	}
+	if c.NeedsConn && s.client == nil {
+		return nil, protocolErrorf("ERR", "'%s' needs a connection and is not available over UDP", c.Name)
+	}
+	return c.Run(s, args[1:])
+}
+
+// errorReply formats err for the wire. Errors that are not already
+// protocol errors are reported with the generic ERR code.
+func errorReply(err error) string {
+	var perr *ProtocolError
+	if !errors.As(err, &perr) {
+		perr = &ProtocolError{Code: "ERR", Message: err.Error()}
+	}
+	return perr.Error()
+}
+
+// Built-in commands
+func init() {
+	registerCommand(Command{
+		Name:  "help",
+		Args:  "[command]",
+		Help:  "List the commands, or describe one",
+		Arity: -1,
+		Run:   helpCommand,
+	})
+	registerCommand(Command{
+		Name:  "time",
+		Help:  "Show the server time",
+		Arity: 1,
+		Run: func(s *Session, args []string) (any, error) {
+			return fmt.Sprintf("Current time: %s", time.Now().Format("15:04:05")), nil
+		},
+	})
+	registerCommand(Command{
+		Name:  "date",
+		Help:  "Show the server date",
+		Arity: 1,
+		Run: func(s *Session, args []string) (any, error) {
+			return fmt.Sprintf("Current date: %s", time.Now().Format("2006-01-02")), nil
+		},
+	})
+	registerCommand(Command{
+		Name:  "echo",
+		Args:  "<text>",
+		Help:  "Send the text back",
+		Arity: -2,
+		Run: func(s *Session, args []string) (any, error) {
+			return strings.Join(args, " "), nil
+		},
+	})
+	registerCommand(Command{
+		Name:  "ping",
+		Args:  "[message]",
+		Help:  "Check the connection is alive",
+		Arity: -1,
+		Run: func(s *Session, args []string) (any, error) {
+			switch len(args) {
+			case 0:
+				return Status("PONG"), nil
+			case 1:
+				return args[0], nil
+			}
+			return nil, protocolErrorf("ERR", "wrong number of arguments for 'ping', usage: ping [message]")
+		},
+	})
+	registerCommand(Command{
+		Name:  "status",
+		Help:  "Show server and connection status",
+		Arity: 1,
+		Run:   statusCommand,
+	})
+	registerCommand(Command{
+		Name:  "quit",
+		Help:  "Close the connection",
+		Arity: 1,
+		Run: func(s *Session, args []string) (any, error) {
+			s.quit = true
+			return "Goodbye!", nil
+		},
+	})
+}
+
+func helpCommand(s *Session, args []string) (any, error) {
+	if len(args) > 1 {
+		return nil, protocolErrorf("ERR", "wrong number of arguments for 'help', usage: help [command]")
+	}
+	if len(args) == 1 {
+		c, ok := lookupCommand(args[0])
+		if !ok {
+			return nil, protocolErrorf("ERR", "unknown command '%s'", args[0])
+		}
+		return fmt.Sprintf("%s - %s", c.Usage(), c.Help), nil
+	}
+
+	// Line the descriptions up, letting the odd long synopsis overflow
+	all := allCommands()
+	width := 0
+	for _, c := range all {
+		if n := len(c.Usage()); n <= 24 {
+			width = max(width, n)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Available commands:")
+	for _, c := range all {
+		fmt.Fprintf(&sb, "\n  %-*s  %s", width, c.Usage(), c.Help)
+	}
+	return sb.String(), nil
+}
+
+func statusCommand(s *Session, args []string) (any, error) {
+	stats := s.server.Stats()
+
+	limit := "unlimited"
+	if stats.MaxConns > 0 {
+		limit = fmt.Sprint(stats.MaxConns)
+	}
+	who := s.Addr
+	if s.Nick != "" {
+		who = s.Nick + " at " + s.Addr
+	}
+	status := fmt.Sprintf("Server is running and healthy\n"+
+		"  connections: %d active (max %s), %d accepted, %d rejected, %d timed out, %d too slow\n"+
+		"  commands:    %d served\n"+
+		"  this client: %s, connected for %v, %d commands",
+		stats.Active, limit, stats.Accepted, stats.Rejected, stats.TimedOut, stats.Slow,
+		stats.Commands, who, time.Since(s.Connected).Round(time.Second), s.Commands)
+
+	if s.TLS != nil {
+		subject := certs.PeerSubject(s.TLS)
+		if subject == "" {
+			subject = "none"
+		}
+		status += fmt.Sprintf("\n  tls:         %s, client certificate: %s", tls.VersionName(s.TLS.Version), subject)
+	}
+	return status, nil
+}
@@ -0,0 +1,394 @@
+package tcpserver
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+// sweepInterval is how often keys that expired without being read are
+// removed.
+const sweepInterval = time.Second
+
+// store is the in-memory key-value store shared by every client. Expired
+// keys disappear the moment they are looked up; a background sweep, started
+// when the first expiry is set and stopped when the server shuts down,
+// frees the ones nobody asks for again. Expiry follows internal/clock, so
+// under --fake-clock a key's time runs out as soon as the server is idle.
+type store struct {
+	mu        sync.Mutex
+	items     map[string]item
+	stopSweep chan struct{} // closed to stop the sweep; nil while none runs
+}
+
+type item struct {
+	value   string
+	expires time.Time // zero if the key never expires
+}
+
+func (it item) expired(now time.Time) bool {
+	return !it.expires.IsZero() && !now.Before(it.expires)
+}
+
+var db = &store{items: make(map[string]item)}
+
+// getLocked returns the live item for key, removing it if it has expired.
+func (s *store) getLocked(key string, now time.Time) (item, bool) {
+	it, ok := s.items[key]
+	if ok && it.expired(now) {
+		delete(s.items, key)
+		return item{}, false
+	}
+	return it, ok
+}
+
+// ttlFor converts an expiry of n units to a duration, refusing ones too
+// long to represent, as Redis does.
+func ttlFor(cmd string, n int64, unit time.Duration) (time.Duration, error) {
+	if n > math.MaxInt64/int64(unit) {
+		return 0, protocolErrorf("ERR", "invalid expire time in '%s' command", cmd)
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// expireAfter sets the key's expiry, starting the sweep if it is not
+// running. It is called with s.mu held.
+func (s *store) expireAfter(it *item, ttl time.Duration, now time.Time) {
+	it.expires = now.Add(ttl)
+	if s.stopSweep == nil {
+		s.stopSweep = make(chan struct{})
+		go s.sweepExpired(clock.NewTicker(sweepInterval), s.stopSweep)
+	}
+}
+
+func (s *store) sweepExpired(ticker *clock.Ticker, stop <-chan struct{}) {
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			s.mu.Lock()
+			for key, it := range s.items {
+				if it.expired(now) {
+					delete(s.items, key)
+				}
+			}
+			s.mu.Unlock()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// close stops the sweep. The keys stay, and setting another expiry starts
+// it again.
+func (s *store) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopSweep != nil {
+		close(s.stopSweep)
+		s.stopSweep = nil
+	}
+}
+
+// Key-value commands, named and shaped after their Redis counterparts so
+// Redis client libraries can use the server as a stand-in cache
+func init() {
+	registerCommand(Command{
+		Name:  "set",
+		Args:  "<key> <value> [EX seconds|PX milliseconds]",
+		Help:  "Set a key, optionally expiring it",
+		Arity: -3,
+		Run:   setCommand,
+	})
+	registerCommand(Command{
+		Name:  "get",
+		Args:  "<key>",
+		Help:  "Get the value of a key",
+		Arity: 2,
+		Run:   getCommand,
+	})
+	registerCommand(Command{
+		Name:  "del",
+		Args:  "<key> [key ...]",
+		Help:  "Delete keys, returning how many existed",
+		Arity: -2,
+		Run:   delCommand,
+	})
+	registerCommand(Command{
+		Name:  "exists",
+		Args:  "<key> [key ...]",
+		Help:  "Count how many of the keys exist",
+		Arity: -2,
+		Run:   existsCommand,
+	})
+	registerCommand(Command{
+		Name:  "expire",
+		Args:  "<key> <seconds>",
+		Help:  "Expire a key after a number of seconds",
+		Arity: 3,
+		Run:   expireCommand,
+	})
+	registerCommand(Command{
+		Name:  "ttl",
+		Args:  "<key>",
+		Help:  "Seconds until a key expires (-1 never, -2 no such key)",
+		Arity: 2,
+		Run:   ttlCommand,
+	})
+	registerCommand(Command{
+		Name:  "incr",
+		Args:  "<key>",
+		Help:  "Add one to the integer stored at a key",
+		Arity: 2,
+		Run:   incrCommand,
+	})
+	registerCommand(Command{
+		Name:  "keys",
+		Args:  "<pattern>",
+		Help:  "List the keys matching a glob pattern",
+		Arity: 2,
+		Run:   keysCommand,
+	})
+}
+
+func setCommand(s *Session, args []string) (any, error) {
+	key, value := args[0], args[1]
+
+	var ttl time.Duration
+	switch opts := args[2:]; {
+	case len(opts) == 0:
+	case len(opts) == 2 && (strings.EqualFold(opts[0], "EX") || strings.EqualFold(opts[0], "PX")):
+		n, err := strconv.ParseInt(opts[1], 10, 64)
+		if err != nil || n <= 0 {
+			return nil, protocolErrorf("ERR", "invalid expire time in 'set' command")
+		}
+		unit := time.Second
+		if strings.EqualFold(opts[0], "PX") {
+			unit = time.Millisecond
+		}
+		if ttl, err = ttlFor("set", n, unit); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, protocolErrorf("ERR", "syntax error")
+	}
+
+	now := clock.Now()
+	it := item{value: value}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if ttl > 0 {
+		db.expireAfter(&it, ttl, now)
+	}
+	db.items[key] = it
+	return Status("OK"), nil
+}
+
+func getCommand(s *Session, args []string) (any, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	it, ok := db.getLocked(args[0], clock.Now())
+	if !ok {
+		return nil, nil
+	}
+	return it.value, nil
+}
+
+func delCommand(s *Session, args []string) (any, error) {
+	now := clock.Now()
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	deleted := 0
+	for _, key := range args {
+		if _, ok := db.getLocked(key, now); ok {
+			delete(db.items, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func existsCommand(s *Session, args []string) (any, error) {
+	now := clock.Now()
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// Like Redis, a key named twice is counted twice
+	found := 0
+	for _, key := range args {
+		if _, ok := db.getLocked(key, now); ok {
+			found++
+		}
+	}
+	return found, nil
+}
+
+func expireCommand(s *Session, args []string) (any, error) {
+	seconds, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return nil, protocolErrorf("ERR", "value is not an integer or out of range")
+	}
+	ttl, err := ttlFor("expire", seconds, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	now := clock.Now()
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	it, ok := db.getLocked(args[0], now)
+	if !ok {
+		return 0, nil
+	}
+	if seconds <= 0 {
+		// An expiry in the past deletes the key at once
+		delete(db.items, args[0])
+		return 1, nil
+	}
+	db.expireAfter(&it, ttl, now)
+	db.items[args[0]] = it
+	return 1, nil
+}
+
+func ttlCommand(s *Session, args []string) (any, error) {
+	now := clock.Now()
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	it, ok := db.getLocked(args[0], now)
+	switch {
+	case !ok:
+		return -2, nil
+	case it.expires.IsZero():
+		return -1, nil
+	}
+	return int64(it.expires.Sub(now).Round(time.Second) / time.Second), nil
+}
+
+func incrCommand(s *Session, args []string) (any, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// A missing key counts as zero; an existing one keeps its expiry
+	it, ok := db.getLocked(args[0], clock.Now())
+	n := int64(0)
+	if ok {
+		var err error
+		n, err = strconv.ParseInt(it.value, 10, 64)
+		if err != nil || n == math.MaxInt64 {
+			return nil, protocolErrorf("ERR", "value is not an integer or out of range")
+		}
+	}
+	n++
+	it.value = strconv.FormatInt(n, 10)
+	db.items[args[0]] = it
+	return n, nil
+}
+
+func keysCommand(s *Session, args []string) (any, error) {
+	now := clock.Now()
+
+	db.mu.Lock()
+	keys := []string{}
+	for key := range db.items {
+		if _, ok := db.getLocked(key, now); ok && matchGlob(args[0], key) {
+			keys = append(keys, key)
+		}
+	}
+	db.mu.Unlock()
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// matchGlob reports whether name matches a Redis-style glob pattern: '*'
+// matches any run of bytes, '?' any single byte, "[abc]" or "[a-z]" one
+// byte from a set ("[^a]" negates it), and '\' escapes the next byte.
+// Unlike path.Match, '/' is an ordinary byte.
+//
+// On a mismatch only the last '*' is retried, swallowing one more byte of
+// name: the earlier ones could not help, since whatever they could swallow
+// instead the last one can too. So a pattern like "*a*a*a*b" takes time
+// proportional to len(pattern)*len(name), not exponential time.
+func matchGlob(pattern, name string) bool {
+	p, n := 0, 0
+	star, next := -1, 0 // pattern after the last '*', and where name resumes
+	for n < len(name) {
+		if p < len(pattern) && pattern[p] == '*' {
+			p++
+			star, next = p, n
+			continue
+		}
+		if p < len(pattern) {
+			if width, ok := matchByte(pattern[p:], name[n]); ok {
+				p += width
+				n++
+				continue
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		next++
+		p, n = star, next
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// matchByte matches b against the element pattern starts with, other than
+// '*', and says how many bytes of pattern that element takes up.
+func matchByte(pattern string, b byte) (width int, ok bool) {
+	switch pattern[0] {
+	case '?':
+		return 1, true
+	case '[':
+		end := strings.IndexByte(pattern[1:], ']') + 1
+		if end == 0 {
+			// No closing bracket: match '[' literally
+			return 1, b == '['
+		}
+		set := pattern[1:end]
+		negate := strings.HasPrefix(set, "^")
+		if negate {
+			set = set[1:]
+		}
+		return end + 1, inSet(set, b) != negate
+	case '\\':
+		if len(pattern) > 1 {
+			return 2, pattern[1] == b
+		}
+	}
+	return 1, pattern[0] == b
+}
+
+// inSet reports whether b is in a bracket expression such as "abc" or "a-z".
+func inSet(set string, b byte) bool {
+	for i := 0; i < len(set); i++ {
+		if i+2 < len(set) && set[i+1] == '-' {
+			if set[i] <= b && b <= set[i+2] {
+				return true
+			}
+			i += 2
+			continue
+		}
+		if set[i] == b {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+package tcpserver
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"*", "", true},
+		{"*", "anything", true},
+		{"", "", true},
+		{"", "a", false},
+		{"user:*", "user:42", true},
+		{"user:*", "user", false},
+		{"*:42", "user:42", true},
+		{"*:*:*", "a:b:c", true},
+		{"*:*:*", "a:b", false},
+		{"h?llo", "hello", true},
+		{"h?llo", "hllo", false},
+		{"h[ae]llo", "hallo", true},
+		{"h[ae]llo", "hillo", false},
+		{"h[^e]llo", "hallo", true},
+		{"h[^e]llo", "hello", false},
+		{"h[a-c]llo", "hbllo", true},
+		{"h[a-c]llo", "hdllo", false},
+		{"h[llo", "h[llo", true},
+		{`h\*llo`, "h*llo", true},
+		{`h\*llo`, "hello", false},
+		{`trailing\`, `trailing\`, true},
+		{"a/*", "a/b/c", true},
+		{"*a*b", "xaxaxb", true},
+		{"*a*b", "xaxaxc", false},
+		{"a*a*a*a*a*a*a*a*a*a*b", strings.Repeat("a", 100), false},
+	}
+	for _, tt := range tests {
+		if got := matchGlob(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("matchGlob(%q, %q) = %t, want %t", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+// A pattern of many stars against a long name that almost matches would
+// take exponential time with naive backtracking.
+func TestMatchGlobPathological(t *testing.T) {
+	pattern := strings.Repeat("*a", 50) + "*b"
+	name := strings.Repeat("a", 10000)
+
+	done := make(chan bool)
+	go func() { done <- matchGlob(pattern, name) }()
+	select {
+	case matched := <-done:
+		if matched {
+			t.Errorf("matchGlob matched a name without a 'b'")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("matchGlob took more than 5s")
+	}
+}
+
+func TestKVCommands(t *testing.T) {
+	fake := useFakeClock(t)
+
+	// Run in order: later commands see what earlier ones stored
+	steps := []struct {
+		args    string
+		advance time.Duration // how far to move the clock first
+		want    string        // the reply as the line protocol shows it
+	}{
+		{"get missing", 0, "(nil)"},
+		{"set name gopher", 0, "OK"},
+		{"get name", 0, "gopher"},
+		{"exists name name missing", 0, "(integer) 2"},
+		{"ttl name", 0, "(integer) -1"},
+		{"ttl missing", 0, "(integer) -2"},
+		{"set session abc EX 10", 0, "OK"},
+		{"ttl session", 0, "(integer) 10"},
+		{"ttl session", 4 * time.Second, "(integer) 6"},
+		{"get session", 6 * time.Second, "(nil)"},
+		{"set token xyz PX 1500", 0, "OK"},
+		{"get token", time.Second, "xyz"},
+		{"get token", time.Second, "(nil)"},
+		{"set bad v EX 0", 0, "ERR invalid expire time in 'set' command"},
+		{"set bad v EX 9223372036854775807", 0, "ERR invalid expire time in 'set' command"},
+		{"set bad v XX 1", 0, "ERR syntax error"},
+		{"incr counter", 0, "(integer) 1"},
+		{"incr counter", 0, "(integer) 2"},
+		{"incr name", 0, "ERR value is not an integer or out of range"},
+		{"expire counter 5", 0, "(integer) 1"},
+		{"incr counter", 0, "(integer) 3"},
+		{"ttl counter", 0, "(integer) 5"},
+		{"expire missing 5", 0, "(integer) 0"},
+		{"expire counter notanumber", 0, "ERR value is not an integer or out of range"},
+		{"expire counter 9223372036854775807", 0, "ERR invalid expire time in 'expire' command"},
+		{"set user:1 ann", 0, "OK"},
+		{"set user:2 bob", 0, "OK"},
+		{"keys user:*", 0, "1) user:1\n2) user:2"},
+		{"keys *", 0, "1) counter\n2) name\n3) user:1\n4) user:2"},
+		{"keys nothing*", 0, "(empty array)"},
+		{"expire user:2 0", 0, "(integer) 1"},
+		{"del user:1 user:2 missing", 0, "(integer) 1"},
+		{"keys *", 5 * time.Second, "1) name"},
+		{"get", 0, "ERR wrong number of arguments for 'get', usage: get <key>"},
+	}
+	session := &Session{}
+	for _, step := range steps {
+		fake.Advance(step.advance)
+		reply, err := runCommand(session, strings.Fields(step.args))
+		if got := formatText(reply, err); got != step.want {
+			t.Errorf("%s: got %q, want %q", step.args, got, step.want)
+		}
+	}
+}
+
+// The sweep frees expired keys nobody reads, and stops with the server.
+func TestKVSweep(t *testing.T) {
+	fake := useFakeClock(t)
+
+	runCommand(&Session{}, []string{"set", "gone", "x", "EX", "1"})
+	fake.Advance(2 * sweepInterval)
+	waitFor(t, "the sweep to remove the key", func() bool {
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		_, ok := db.items["gone"]
+		return !ok
+	})
+
+	(&Server{}).Shutdown(context.Background())
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.stopSweep != nil {
+		t.Error("sweep still running after shutdown")
+	}
+}
+
+// RESP requests over a connection get RESP replies.
+func TestRESPConnection(t *testing.T) {
+	useFakeClock(t)
+
+	srv := &Server{}
+	serverConn, conn := net.Pipe()
+	c, _ := srv.track(serverConn)
+	go srv.serve(c)
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	steps := []struct {
+		request string
+		want    string
+	}{
+		{"*3\r\n$3\r\nSET\r\n$4\r\nlang\r\n$2\r\ngo\r\n", "+OK\r\n"},
+		{"*2\r\n$3\r\nGET\r\n$4\r\nlang\r\n", "$2\r\ngo\r\n"},
+		{"*2\r\n$4\r\nKEYS\r\n$2\r\nl*\r\n", "*1\r\n$4\r\nlang\r\n"},
+		{"*1\r\n$4\r\nNOPE\r\n", "-ERR unknown command 'NOPE', try 'help'\r\n"},
+	}
+	for _, step := range steps {
+		if _, err := conn.Write([]byte(step.request)); err != nil {
+			t.Fatal(err)
+		}
+		got := make([]byte, len(step.want))
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if _, err := io.ReadFull(r, got); err != nil {
+			t.Fatalf("%q: %v", step.request, err)
+		}
+		if string(got) != step.want {
+			t.Errorf("%q: got %q, want %q", step.request, got, step.want)
+		}
+	}
+}
+
+// useFakeClock empties the store and puts the example clock on a fake one
+// for the rest of the test.
+func useFakeClock(t *testing.T) *clock.Fake {
+	fake := clock.NewFake(clock.Epoch)
+	saved := clock.Default
+	clock.Default = fake
+
+	db.mu.Lock()
+	db.items = make(map[string]item)
+	db.mu.Unlock()
+	t.Cleanup(func() {
+		db.close()
+		clock.Default = saved
+	})
+	return fake
+}
+
+// waitFor polls cond until it holds, failing the test after a few seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); !cond(); {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
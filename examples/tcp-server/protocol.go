@@ -0,0 +1,188 @@
+package tcpserver
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits on RESP requests, so a bad length cannot make the server
+// allocate without bound
+const (
+	maxArgs      = 1024
+	maxBulkBytes = 1 << 20
+)
+
+// detectWait is how long a new connection may stay silent before it is
+// taken to be a person at a terminal and greeted.
+const detectWait = 250 * time.Millisecond
+
+// protocol reads commands from a connection and encodes the replies.
+type protocol interface {
+	ReadCommand(r *bufio.Reader) ([]string, error)
+	Reply(reply any, err error) string
+}
+
+// textProtocol is the human-friendly line protocol: one command per line,
+// arguments separated by spaces, replies formatted like redis-cli.
+type textProtocol struct{}
+
+func (textProtocol) ReadCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		return nil, protocolErrorf("ERR", "line longer than %d bytes", maxLineLength)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(line)), nil
+}
+
+func (textProtocol) Reply(reply any, err error) string {
+	if replies, ok := reply.(Replies); ok && len(replies) == 0 && err == nil {
+		return ""
+	}
+	return formatText(reply, err) + "\n"
+}
+
+// formatText renders a reply for the line protocol.
+func formatText(reply any, err error) string {
+	if err != nil {
+		return errorReply(err)
+	}
+
+	switch v := reply.(type) {
+	case nil:
+		return "(nil)"
+	case int:
+		return fmt.Sprintf("(integer) %d", v)
+	case int64:
+		return fmt.Sprintf("(integer) %d", v)
+	case Status:
+		return string(v)
+	case string:
+		return v
+	case []string:
+		items := make([]any, len(v))
+		for i, s := range v {
+			items[i] = s
+		}
+		return formatText(items, nil)
+	case Replies:
+		lines := make([]string, len(v))
+		for i, r := range v {
+			lines[i] = formatText(r, nil)
+		}
+		return strings.Join(lines, "\n")
+	case []any:
+		if len(v) == 0 {
+			return "(empty array)"
+		}
+		lines := make([]string, len(v))
+		for i, item := range v {
+			lines[i] = fmt.Sprintf("%d) %s", i+1, formatText(item, nil))
+		}
+		return strings.Join(lines, "\n")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// respProtocol is the Redis serialization protocol, version 2, which
+// standard Redis clients speak. Requests are arrays of bulk strings.
+type respProtocol struct{}
+
+func (respProtocol) ReadCommand(r *bufio.Reader) ([]string, error) {
+	n, err := readRESPLength(r, '*', maxArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, n)
+	for range n {
+		size, err := readRESPLength(r, '$', maxBulkBytes)
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		if string(buf[size:]) != "\r\n" {
+			return nil, protocolErrorf("ERR", "Protocol error: bulk string not terminated by CRLF")
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+// readRESPLength reads a "<prefix><n>\r\n" header such as "*3" or "$5".
+func readRESPLength(r *bufio.Reader, prefix byte, limit int) (int, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		if errors.Is(err, bufio.ErrBufferFull) {
+			return 0, protocolErrorf("ERR", "Protocol error: header too long")
+		}
+		return 0, err
+	}
+
+	header := strings.TrimSuffix(strings.TrimSuffix(string(line), "\n"), "\r")
+	if len(header) == 0 || header[0] != prefix {
+		return 0, protocolErrorf("ERR", "Protocol error: expected '%c', got '%s'", prefix, header)
+	}
+	n, err := strconv.Atoi(header[1:])
+	if err != nil || n < 0 || n > limit {
+		return 0, protocolErrorf("ERR", "Protocol error: invalid length '%s'", header[1:])
+	}
+	return n, nil
+}
+
+func (p respProtocol) Reply(reply any, err error) string {
+	if err != nil {
+		// Errors are single lines; keep any multi-line message on one
+		return "-" + strings.ReplaceAll(errorReply(err), "\n", " ") + "\r\n"
+	}
+
+	switch v := reply.(type) {
+	case nil:
+		return "$-1\r\n"
+	case int:
+		return ":" + strconv.Itoa(v) + "\r\n"
+	case int64:
+		return ":" + strconv.FormatInt(v, 10) + "\r\n"
+	case Status:
+		return "+" + string(v) + "\r\n"
+	case string:
+		return bulkString(v)
+	case []string:
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "*%d\r\n", len(v))
+		for _, s := range v {
+			sb.WriteString(bulkString(s))
+		}
+		return sb.String()
+	case []any:
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "*%d\r\n", len(v))
+		for _, item := range v {
+			sb.WriteString(p.Reply(item, nil))
+		}
+		return sb.String()
+	case Replies:
+		var sb strings.Builder
+		for _, r := range v {
+			sb.WriteString(p.Reply(r, nil))
+		}
+		return sb.String()
+	default:
+		return bulkString(fmt.Sprint(v))
+	}
+}
+
+func bulkString(s string) string {
+	return "$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n"
+}
@@ -0,0 +1,75 @@
+package tcpserver
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRESPReadCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []string
+		wantErr string
+	}{
+		{"command", "*2\r\n$3\r\nGET\r\n$3\r\nkey\r\n", []string{"GET", "key"}, ""},
+		{"empty array", "*0\r\n", []string{}, ""},
+		{"empty string", "*1\r\n$0\r\n\r\n", []string{""}, ""},
+		{"binary", "*1\r\n$4\r\na\r\nb\r\n", []string{"a\r\nb"}, ""},
+		{"bare LF", "*1\n$4\nPING\r\n", []string{"PING"}, ""},
+		{"inline", "PING\r\n", nil, "ERR Protocol error: expected '*', got 'PING'"},
+		{"bulk without header", "*1\r\n+OK\r\n", nil, "ERR Protocol error: expected '$', got '+OK'"},
+		{"negative count", "*-1\r\n", nil, "ERR Protocol error: invalid length '-1'"},
+		{"too many args", "*1025\r\n", nil, "ERR Protocol error: invalid length '1025'"},
+		{"bulk too long", "*1\r\n$1048577\r\n", nil, "ERR Protocol error: invalid length '1048577'"},
+		{"bad length", "*x\r\n", nil, "ERR Protocol error: invalid length 'x'"},
+		{"unterminated", "*1\r\n$4\r\nPINGxx", nil, "ERR Protocol error: bulk string not terminated by CRLF"},
+		{"cut short", "*2\r\n$3\r\nGET\r\n", nil, "EOF"},
+	}
+	for _, tt := range tests {
+		args, err := respProtocol{}.ReadCommand(bufio.NewReader(strings.NewReader(tt.in)))
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("%s: error %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(args, tt.want) {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, args, err, tt.want)
+		}
+	}
+}
+
+func TestReply(t *testing.T) {
+	tests := []struct {
+		name     string
+		reply    any
+		err      error
+		wantRESP string
+		wantText string
+	}{
+		{"nil", nil, nil, "$-1\r\n", "(nil)\n"},
+		{"status", Status("OK"), nil, "+OK\r\n", "OK\n"},
+		{"string", "hello", nil, "$5\r\nhello\r\n", "hello\n"},
+		{"integer", 42, nil, ":42\r\n", "(integer) 42\n"},
+		{"int64", int64(-2), nil, ":-2\r\n", "(integer) -2\n"},
+		{"strings", []string{"a", "b"}, nil, "*2\r\n$1\r\na\r\n$1\r\nb\r\n", "1) a\n2) b\n"},
+		{"empty array", []any{}, nil, "*0\r\n", "(empty array)\n"},
+		{"mixed array", []any{"subscribe", "news", 1}, nil,
+			"*3\r\n$9\r\nsubscribe\r\n$4\r\nnews\r\n:1\r\n", "1) subscribe\n2) news\n3) (integer) 1\n"},
+		{"replies", Replies{Status("OK"), 1}, nil, "+OK\r\n:1\r\n", "OK\n(integer) 1\n"},
+		{"no replies", Replies{}, nil, "", ""},
+		{"error", nil, protocolErrorf("ERR", "syntax error"), "-ERR syntax error\r\n", "ERR syntax error\n"},
+		{"multi-line error", nil, protocolErrorf("ERR", "a\nb"), "-ERR a b\r\n", "ERR a\nb\n"},
+	}
+	for _, tt := range tests {
+		if got := (respProtocol{}).Reply(tt.reply, tt.err); got != tt.wantRESP {
+			t.Errorf("%s: RESP %q, want %q", tt.name, got, tt.wantRESP)
+		}
+		if got := (textProtocol{}).Reply(tt.reply, tt.err); got != tt.wantText {
+			t.Errorf("%s: text %q, want %q", tt.name, got, tt.wantText)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package tcpserver
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// pubSub routes PUBLISH messages to channel and pattern subscribers, with
+// the same message shapes Redis uses, so Redis clients can subscribe too.
+type pubSub struct {
+	mu       sync.Mutex
+	channels map[string]map[*client]struct{} // subscribers by channel
+	patterns map[string]map[*client]struct{} // subscribers by glob pattern
+}
+
+// subscription kinds, as named in confirmation replies
+const (
+	kindChannel = "subscribe"
+	kindPattern = "psubscribe"
+)
+
+func (ps *pubSub) lock() {
+	ps.mu.Lock()
+	if ps.channels == nil {
+		ps.channels = make(map[string]map[*client]struct{})
+		ps.patterns = make(map[string]map[*client]struct{})
+	}
+}
+
+// setLocked returns the channel or pattern subscriptions for a kind.
+func (ps *pubSub) setLocked(kind string) map[string]map[*client]struct{} {
+	if kind == kindPattern {
+		return ps.patterns
+	}
+	return ps.channels
+}
+
+// countLocked returns how many channels and patterns c is subscribed to.
+func (ps *pubSub) countLocked(c *client) int {
+	n := 0
+	for _, subs := range ps.channels {
+		if _, ok := subs[c]; ok {
+			n++
+		}
+	}
+	for _, subs := range ps.patterns {
+		if _, ok := subs[c]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// namesLocked returns the channels or patterns c is subscribed to, sorted.
+func (ps *pubSub) namesLocked(kind string, c *client) []string {
+	var names []string
+	for name, subs := range ps.setLocked(kind) {
+		if _, ok := subs[c]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// subscribe adds c to each name and confirms each one with the number of
+// subscriptions c now has. The confirmations are replies to the command,
+// queued however long c takes to read them, so a SUBSCRIBE to many
+// channels never counts as falling behind. Each is queued before c joins
+// the channel, so it always arrives ahead of the first message. Only the
+// goroutine serving c changes its subscriptions, so the count holds.
+func (ps *pubSub) subscribe(c *client, kind string, names []string) {
+	for _, name := range names {
+		ps.lock()
+		set := ps.setLocked(kind)
+		n := ps.countLocked(c)
+		if _, ok := set[name][c]; !ok {
+			n++
+		}
+		ps.mu.Unlock()
+
+		c.reply([]any{kind, name, n}, nil)
+
+		ps.lock()
+		if set[name] == nil {
+			set[name] = make(map[*client]struct{})
+		}
+		set[name][c] = struct{}{}
+		ps.mu.Unlock()
+	}
+}
+
+// unsubscribe removes c from each name, or from all of its subscriptions
+// of that kind if no names are given.
+func (ps *pubSub) unsubscribe(c *client, kind string, names []string) Replies {
+	ps.lock()
+	defer ps.mu.Unlock()
+
+	reply := strings.Replace(kind, "subscribe", "unsubscribe", 1)
+	if len(names) == 0 {
+		names = ps.namesLocked(kind, c)
+		if len(names) == 0 {
+			return Replies{[]any{reply, nil, ps.countLocked(c)}}
+		}
+	}
+
+	set := ps.setLocked(kind)
+	replies := make(Replies, 0, len(names))
+	for _, name := range names {
+		delete(set[name], c)
+		if len(set[name]) == 0 {
+			delete(set, name)
+		}
+		replies = append(replies, []any{reply, name, ps.countLocked(c)})
+	}
+	return replies
+}
+
+// publish delivers message to every subscriber of channel and of each
+// matching pattern, and returns how many received it.
+func (ps *pubSub) publish(channel, message string) int {
+	ps.lock()
+	defer ps.mu.Unlock()
+
+	received := 0
+	for c := range ps.channels[channel] {
+		if c.push([]string{"message", channel, message}, nil) {
+			received++
+		}
+	}
+	for pattern, subs := range ps.patterns {
+		if !matchGlob(pattern, channel) {
+			continue
+		}
+		for c := range subs {
+			if c.push([]string{"pmessage", pattern, channel, message}, nil) {
+				received++
+			}
+		}
+	}
+	return received
+}
+
+// leave drops every subscription of a disconnected client.
+func (ps *pubSub) leave(c *client) {
+	ps.lock()
+	defer ps.mu.Unlock()
+
+	for _, set := range []map[string]map[*client]struct{}{ps.channels, ps.patterns} {
+		for name, subs := range set {
+			delete(subs, c)
+			if len(subs) == 0 {
+				delete(set, name)
+			}
+		}
+	}
+}
+
+// Publish/subscribe commands
+func init() {
+	registerCommand(Command{
+		Name:  "publish",
+		Args:  "<channel> <message>",
+		Help:  "Send a message to a channel's subscribers",
+		Arity: 3,
+		Run: func(s *Session, args []string) (any, error) {
+			return s.server.pubsub.publish(args[0], args[1]), nil
+		},
+	})
+	registerCommand(Command{
+		Name:      "subscribe",
+		Args:      "<channel> [channel ...]",
+		Help:      "Receive the messages published to channels",
+		Arity:     -2,
+		NeedsConn: true,
+		Run: func(s *Session, args []string) (any, error) {
+			s.server.pubsub.subscribe(s.client, kindChannel, args)
+			return Replies{}, nil // confirmed already
+		},
+	})
+	registerCommand(Command{
+		Name:      "psubscribe",
+		Args:      "<pattern> [pattern ...]",
+		Help:      "Receive the messages published to channels matching patterns",
+		Arity:     -2,
+		NeedsConn: true,
+		Run: func(s *Session, args []string) (any, error) {
+			s.server.pubsub.subscribe(s.client, kindPattern, args)
+			return Replies{}, nil // confirmed already
+		},
+	})
+	registerCommand(Command{
+		Name:      "unsubscribe",
+		Args:      "[channel ...]",
+		Help:      "Stop receiving from channels, or from all of them",
+		Arity:     -1,
+		NeedsConn: true,
+		Run: func(s *Session, args []string) (any, error) {
+			return s.server.pubsub.unsubscribe(s.client, kindChannel, args), nil
+		},
+	})
+	registerCommand(Command{
+		Name:      "punsubscribe",
+		Args:      "[pattern ...]",
+		Help:      "Stop receiving from patterns, or from all of them",
+		Arity:     -1,
+		NeedsConn: true,
+		Run: func(s *Session, args []string) (any, error) {
+			return s.server.pubsub.unsubscribe(s.client, kindPattern, args), nil
+		},
+	})
+}
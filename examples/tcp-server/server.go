@@ -0,0 +1,393 @@
+package tcpserver
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	maxLineLength    = 64 * 1024       // longest command line, as for bufio.Scanner
+	writeTimeout     = 5 * time.Second // how long a stalled client may hold up a reply
+	defaultQueueSize = 64              // outgoing messages buffered per client
+)
+
+// Server serves the line protocol with a bounded number of connections,
+// per-connection timeouts and a graceful shutdown.
+type Server struct {
+	MaxConns    int           // connections served at once; more are turned away
+	IdleTimeout time.Duration // close connections that send nothing for this long
+	ReadTimeout time.Duration // how long a line may take once it has started
+	QueueSize   int           // messages buffered per client; 0 means 64
+
+	listener   net.Listener
+	packetConn net.PacketConn
+	closing    atomic.Bool
+	wg         sync.WaitGroup
+
+	mu      sync.Mutex
+	clients map[*client]struct{}
+	peers   map[string]*peer // datagram clients, by address
+
+	chat   chatHub
+	pubsub pubSub
+
+	accepted atomic.Int64
+	rejected atomic.Int64
+	timedOut atomic.Int64
+	slow     atomic.Int64
+	commands atomic.Int64
+}
+
+// Stats is a snapshot of the server's connection counters.
+type Stats struct {
+	Active   int
+	MaxConns int
+	Accepted int64
+	Rejected int64
+	TimedOut int64
+	Slow     int64 // disconnected for not reading their messages
+	Commands int64
+}
+
+// Stats returns the current connection counters.
+func (srv *Server) Stats() Stats {
+	srv.mu.Lock()
+	active := len(srv.clients) + len(srv.peers)
+	srv.mu.Unlock()
+
+	return Stats{
+		Active:   active,
+		MaxConns: srv.MaxConns,
+		Accepted: srv.accepted.Load(),
+		Rejected: srv.rejected.Load(),
+		TimedOut: srv.timedOut.Load(),
+		Slow:     srv.slow.Load(),
+		Commands: srv.commands.Load(),
+	}
+}
+
+// client is a connection being served. Everything sent to it, replies and
+// broadcasts alike, goes through out, a bounded queue with its own writer
+// goroutine, so a client that reads slowly holds up nobody but itself.
+type client struct {
+	conn    net.Conn
+	session *Session
+	out     chan string
+	flushed chan struct{} // closed once the writer has drained out
+
+	mu     sync.Mutex
+	proto  protocol // the line protocol until the client turns out to speak RESP
+	busy   bool     // running a command
+	closed bool     // out is closed; nothing more may be queued
+	slow   bool     // dropped for letting out fill up
+}
+
+// writeLoop sends queued messages until out is closed. After a failed
+// write it closes the connection and discards the rest.
+func (c *client) writeLoop() {
+	defer close(c.flushed)
+
+	var err error
+	for msg := range c.out {
+		if err != nil {
+			continue
+		}
+		c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if _, err = io.WriteString(c.conn, msg); err != nil {
+			c.conn.Close()
+		}
+	}
+}
+
+// reply queues the answer to a command, waiting for room in the queue.
+// Only the goroutine serving the connection sends replies.
+func (c *client) reply(reply any, err error) {
+	if msg := c.proto.Reply(reply, err); msg != "" {
+		c.out <- msg
+	}
+}
+
+// push queues a message the client did not ask for, such as a chat line or
+// a shutdown notice. It never waits: a client whose queue is full is not
+// keeping up, so it is disconnected rather than allowed to stall the
+// sender. push reports whether the message was queued.
+func (c *client) push(reply any, err error) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed || c.slow {
+		return false
+	}
+	select {
+	case c.out <- c.proto.Reply(reply, err):
+		return true
+	default:
+		c.slow = true
+		c.session.server.slow.Add(1)
+		c.conn.Close()
+		return false
+	}
+}
+
+// close stops queueing, waits for what is queued to be written and closes
+// the connection.
+func (c *client) close() {
+	c.mu.Lock()
+	c.closed = true
+	close(c.out)
+	c.mu.Unlock()
+
+	<-c.flushed
+	c.conn.Close()
+}
+
+// Serve accepts connections on l until Shutdown is called.
+func (srv *Server) Serve(l net.Listener) error {
+	srv.mu.Lock()
+	srv.listener = l
+	srv.mu.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if srv.closing.Load() || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			log.Printf("Error accepting connection: %v\n", err)
+			continue
+		}
+
+		c, ok := srv.track(conn)
+		if !ok {
+			// Over the limit: say so rather than leave the client hanging.
+			// Its protocol is not known yet, but a RESP error line reads
+			// fine to a person too.
+			srv.rejected.Add(1)
+			conn.SetDeadline(time.Now().Add(writeTimeout))
+			io.WriteString(conn, respProtocol{}.Reply(nil, protocolErrorf("BUSY", "server busy, try again later")))
+			conn.Close()
+			continue
+		}
+		go srv.serve(c)
+	}
+}
+
+// track registers a new connection unless the server is full or closing.
+func (srv *Server) track(conn net.Conn) (*client, bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if srv.closing.Load() || (srv.MaxConns > 0 && len(srv.clients) >= srv.MaxConns) {
+		return nil, false
+	}
+	if srv.clients == nil {
+		srv.clients = make(map[*client]struct{})
+	}
+
+	queueSize := srv.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	c := &client{
+		conn:    conn,
+		out:     make(chan string, queueSize),
+		flushed: make(chan struct{}),
+		proto:   textProtocol{},
+	}
+	addr := conn.RemoteAddr().String()
+	if addr == "" || addr == "@" {
+		// Unix socket clients are usually unnamed
+		addr = fmt.Sprintf("%s#%d", conn.LocalAddr(), srv.accepted.Load()+1)
+	}
+	c.session = &Session{Addr: addr, Connected: time.Now(), server: srv, client: c}
+	srv.clients[c] = struct{}{}
+	srv.accepted.Add(1)
+	srv.wg.Add(1)
+	return c, true
+}
+
+func (srv *Server) untrack(c *client) {
+	srv.chat.leave(c)
+	srv.pubsub.leave(c)
+
+	srv.mu.Lock()
+	delete(srv.clients, c)
+	srv.mu.Unlock()
+	srv.wg.Done()
+}
+
+// serve runs the read-command-reply loop for one connection.
+func (srv *Server) serve(c *client) {
+	defer srv.untrack(c)
+	defer c.close()
+	go c.writeLoop()
+
+	clientAddr := c.session.Addr
+	fmt.Printf("New connection from %s\n", clientAddr)
+	defer fmt.Printf("Connection closed from %s\n", clientAddr)
+
+	if tc, ok := c.conn.(*tls.Conn); ok && !srv.handshake(c, tc) {
+		return
+	}
+
+	reader := bufio.NewReaderSize(c.conn, maxLineLength)
+	srv.detect(c, reader)
+
+	for {
+		// Wait up to IdleTimeout for the next command to start. The deadline
+		// is set before checking for shutdown, so a drain that starts in
+		// between still interrupts the read.
+		c.conn.SetReadDeadline(deadline(srv.IdleTimeout))
+		if srv.closing.Load() {
+			return
+		}
+		if _, err := reader.Peek(1); err != nil {
+			srv.readFailed(c, err, "idle for %v", srv.IdleTimeout)
+			return
+		}
+
+		// Once it has started, the whole command must arrive within
+		// ReadTimeout
+		c.conn.SetReadDeadline(deadline(srv.ReadTimeout))
+		args, err := c.proto.ReadCommand(reader)
+		var perr *ProtocolError
+		switch {
+		case errors.As(err, &perr):
+			// Malformed input: report it and hang up, as Redis does
+			c.reply(nil, err)
+			return
+		case err != nil:
+			srv.readFailed(c, err, "command not completed within %v", srv.ReadTimeout)
+			return
+		}
+
+		fmt.Printf("Message from %s: %s\n", clientAddr, strings.Join(args, " "))
+
+		c.mu.Lock()
+		c.busy = true
+		c.mu.Unlock()
+
+		reply, cmdErr := runCommand(c.session, args)
+		srv.commands.Add(1)
+
+		c.mu.Lock()
+		c.busy = false
+		c.mu.Unlock()
+
+		c.reply(reply, cmdErr)
+		if c.session.quit {
+			return
+		}
+	}
+}
+
+// handshake completes the TLS handshake up front, within ReadTimeout, so
+// that commands can see the client's certificate.
+func (srv *Server) handshake(c *client, tc *tls.Conn) bool {
+	c.conn.SetDeadline(deadline(srv.ReadTimeout))
+	defer c.conn.SetDeadline(time.Time{})
+
+	if err := tc.Handshake(); err != nil {
+		fmt.Printf("TLS handshake with %s failed: %v\n", c.session.Addr, err)
+		return false
+	}
+	state := tc.ConnectionState()
+	c.session.TLS = &state
+	return true
+}
+
+// detect picks the protocol from the first byte the client sends: RESP
+// requests are arrays, which start with '*'. Redis clients speak first, so
+// a client that stays silent for a moment is taken to be a person at a
+// terminal and greeted in the line protocol.
+func (srv *Server) detect(c *client, r *bufio.Reader) {
+	c.conn.SetReadDeadline(time.Now().Add(detectWait))
+	if b, err := r.Peek(1); err == nil && b[0] == '*' {
+		c.mu.Lock()
+		c.proto = respProtocol{}
+		c.mu.Unlock()
+		return
+	}
+	c.reply(Status("Welcome to TCP Server!"), nil)
+}
+
+// readFailed tells the client why its connection is closing if the read
+// timed out. Other errors, such as the client hanging up, end it quietly.
+func (srv *Server) readFailed(c *client, err error, format string, args ...any) {
+	if !errors.Is(err, os.ErrDeadlineExceeded) || srv.closing.Load() {
+		return
+	}
+	srv.timedOut.Add(1)
+	c.reply(nil, protocolErrorf("TIMEOUT", format+", closing connection", args...))
+}
+
+// Shutdown stops accepting connections, tells every client the server is
+// going away and waits for commands in flight to finish. Idle connections
+// are closed straight away. If ctx ends first, the remaining connections
+// are closed without waiting for their commands and ctx's error is
+// returned.
+func (srv *Server) Shutdown(ctx context.Context) error {
+	srv.closing.Store(true)
+	defer db.close() // stop the expiry sweep once the commands are done
+
+	srv.mu.Lock()
+	if srv.listener != nil {
+		srv.listener.Close()
+	}
+	if srv.packetConn != nil {
+		srv.packetConn.Close()
+	}
+	for c := range srv.clients {
+		c.mu.Lock()
+		// RESP clients would take an unrequested error for the reply to
+		// their next command, so only people are told
+		_, text := c.proto.(textProtocol)
+		if !c.busy {
+			c.conn.SetReadDeadline(time.Now())
+		}
+		c.mu.Unlock()
+
+		if text {
+			c.push(nil, protocolErrorf("SHUTDOWN", "server is shutting down"))
+		}
+	}
+	srv.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		srv.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		srv.mu.Lock()
+		for c := range srv.clients {
+			c.conn.Close()
+		}
+		srv.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// deadline returns the read deadline for a timeout, or no deadline if the
+// timeout is zero.
+func deadline(timeout time.Duration) time.Time {
+	if timeout <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(timeout)
+}
@@ -0,0 +1,191 @@
+package tcpserver
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/certs"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "tcp-server",
+		Category:    examples.Practical,
+		Description: "TCP Server",
+		Icon:        "🔌",
+		Run:         Run,
+	})
+}
+
+func Run() {
+	fmt.Println("=== TCP Server ===")
+
+	// Read the transport, limits and timeouts from the command line
+	// (gobyexample run tcp-server --network=udp --max-conns=2)
+	flags := flag.NewFlagSet("tcp-server", flag.ExitOnError)
+	network := flags.String("network", "tcp", "Transport to serve: tcp, udp or unix")
+	addr := flags.String("addr", ":8081", "Address to listen on for tcp and udp")
+	socketPath := flags.String("socket", filepath.Join(os.TempDir(), "gobyexample.sock"), "Socket file to listen on for unix")
+	socketMode := flags.String("socket-mode", "0660", "Permissions for the unix socket file, in octal")
+	maxPayload := flags.Int("max-payload", defaultMaxPayload, "Largest datagram accepted or sent over udp")
+	maxConns := flags.Int("max-conns", 100, "Maximum connections served at once (0 for no limit)")
+	idleTimeout := flags.Duration("idle-timeout", 5*time.Minute, "Close connections idle this long")
+	readTimeout := flags.Duration("read-timeout", 30*time.Second, "Time allowed to finish sending a line")
+	queueSize := flags.Int("queue-size", defaultQueueSize, "Messages buffered per client before a slow client is dropped")
+	drainTimeout := flags.Duration("drain-timeout", 10*time.Second, "Time allowed for commands in flight on shutdown")
+	var tlsFlags certs.Config
+	tlsFlags.RegisterFlags(flags)
+	flags.Parse(os.Args[1:])
+
+	// Listen on the chosen transport
+	var listener net.Listener
+	var packetConn net.PacketConn
+	var err error
+	switch *network {
+	case "tcp":
+		listener, err = net.Listen("tcp", *addr)
+	case "udp":
+		if *maxPayload < minPayload {
+			err = fmt.Errorf("--max-payload must be at least %d bytes", minPayload)
+		} else {
+			packetConn, err = net.ListenPacket("udp", *addr)
+		}
+	case "unix":
+		var mode uint64
+		if mode, err = strconv.ParseUint(*socketMode, 8, 32); err == nil {
+			listener, err = listenUnix(*socketPath, fs.FileMode(mode))
+		}
+	default:
+		err = fmt.Errorf("unknown network %q, want tcp, udp or unix", *network)
+	}
+	if err != nil {
+		log.Fatal("Error starting server:", err)
+	}
+
+	// Wrap the listener for TLS if asked (--tls, --cert/--key, --mtls)
+	tlsConfig, generated, err := tlsFlags.ServerConfig("localhost", "127.0.0.1", "::1")
+	if err != nil {
+		log.Fatal("Error configuring TLS:", err)
+	}
+	defer generated.Remove()
+	if tlsConfig != nil {
+		if listener == nil {
+			generated.Remove()
+			log.Fatal("TLS is not available over UDP")
+		}
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	server := &Server{
+		MaxConns:    *maxConns,
+		IdleTimeout: *idleTimeout,
+		ReadTimeout: *readTimeout,
+		QueueSize:   *queueSize,
+	}
+
+	switch {
+	case packetConn != nil:
+		_, port, _ := net.SplitHostPort(packetConn.LocalAddr().String())
+		fmt.Printf("UDP Server listening on %s, one command per datagram\n", packetConn.LocalAddr())
+		fmt.Printf("Use: nc -u localhost %s\n", port)
+	case *network == "unix":
+		fmt.Printf("Unix Socket Server listening on %s\n", *socketPath)
+		if tlsConfig == nil {
+			fmt.Printf("Use: nc -U %s\n", *socketPath)
+			fmt.Printf("Redis clients work too: redis-cli -s %s\n", *socketPath)
+		} else {
+			fmt.Printf("Use: openssl s_client -quiet -unix %s%s\n", *socketPath, opensslArgs(generated))
+		}
+	default:
+		_, port, _ := net.SplitHostPort(listener.Addr().String())
+		fmt.Printf("TCP Server listening on %s\n", listener.Addr())
+		if tlsConfig == nil {
+			fmt.Printf("Use: telnet localhost %s or nc localhost %s\n", port, port)
+			fmt.Printf("Redis clients work too: redis-cli -p %s\n", port)
+		} else {
+			fmt.Printf("Use: openssl s_client -quiet -connect localhost:%s%s\n", port, opensslArgs(generated))
+			fmt.Printf("Redis clients work too: redis-cli -p %s --tls%s\n", port, redisCLIArgs(generated))
+		}
+	}
+	fmt.Println("Press Ctrl+C to stop")
+
+	// Serve until interrupted or terminated
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if packetConn != nil {
+			serveErr <- server.ServePacket(packetConn, *maxPayload)
+		} else {
+			serveErr <- server.Serve(listener)
+		}
+	}()
+
+	select {
+	case err := <-serveErr:
+		generated.Remove() // log.Fatal skips the deferred one
+		log.Fatal("Server error:", err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	// Drain: stop accepting, tell clients, and let commands in flight finish
+	fmt.Printf("\nShutting down, waiting up to %v for connections to finish...\n", *drainTimeout)
+	// readme:begin
+	drainCtx, cancel := context.WithTimeout(context.Background(), *drainTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(drainCtx); err != nil {
+		fmt.Println("Drain timed out; remaining connections were closed")
+	}
+	// readme:end
+	stats := server.Stats()
+	fmt.Printf("Server stopped after %d connections (%d rejected) and %d commands\n",
+		stats.Accepted, stats.Rejected, stats.Commands)
+}
+
+// opensslArgs returns the flags openssl s_client needs to trust a
+// generated certificate and, for mutual TLS, present the client one.
+func opensslArgs(gen *certs.Generated) string {
+	if gen == nil {
+		return ""
+	}
+	args := " -CAfile " + gen.CA
+	if gen.ClientCert != "" {
+		args += " -cert " + gen.ClientCert + " -key " + gen.ClientKey
+	}
+	return args
+}
+
+// redisCLIArgs is opensslArgs for redis-cli.
+func redisCLIArgs(gen *certs.Generated) string {
+	if gen == nil {
+		return ""
+	}
+	args := " --cacert " + gen.CA
+	if gen.ClientCert != "" {
+		args += " --cert " + gen.ClientCert + " --key " + gen.ClientKey
+	}
+	return args
+}
+
+// handleCommand runs one line of the line protocol against the command
+// registry and returns the reply. Failures come back as a line starting
+// with an error code such as "ERR".
+func handleCommand(session *Session, message string) string {
+	return formatText(runCommand(session, strings.Fields(message)))
+}
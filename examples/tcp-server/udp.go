@@ -0,0 +1,148 @@
+package tcpserver
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/saqib77official/go-by-example/internal/clock"
+)
+
+// defaultMaxPayload is the largest UDP payload that fits in one Ethernet
+// frame (1500 bytes less 20 for IPv4 and 8 for UDP), so datagrams of this
+// size are never fragmented.
+const defaultMaxPayload = 1472
+
+// truncatedSuffix marks a reply cut short to fit in one datagram.
+const truncatedSuffix = "\n...(truncated)\n"
+
+// minPayload is the smallest maxPayload that leaves room for at least one
+// character of a truncated reply.
+const minPayload = len(truncatedSuffix) + utf8.UTFMax
+
+// peer is a datagram client, remembered by address between datagrams.
+type peer struct {
+	session  *Session
+	lastSeen time.Time
+}
+
+// ServePacket serves datagram clients on pc until Shutdown is called. Each
+// datagram carries one command in the line protocol and is answered with
+// one datagram. There is no connection, so clients are told apart by
+// address and forgotten after IdleTimeout of silence. Commands that push
+// messages to a client, such as join and subscribe, are not available.
+//
+// Datagrams longer than maxPayload are refused, and replies longer than it
+// are truncated. It must be at least minPayload.
+func (srv *Server) ServePacket(pc net.PacketConn, maxPayload int) error {
+	if maxPayload < minPayload {
+		return fmt.Errorf("max payload %d is below the minimum of %d bytes", maxPayload, minPayload)
+	}
+	srv.mu.Lock()
+	srv.packetConn = pc
+	srv.mu.Unlock()
+
+	// One byte spare, so an oversized datagram can be told from one that
+	// fits exactly
+	buf := make([]byte, maxPayload+1)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			if srv.closing.Load() || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			log.Printf("Error reading datagram: %v\n", err)
+			continue
+		}
+
+		var reply string
+		if n > maxPayload {
+			reply = errorReply(protocolErrorf("ERR", "datagram larger than %d bytes", maxPayload)) + "\n"
+		} else {
+			reply = srv.handleDatagram(addr, string(buf[:n]))
+		}
+		if reply == "" {
+			continue
+		}
+		reply = truncate(reply, maxPayload)
+		if _, err := pc.WriteTo([]byte(reply), addr); err != nil {
+			log.Printf("Error replying to %s: %v\n", addr, err)
+		}
+	}
+}
+
+// truncate shortens a reply longer than maxPayload bytes to fit, cutting
+// between characters and marking the cut with truncatedSuffix.
+func truncate(reply string, maxPayload int) string {
+	if len(reply) <= maxPayload {
+		return reply
+	}
+	cut := maxPayload - len(truncatedSuffix)
+	for cut > 0 && !utf8.RuneStart(reply[cut]) {
+		cut--
+	}
+	return reply[:cut] + truncatedSuffix
+}
+
+// handleDatagram runs the command in one datagram for the peer at addr and
+// returns the reply, or "" if there should be none.
+func (srv *Server) handleDatagram(addr net.Addr, message string) string {
+	p, ok := srv.peer(addr)
+	if !ok {
+		srv.rejected.Add(1)
+		return errorReply(protocolErrorf("BUSY", "server busy, try again later")) + "\n"
+	}
+
+	fmt.Printf("Message from %s: %s\n", p.session.Addr, strings.TrimSpace(message))
+	reply := handleCommand(p.session, message) + "\n"
+	srv.commands.Add(1)
+
+	if p.session.quit {
+		srv.mu.Lock()
+		delete(srv.peers, p.session.Addr)
+		srv.mu.Unlock()
+	}
+	return reply
+}
+
+// peer returns the session for addr, starting one if this is a new client
+// and there is room. Peers that have been quiet for IdleTimeout are
+// forgotten first.
+func (srv *Server) peer(addr net.Addr) (*peer, bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	now := clock.Now()
+	if srv.IdleTimeout > 0 {
+		for key, p := range srv.peers {
+			if now.Sub(p.lastSeen) > srv.IdleTimeout {
+				delete(srv.peers, key)
+				srv.timedOut.Add(1)
+			}
+		}
+	}
+
+	key := addr.String()
+	if p, ok := srv.peers[key]; ok {
+		p.lastSeen = now
+		return p, true
+	}
+	if srv.MaxConns > 0 && len(srv.clients)+len(srv.peers) >= srv.MaxConns {
+		return nil, false
+	}
+	if srv.peers == nil {
+		srv.peers = make(map[string]*peer)
+	}
+
+	p := &peer{
+		session:  &Session{Addr: key, Connected: now, server: srv},
+		lastSeen: now,
+	}
+	srv.peers[key] = p
+	srv.accepted.Add(1)
+	return p, true
+}
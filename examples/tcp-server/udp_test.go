@@ -0,0 +1,32 @@
+package tcpserver
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	max := len(truncatedSuffix) + 4
+	tests := []struct {
+		name  string
+		reply string
+		want  string
+	}{
+		{"fits", "hello", "hello"},
+		{"exactly fits", strings.Repeat("a", max), strings.Repeat("a", max)},
+		{"ascii", strings.Repeat("a", max+1), "aaaa" + truncatedSuffix},
+		{"cut inside a character", "aaa€€€€€€€", "aaa" + truncatedSuffix},
+		{"cut after a character", "a€€€€€€€", "a€" + truncatedSuffix},
+		{"four-byte characters", "😀😀😀😀😀😀", "😀" + truncatedSuffix},
+	}
+	for _, tt := range tests {
+		got := truncate(tt.reply, max)
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+		if len(got) > max || !utf8.ValidString(got) {
+			t.Errorf("%s: %q is too long or not UTF-8", tt.name, got)
+		}
+	}
+}
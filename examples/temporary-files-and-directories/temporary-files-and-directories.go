@@ -1,19 +1,33 @@
-package main
+package temporaryfilesanddirectories
 
 import (
 	"fmt"
 	"io"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "temporary-files-and-directories",
+		Category:    examples.Practical,
+		Description: "Temporary Files and Directories",
+		Icon:        "📂",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Temporary Files and Directories ===")
 
 	// Create temporary file
+	// readme:begin
 	tempFile, err := os.CreateTemp("", "example-*.txt")
 	if err != nil {
 		panic(err)
 	}
+	// readme:end
 	defer os.Remove(tempFile.Name()) // Clean up
 	defer tempFile.Close()
 
@@ -1,8 +1,10 @@
-package main
+package testingandbenchmarking
 
 import (
 	"fmt"
 	"testing"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 // Function to test
@@ -43,12 +45,14 @@ func TestAddTable(t *testing.T) {
 	}
 
 	for _, test := range tests {
+		// readme:begin
 		t.Run(fmt.Sprintf("%d+%d", test.a, test.b), func(t *testing.T) {
 			result := Add(test.a, test.b)
 			if result != test.expected {
 				t.Errorf("Add(%d, %d) = %d; want %d", test.a, test.b, result, test.expected)
 			}
 		})
+		// readme:end
 	}
 }
 
@@ -72,7 +76,17 @@ func ExampleAdd() {
 	// Output: 5
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "testing-and-benchmarking",
+		Category:    examples.Practical,
+		Description: "Testing and Benchmarking",
+		Icon:        "🧪",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Testing and Benchmarking ===")
 	fmt.Println("Run tests with: go test")
 	fmt.Println("Run benchmarks with: go test -bench=.")
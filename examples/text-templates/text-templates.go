@@ -1,14 +1,28 @@
-package main
+package texttemplates
 
 import (
+	"fmt"
 	"os"
 	"text/template"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "text-templates",
+		Category:    examples.Practical,
+		Description: "Text Templates",
+		Icon:        "📋",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Text Templates ===")
 
 	// Basic template
+	// readme:begin
 	tmpl := `Hello {{.Name}}! You are {{.Age}} years old.`
 
 	// Parse template
@@ -16,6 +30,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	// readme:end
 
 	// Data for template
 	data := struct {
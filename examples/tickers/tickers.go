@@ -1,35 +1,89 @@
-package main
+package tickers
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "tickers",
+		Category:    examples.Expert,
+		Description: "Ticker Operations",
+		Icon:        "🔄",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic ticker", Run: basicTicker},
+			{Number: 2, Title: "Ticker with select", Run: tickerWithSelect},
+			{Number: 3, Title: "Multiple tickers", Run: multipleTickers},
+			{Number: 4, Title: "Ticker with stop condition", Run: tickerWithStopCondition},
+			{Number: 5, Title: "Ticker for periodic tasks", Run: tickerForPeriodicTasks},
+			{Number: 6, Title: "Ticker with rate limiting", Run: tickerWithRateLimiting},
+			{Number: 7, Title: "Ticker with timeout", Run: tickerWithTimeout},
+			{Number: 8, Title: "Ticker for monitoring", Run: tickerForMonitoring},
+			{Number: 9, Title: "Ticker with dynamic interval", Run: tickerWithDynamicInterval},
+			{Number: 10, Title: "Ticker for heartbeat", Run: tickerForHeartbeat},
+			{Number: 11, Title: "Ticker with data collection", Run: tickerWithDataCollection},
+			{Number: 12, Title: "Ticker with batch processing", Run: tickerWithBatchProcessing},
+			{Number: 13, Title: "Ticker with statistics", Run: tickerWithStatistics},
+			{Number: 14, Title: "Ticker with graceful shutdown", Run: tickerWithGracefulShutdown},
+			{Number: 15, Title: "Ticker vs Timer comparison", Run: tickerVsTimerComparison},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Tickers Examples ===")
 
-	// 1. Basic ticker
+	basicTicker()
+	tickerWithSelect()
+	multipleTickers()
+	tickerWithStopCondition()
+	tickerForPeriodicTasks()
+	tickerWithRateLimiting()
+	tickerWithTimeout()
+	tickerForMonitoring()
+	tickerWithDynamicInterval()
+	tickerForHeartbeat()
+	tickerWithDataCollection()
+	tickerWithBatchProcessing()
+	tickerWithStatistics()
+	tickerWithGracefulShutdown()
+	tickerVsTimerComparison()
+
+	fmt.Println("All ticker examples completed!")
+}
+
+// 1. Basic ticker
+func basicTicker() {
 	fmt.Println("\n1. Basic ticker:")
-	ticker1 := time.NewTicker(500 * time.Millisecond)
+	// readme:begin
+	ticker1 := clock.NewTicker(500 * time.Millisecond)
 	defer ticker1.Stop()
-	
+
 	for i := 0; i < 3; i++ {
 		<-ticker1.C
-		fmt.Printf("Tick %d at %v\n", i+1, time.Now().Format("15:04:05.000"))
+		fmt.Printf("Tick %d at %v\n", i+1, clock.Now().Format("15:04:05.000"))
 	}
+	// readme:end
+}
 
-	// 2. Ticker with select
+// 2. Ticker with select
+func tickerWithSelect() {
 	fmt.Println("\n2. Ticker with select:")
-	ticker2 := time.NewTicker(300 * time.Millisecond)
+	ticker2 := clock.NewTicker(300 * time.Millisecond)
 	defer ticker2.Stop()
-	
+
 	ch := make(chan string)
-	
+
 	go func() {
-		time.Sleep(800 * time.Millisecond)
+		clock.Sleep(800 * time.Millisecond)
 		ch <- "message"
 	}()
-	
+
 	for i := 0; i < 5; i++ {
 		select {
 		case <-ticker2.C:
@@ -39,100 +93,110 @@ func main() {
 			break
 		}
 	}
+}
 
-	// 3. Multiple tickers
+// 3. Multiple tickers
+func multipleTickers() {
 	fmt.Println("\n3. Multiple tickers:")
-	fastTicker := time.NewTicker(200 * time.Millisecond)
-	slowTicker := time.NewTicker(500 * time.Millisecond)
+	fastTicker := clock.NewTicker(200 * time.Millisecond)
+	slowTicker := clock.NewTicker(500 * time.Millisecond)
 	defer fastTicker.Stop()
 	defer slowTicker.Stop()
-	
+
 	count := 0
 	for count < 6 {
 		select {
 		case <-fastTicker.C:
-			fmt.Printf("Fast tick at %v\n", time.Now().Format("15:04:05.000"))
+			fmt.Printf("Fast tick at %v\n", clock.Now().Format("15:04:05.000"))
 			count++
 		case <-slowTicker.C:
-			fmt.Printf("Slow tick at %v\n", time.Now().Format("15:04:05.000"))
+			fmt.Printf("Slow tick at %v\n", clock.Now().Format("15:04:05.000"))
 			count++
 		}
 	}
+}
 
-	// 4. Ticker with stop condition
+// 4. Ticker with stop condition
+func tickerWithStopCondition() {
 	fmt.Println("\n4. Ticker with stop condition:")
-	ticker3 := time.NewTicker(100 * time.Millisecond)
+	ticker3 := clock.NewTicker(100 * time.Millisecond)
 	defer ticker3.Stop()
-	
+
 	go func() {
-		time.Sleep(550 * time.Millisecond)
+		clock.Sleep(550 * time.Millisecond)
 		ticker3.Stop()
 		fmt.Println("Ticker stopped from goroutine")
 	}()
-	
-	count = 0
+
+	count := 0
 	for {
 		select {
 		case <-ticker3.C:
 			count++
 			fmt.Printf("Tick %d\n", count)
-		case <-time.After(1 * time.Second):
+		case <-clock.After(1 * time.Second):
 			fmt.Println("Timeout reached")
 			return
 		}
-		
+
 		if count >= 10 {
 			break
 		}
 	}
+}
 
-	// 5. Ticker for periodic tasks
+// 5. Ticker for periodic tasks
+func tickerForPeriodicTasks() {
 	fmt.Println("\n5. Ticker for periodic tasks:")
-	
+
 	periodicTask := func() {
-		ticker := time.NewTicker(400 * time.Millisecond)
+		ticker := clock.NewTicker(400 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		tasks := []string{"Task A", "Task B", "Task C", "Task D"}
 		taskIndex := 0
-		
+
 		for i := 0; i < len(tasks)*2; i++ {
 			<-ticker.C
 			task := tasks[taskIndex%len(tasks)]
-			fmt.Printf("Executing %s at %v\n", task, time.Now().Format("15:04:05.000"))
+			fmt.Printf("Executing %s at %v\n", task, clock.Now().Format("15:04:05.000"))
 			taskIndex++
 		}
 	}
-	
+
 	periodicTask()
+}
 
-	// 6. Ticker with rate limiting
+// 6. Ticker with rate limiting
+func tickerWithRateLimiting() {
 	fmt.Println("\n6. Ticker with rate limiting:")
-	
+
 	rateLimited := func() {
-		ticker := time.NewTicker(200 * time.Millisecond)
+		ticker := clock.NewTicker(200 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		requests := []int{1, 2, 3, 4, 5, 6, 7, 8}
-		
+
 		for _, req := range requests {
 			<-ticker.C // Wait for ticker
-			fmt.Printf("Processing request %d at %v\n", req, time.Now().Format("15:04:05.000"))
+			fmt.Printf("Processing request %d at %v\n", req, clock.Now().Format("15:04:05.000"))
 		}
 	}
-	
+
 	rateLimited()
+}
 
-	// 7. Ticker with timeout
+// 7. Ticker with timeout
+func tickerWithTimeout() {
 	fmt.Println("\n7. Ticker with timeout:")
-	
+
 	tickerWithTimeout := func() {
-		ticker := time.NewTicker(300 * time.Millisecond)
+		ticker := clock.NewTicker(300 * time.Millisecond)
 		defer ticker.Stop()
-		
-		timeout := time.After(2 * time.Second)
+
+		timeout := clock.After(2 * time.Second)
 		count := 0
-		
+
 		for {
 			select {
 			case <-ticker.C:
@@ -144,28 +208,31 @@ func main() {
 			}
 		}
 	}
-	
+
 	tickerWithTimeout()
+}
 
-	// 8. Ticker for monitoring
+// 8. Ticker for monitoring
+func tickerForMonitoring() {
 	fmt.Println("\n8. Ticker for monitoring:")
-	
+
 	monitor := func() {
-		ticker := time.NewTicker(500 * time.Millisecond)
+		ticker := clock.NewTicker(500 * time.Millisecond)
 		defer ticker.Stop()
-		
+
+		count := 0
+
 		// Simulate system metrics
 		getCPU := func() float64 {
 			// Simulate CPU usage
 			return float64(count%100) / 100.0
 		}
-		
+
 		getMemory := func() int {
 			// Simulate memory usage
 			return 50 + count%50
 		}
-		
-		count := 0
+
 		for count < 5 {
 			<-ticker.C
 			cpu := getCPU()
@@ -174,86 +241,93 @@ func main() {
 			count++
 		}
 	}
-	
+
 	monitor()
+}
 
-	// 9. Ticker with dynamic interval
+// 9. Ticker with dynamic interval
+func tickerWithDynamicInterval() {
 	fmt.Println("\n9. Ticker with dynamic interval:")
-	
+
 	dynamicTicker := func() {
-		ticker := time.NewTicker(100 * time.Millisecond)
+		ticker := clock.NewTicker(100 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		interval := 100 * time.Millisecond
 		count := 0
-		
+
 		for count < 10 {
 			<-ticker.C
-			
+
 			// Change interval every 3 ticks
 			if count > 0 && count%3 == 0 {
 				interval += 100 * time.Millisecond
 				ticker.Stop()
-				ticker = time.NewTicker(interval)
+				ticker = clock.NewTicker(interval)
 				fmt.Printf("New interval: %v\n", interval)
 			}
-			
+
 			fmt.Printf("Tick %d (interval: %v)\n", count+1, interval)
 			count++
 		}
 	}
-	
+
 	dynamicTicker()
+}
 
-	// 10. Ticker for heartbeat
+// 10. Ticker for heartbeat
+func tickerForHeartbeat() {
 	fmt.Println("\n10. Ticker for heartbeat:")
-	
+
 	heartbeat := func() {
-		ticker := time.NewTicker(1 * time.Second)
+		ticker := clock.NewTicker(1 * time.Second)
 		defer ticker.Stop()
-		
+
 		stop := make(chan struct{})
-		
+
 		// Stop after 5 heartbeats
 		go func() {
-			time.Sleep(5 * time.Second)
+			clock.Sleep(5 * time.Second)
 			close(stop)
 		}()
-		
+
 		beatCount := 0
 		for {
 			select {
 			case <-ticker.C:
 				beatCount++
-				fmt.Printf("❤️ Heartbeat %d at %v\n", beatCount, time.Now().Format("15:04:05"))
+				fmt.Printf("❤️ Heartbeat %d at %v\n", beatCount, clock.Now().Format("15:04:05"))
 			case <-stop:
 				fmt.Println("Heartbeat stopped")
 				return
 			}
 		}
 	}
-	
+
 	heartbeat()
+}
 
-	// 11. Ticker with data collection
+// 11. Ticker with data collection
+func tickerWithDataCollection() {
 	fmt.Println("\n11. Ticker with data collection:")
-	
+
 	dataCollector := func() {
-		ticker := time.NewTicker(300 * time.Millisecond)
+		ticker := clock.NewTicker(300 * time.Millisecond)
 		defer ticker.Stop()
-		
+
+		count := 0
+
 		// Simulate sensor data
 		getSensorData := func() (temp float64, humidity int) {
 			return 20.0 + float64(count%10), 40 + count%20
 		}
-		
+
 		var readings []struct {
 			temp     float64
 			humidity int
 			time     time.Time
 		}
-		
-		count := 0
+
 		for count < 5 {
 			<-ticker.C
 			temp, humidity := getSensorData()
@@ -261,52 +335,54 @@ func main() {
 				temp     float64
 				humidity int
 				time     time.Time
-			}{temp, humidity, time.Now()}
-			
+			}{temp, humidity, clock.Now()}
+
 			readings = append(readings, reading)
-			fmt.Printf("Reading %d: Temp=%.1f°C, Humidity=%d%%\n", 
+			fmt.Printf("Reading %d: Temp=%.1f°C, Humidity=%d%%\n",
 				count+1, temp, humidity)
 			count++
 		}
-		
+
 		// Calculate averages
 		if len(readings) > 0 {
 			var totalTemp float64
 			var totalHumidity int
-			
+
 			for _, r := range readings {
 				totalTemp += r.temp
 				totalHumidity += r.humidity
 			}
-			
+
 			avgTemp := totalTemp / float64(len(readings))
 			avgHumidity := totalHumidity / len(readings)
-			
+
 			fmt.Printf("Averages: Temp=%.1f°C, Humidity=%d%%\n", avgTemp, avgHumidity)
 		}
 	}
-	
+
 	dataCollector()
+}
 
-	// 12. Ticker with batch processing
+// 12. Ticker with batch processing
+func tickerWithBatchProcessing() {
 	fmt.Println("\n12. Ticker with batch processing:")
-	
+
 	batchProcessor := func() {
-		ticker := time.NewTicker(400 * time.Millisecond)
+		ticker := clock.NewTicker(400 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		// Input generator
 		input := make(chan int)
 		go func() {
 			defer close(input)
 			for i := 1; i <= 15; i++ {
 				input <- i
-				time.Sleep(50 * time.Millisecond)
+				clock.Sleep(50 * time.Millisecond)
 			}
 		}()
-		
+
 		batch := make([]int, 0, 5)
-		
+
 		for {
 			select {
 			case <-ticker.C:
@@ -329,36 +405,38 @@ func main() {
 			}
 		}
 	}
-	
+
 	batchProcessor()
+}
 
-	// 13. Ticker with statistics
+// 13. Ticker with statistics
+func tickerWithStatistics() {
 	fmt.Println("\n13. Ticker with statistics:")
-	
+
 	tickerStats := func() {
-		ticker := time.NewTicker(200 * time.Millisecond)
+		ticker := clock.NewTicker(200 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		var tickTimes []time.Time
 		count := 0
-		
+
 		for count < 10 {
 			tickTime := <-ticker.C
 			tickTimes = append(tickTimes, tickTime)
 			fmt.Printf("Tick %d at %v\n", count+1, tickTime.Format("15:04:05.000"))
 			count++
 		}
-		
+
 		// Calculate intervals
 		if len(tickTimes) > 1 {
 			var totalInterval time.Duration
 			minInterval := tickTimes[1].Sub(tickTimes[0])
 			maxInterval := minInterval
-			
+
 			for i := 1; i < len(tickTimes); i++ {
 				interval := tickTimes[i].Sub(tickTimes[i-1])
 				totalInterval += interval
-				
+
 				if interval < minInterval {
 					minInterval = interval
 				}
@@ -366,77 +444,79 @@ func main() {
 					maxInterval = interval
 				}
 			}
-			
+
 			avgInterval := totalInterval / time.Duration(len(tickTimes)-1)
-			
-			fmt.Printf("Interval stats: Min=%v, Max=%v, Avg=%v\n", 
+
+			fmt.Printf("Interval stats: Min=%v, Max=%v, Avg=%v\n",
 				minInterval, maxInterval, avgInterval)
 		}
 	}
-	
+
 	tickerStats()
+}
 
-	// 14. Ticker with graceful shutdown
+// 14. Ticker with graceful shutdown
+func tickerWithGracefulShutdown() {
 	fmt.Println("\n14. Ticker with graceful shutdown:")
-	
+
 	gracefulShutdown := func() {
-		ticker := time.NewTicker(300 * time.Millisecond)
+		ticker := clock.NewTicker(300 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		shutdown := make(chan struct{})
 		done := make(chan struct{})
-		
+
 		// Initiate shutdown after 1 second
 		go func() {
-			time.Sleep(1 * time.Second)
+			clock.Sleep(1 * time.Second)
 			close(shutdown)
 		}()
-		
+
 		// Worker
 		go func() {
 			defer close(done)
 			for {
 				select {
 				case <-ticker.C:
-					fmt.Printf("Working at %v\n", time.Now().Format("15:04:05.000"))
+					fmt.Printf("Working at %v\n", clock.Now().Format("15:04:05.000"))
 				case <-shutdown:
 					fmt.Println("Received shutdown signal")
 					return
 				}
 			}
 		}()
-		
+
 		<-done
 		fmt.Println("Worker shutdown gracefully")
 	}
-	
+
 	gracefulShutdown()
+}
 
-	// 15. Ticker vs Timer comparison
+// 15. Ticker vs Timer comparison
+func tickerVsTimerComparison() {
 	fmt.Println("\n15. Ticker vs Timer comparison:")
-	
+
 	compareTickerTimer := func() {
 		fmt.Println("Using Timer:")
-		timer := time.NewTimer(0)
+		timer := clock.NewTimer(0)
 		defer timer.Stop()
-		
+
 		for i := 0; i < 3; i++ {
 			<-timer.C
 			fmt.Printf("Timer tick %d\n", i+1)
 			timer.Reset(300 * time.Millisecond)
 		}
-		
+
 		fmt.Println("\nUsing Ticker:")
-		ticker := time.NewTicker(300 * time.Millisecond)
+		ticker := clock.NewTicker(300 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		for i := 0; i < 3; i++ {
 			<-ticker.C
 			fmt.Printf("Ticker tick %d\n", i+1)
 		}
 	}
-	
-	compareTickerTimer()
 
-	fmt.Println("All ticker examples completed!")
+	compareTickerTimer()
 }
@@ -1,15 +1,28 @@
-package main
+package timeformattingparsing
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "time-formatting-parsing",
+		Category:    examples.Practical,
+		Description: "Time Formatting & Parsing",
+		Icon:        "📅",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Time Formatting & Parsing ===")
 
 	// Current time
-	now := time.Now()
+	now := clock.Now()
 
 	// Common format layouts
 	fmt.Printf("RFC1123: %s\n", now.Format(time.RFC1123))
@@ -22,8 +35,10 @@ func main() {
 	fmt.Printf("ISO: %s\n", now.Format("2006-01-02T15:04:05Z07:00"))
 
 	// Parse time from string
+	// readme:begin
 	timeStr := "2023-12-25 15:30:00"
 	parsed, err := time.Parse("2006-01-02 15:04:05", timeStr)
+	// readme:end
 	if err != nil {
 		panic(err)
 	}
@@ -1,15 +1,28 @@
-package main
+package time
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "time",
+		Category:    examples.Practical,
+		Description: "Time Operations",
+		Icon:        "⏰",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Time ===")
 
 	// Current time
-	now := time.Now()
+	now := clock.Now()
 	fmt.Printf("Current time: %v\n", now)
 
 	// Time components
@@ -24,7 +37,9 @@ func main() {
 	fmt.Printf("Weekday: %v\n", now.Weekday())
 
 	// Creating specific time
+	// readme:begin
 	specific := time.Date(2023, 12, 25, 15, 30, 0, 0, time.UTC)
+	// readme:end
 	fmt.Printf("Specific time: %v\n", specific)
 
 	// Time arithmetic
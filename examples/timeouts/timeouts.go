@@ -1,109 +1,167 @@
-package main
+package timeouts
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "timeouts",
+		Category:    examples.Expert,
+		Description: "Timeout Patterns",
+		Icon:        "⏱️",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic timeout with time.After", Run: basicTimeoutWithTimeAfter},
+			{Number: 2, Title: "Timeout with longer duration", Run: timeoutWithLongerDuration},
+			{Number: 3, Title: "Multiple timeouts", Run: multipleTimeouts},
+			{Number: 4, Title: "Timeout with function returning error", Run: timeoutWithFunctionReturningError},
+			{Number: 5, Title: "Timeout for network simulation", Run: timeoutForNetworkSimulation},
+			{Number: 6, Title: "Timeout with context cancellation", Run: timeoutWithContextCancellation},
+			{Number: 7, Title: "Timeout with retry logic", Run: timeoutWithRetryLogic},
+			{Number: 8, Title: "Timeout for concurrent operations", Run: timeoutForConcurrentOperations},
+			{Number: 9, Title: "Timeout with cleanup", Run: timeoutWithCleanup},
+			{Number: 10, Title: "Timeout with progress reporting", Run: timeoutWithProgressReporting},
+			{Number: 11, Title: "Timeout with deadline", Run: timeoutWithDeadline},
+			{Number: 12, Title: "Timeout pattern: Circuit breaker", Run: timeoutPatternCircuitBreaker},
+			{Number: 13, Title: "Timeout with graceful shutdown", Run: timeoutWithGracefulShutdown},
+			{Number: 14, Title: "Timeout with resource management", Run: timeoutWithResourceManagement},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Timeouts Examples ===")
 
-	// 1. Basic timeout with time.After
+	basicTimeoutWithTimeAfter()
+	timeoutWithLongerDuration()
+	multipleTimeouts()
+	timeoutWithFunctionReturningError()
+	timeoutForNetworkSimulation()
+	timeoutWithContextCancellation()
+	timeoutWithRetryLogic()
+	timeoutForConcurrentOperations()
+	timeoutWithCleanup()
+	timeoutWithProgressReporting()
+	timeoutWithDeadline()
+	timeoutPatternCircuitBreaker()
+	timeoutWithGracefulShutdown()
+	timeoutWithResourceManagement()
+
+	fmt.Println("All timeout examples completed!")
+}
+
+// 1. Basic timeout with time.After
+func basicTimeoutWithTimeAfter() {
 	fmt.Println("\n1. Basic timeout:")
 	ch := make(chan string)
-	
+
 	go func() {
-		time.Sleep(200 * time.Millisecond)
+		clock.Sleep(200 * time.Millisecond)
 		ch <- "result"
 	}()
-	
+
 	select {
 	case result := <-ch:
 		fmt.Printf("Received: %s\n", result)
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Operation timed out")
 	}
+}
 
-	// 2. Timeout with longer duration
+// 2. Timeout with longer duration
+func timeoutWithLongerDuration() {
 	fmt.Println("\n2. Timeout with longer duration:")
 	ch2 := make(chan int)
-	
+
 	go func() {
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		ch2 <- 42
 	}()
-	
+
 	select {
 	case result := <-ch2:
 		fmt.Printf("Received: %d\n", result)
-	case <-time.After(200 * time.Millisecond):
+	case <-clock.After(200 * time.Millisecond):
 		fmt.Println("Operation timed out")
 	}
+}
 
-	// 3. Multiple timeouts
+// 3. Multiple timeouts
+func multipleTimeouts() {
 	fmt.Println("\n3. Multiple timeouts:")
 	ch3 := make(chan string)
-	
+
 	go func() {
-		time.Sleep(150 * time.Millisecond)
+		clock.Sleep(150 * time.Millisecond)
 		ch3 <- "data"
 	}()
-	
+
 	select {
 	case data := <-ch3:
 		fmt.Printf("Received: %s\n", data)
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Short timeout (100ms)")
-	case <-time.After(300 * time.Millisecond):
+	case <-clock.After(300 * time.Millisecond):
 		fmt.Println("Long timeout (300ms)")
 	}
+}
 
-	// 4. Timeout with function returning error
+// 4. Timeout with function returning error
+func timeoutWithFunctionReturningError() {
 	fmt.Println("\n4. Timeout with error handling:")
-	
+
 	operationWithTimeout := func() (string, error) {
 		ch := make(chan string)
-		
+
 		go func() {
-			time.Sleep(200 * time.Millisecond)
+			clock.Sleep(200 * time.Millisecond)
 			ch <- "operation completed"
 		}()
-		
+
 		select {
 		case result := <-ch:
 			return result, nil
-		case <-time.After(100 * time.Millisecond):
+		case <-clock.After(100 * time.Millisecond):
 			return "", fmt.Errorf("operation timed out")
 		}
 	}
-	
+
 	result, err := operationWithTimeout()
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	} else {
 		fmt.Printf("Success: %s\n", result)
 	}
+}
 
-	// 5. Timeout for network simulation
+// 5. Timeout for network simulation
+func timeoutForNetworkSimulation() {
 	fmt.Println("\n5. Network operation timeout:")
-	
+
 	simulateNetworkCall := func(timeout time.Duration) (string, error) {
 		ch := make(chan string)
-		
+
 		go func() {
 			// Simulate network delay
-			time.Sleep(150 * time.Millisecond)
+			clock.Sleep(150 * time.Millisecond)
 			ch <- "network response"
 		}()
-		
+
+		// readme:begin
 		select {
 		case response := <-ch:
 			return response, nil
-		case <-time.After(timeout):
+		case <-clock.After(timeout):
 			return "", fmt.Errorf("network timeout after %v", timeout)
 		}
+		// readme:end
 	}
-	
+
 	// Try with short timeout
 	response, err := simulateNetworkCall(100 * time.Millisecond)
 	if err != nil {
@@ -111,7 +169,7 @@ func main() {
 	} else {
 		fmt.Printf("Response: %s\n", response)
 	}
-	
+
 	// Try with longer timeout
 	response, err = simulateNetworkCall(200 * time.Millisecond)
 	if err != nil {
@@ -119,22 +177,19 @@ func main() {
 	} else {
 		fmt.Printf("Response: %s\n", response)
 	}
+}
 
-	// 6. Timeout with context cancellation
+// 6. Timeout with context cancellation
+func timeoutWithContextCancellation() {
 	fmt.Println("\n6. Timeout with cancellation:")
-	
-	type Result struct {
-		Data  string
-		Error error
-	}
-	
+
 	ch4 := make(chan Result)
-	
+
 	go func() {
-		time.Sleep(150 * time.Millisecond)
+		clock.Sleep(150 * time.Millisecond)
 		ch4 <- Result{Data: "processed data", Error: nil}
 	}()
-	
+
 	select {
 	case result := <-ch4:
 		if result.Error != nil {
@@ -142,29 +197,31 @@ func main() {
 		} else {
 			fmt.Printf("Operation succeeded: %s\n", result.Data)
 		}
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Operation cancelled due to timeout")
 	}
+}
 
-	// 7. Timeout with retry logic
+// 7. Timeout with retry logic
+func timeoutWithRetryLogic() {
 	fmt.Println("\n7. Timeout with retry:")
-	
+
 	operationWithRetry := func(maxRetries int, timeout time.Duration) (string, error) {
 		for attempt := 1; attempt <= maxRetries; attempt++ {
 			fmt.Printf("Attempt %d\n", attempt)
-			
+
 			ch := make(chan string)
 			go func() {
 				// Simulate work that might take different times
 				delay := time.Duration(attempt*50) * time.Millisecond
-				time.Sleep(delay)
+				clock.Sleep(delay)
 				ch <- fmt.Sprintf("success on attempt %d", attempt)
 			}()
-			
+
 			select {
 			case result := <-ch:
 				return result, nil
-			case <-time.After(timeout):
+			case <-clock.After(timeout):
 				fmt.Printf("Attempt %d timed out\n", attempt)
 				if attempt == maxRetries {
 					return "", fmt.Errorf("operation failed after %d attempts", maxRetries)
@@ -173,94 +230,100 @@ func main() {
 		}
 		return "", fmt.Errorf("should not reach here")
 	}
-	
-	result, err = operationWithRetry(3, 100*time.Millisecond)
+
+	result, err := operationWithRetry(3, 100*time.Millisecond)
 	if err != nil {
 		fmt.Printf("Final error: %v\n", err)
 	} else {
 		fmt.Printf("Final success: %s\n", result)
 	}
+}
 
-	// 8. Timeout for concurrent operations
+// 8. Timeout for concurrent operations
+func timeoutForConcurrentOperations() {
 	fmt.Println("\n8. Timeout for concurrent operations:")
-	
+
 	ch5 := make(chan int)
 	ch6 := make(chan int)
-	
+
 	// Start two operations
 	go func() {
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		ch5 <- 100
 	}()
-	
+
 	go func() {
-		time.Sleep(200 * time.Millisecond)
+		clock.Sleep(200 * time.Millisecond)
 		ch6 <- 200
 	}()
-	
+
 	// Wait for first result with timeout
 	select {
 	case result1 := <-ch5:
 		fmt.Printf("First operation completed: %d\n", result1)
 	case result2 := <-ch6:
 		fmt.Printf("Second operation completed: %d\n", result2)
-	case <-time.After(150 * time.Millisecond):
+	case <-clock.After(150 * time.Millisecond):
 		fmt.Println("Both operations timed out")
 	}
+}
 
-	// 9. Timeout with cleanup
+// 9. Timeout with cleanup
+func timeoutWithCleanup() {
 	fmt.Println("\n9. Timeout with cleanup:")
-	
+
 	operationWithCleanup := func(timeout time.Duration) error {
-		ch := make(chan string)
+		ch := make(chan string, 1) // buffered so the worker never blocks after a timeout
 		done := make(chan bool)
-		
+
 		// Worker goroutine
 		go func() {
 			defer func() {
 				done <- true
 			}()
-			
-			time.Sleep(200 * time.Millisecond)
+
+			clock.Sleep(200 * time.Millisecond)
 			ch <- "work completed"
 		}()
-		
+
 		select {
 		case result := <-ch:
 			fmt.Printf("Work completed: %s\n", result)
 			return nil
-		case <-time.After(timeout):
+		case <-clock.After(timeout):
 			fmt.Println("Timeout occurred, cleaning up...")
 			// Wait for worker to finish cleanup
 			<-done
 			return fmt.Errorf("operation timed out after %v", timeout)
 		}
 	}
-	
-	err = operationWithCleanup(100 * time.Millisecond)
+
+	err := operationWithCleanup(100 * time.Millisecond)
 	fmt.Printf("Result: %v\n", err)
+}
 
-	// 10. Timeout with progress reporting
+// 10. Timeout with progress reporting
+func timeoutWithProgressReporting() {
 	fmt.Println("\n10. Timeout with progress:")
-	
+
 	operationWithProgress := func(timeout time.Duration) {
 		ch := make(chan string)
 		progress := make(chan int)
-		
+
 		// Worker with progress
 		go func() {
 			defer close(ch)
-			
+
 			for i := 1; i <= 5; i++ {
-				time.Sleep(50 * time.Millisecond)
+				clock.Sleep(50 * time.Millisecond)
 				progress <- i * 20 // Progress percentage
 			}
 			ch <- "completed"
 		}()
-		
-		ticker := time.NewTicker(30 * time.Millisecond)
+
+		ticker := clock.NewTicker(30 * time.Millisecond)
 		defer ticker.Stop()
-		
+
 		for {
 			select {
 			case result := <-ch:
@@ -270,87 +333,52 @@ func main() {
 				fmt.Printf("Progress: %d%%\n", p)
 			case <-ticker.C:
 				fmt.Println("Working...")
-			case <-time.After(timeout):
+			case <-clock.After(timeout):
 				fmt.Printf("Operation timed out after %v\n", timeout)
 				return
 			}
 		}
 	}
-	
+
 	operationWithProgress(200 * time.Millisecond)
+}
 
-	// 11. Timeout with deadline
+// 11. Timeout with deadline
+func timeoutWithDeadline() {
 	fmt.Println("\n11. Timeout with deadline:")
-	
+
 	operationWithDeadline := func(deadline time.Time) error {
 		ch := make(chan string)
-		
+
 		go func() {
-			time.Sleep(200 * time.Millisecond)
+			clock.Sleep(200 * time.Millisecond)
 			ch <- "result"
 		}()
-		
-		timeout := time.Until(deadline)
+
+		timeout := clock.Until(deadline)
 		select {
 		case result := <-ch:
 			fmt.Printf("Completed: %s\n", result)
 			return nil
-		case <-time.After(timeout):
+		case <-clock.After(timeout):
 			return fmt.Errorf("missed deadline at %v", deadline)
 		}
 	}
-	
-	deadline := time.Now().Add(100 * time.Millisecond)
-	err = operationWithDeadline(deadline)
+
+	deadline := clock.Now().Add(100 * time.Millisecond)
+	err := operationWithDeadline(deadline)
 	fmt.Printf("Deadline result: %v\n", err)
+}
 
-	// 12. Timeout pattern: Circuit breaker
+// 12. Timeout pattern: Circuit breaker
+func timeoutPatternCircuitBreaker() {
 	fmt.Println("\n12. Circuit breaker pattern:")
-	
-	type CircuitBreaker struct {
-		failures    int
-		maxFailures int
-		timeout     time.Duration
-		lastFailure time.Time
-	}
-	
-	(cb *CircuitBreaker) Call() (string, error) {
-		// Check if circuit is open
-		if cb.failures >= cb.maxFailures {
-			if time.Since(cb.lastFailure) < cb.timeout {
-				return "", fmt.Errorf("circuit breaker is open")
-			}
-			// Reset after timeout
-			cb.failures = 0
-		}
-		
-		ch := make(chan string)
-		go func() {
-			// Simulate operation
-			if time.Now().Unix()%2 == 0 { // Random failure
-				time.Sleep(150 * time.Millisecond)
-				return
-			}
-			time.Sleep(50 * time.Millisecond)
-			ch <- "success"
-		}()
-		
-		select {
-		case result := <-ch:
-			cb.failures = 0
-			return result, nil
-		case <-time.After(100 * time.Millisecond):
-			cb.failures++
-			cb.lastFailure = time.Now()
-			return "", fmt.Errorf("operation timed out, failures: %d", cb.failures)
-		}
-	}
-	
+
 	breaker := &CircuitBreaker{
 		maxFailures: 2,
 		timeout:     500 * time.Millisecond,
 	}
-	
+
 	// Test circuit breaker
 	for i := 1; i <= 5; i++ {
 		result, err := breaker.Call()
@@ -359,83 +387,135 @@ func main() {
 		} else {
 			fmt.Printf("Call %d succeeded: %s\n", i, result)
 		}
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(200 * time.Millisecond)
 	}
+}
 
-	// 13. Timeout with graceful shutdown
+// 13. Timeout with graceful shutdown
+func timeoutWithGracefulShutdown() {
 	fmt.Println("\n13. Graceful shutdown with timeout:")
-	
+
 	shutdown := make(chan struct{})
 	workDone := make(chan bool)
-	
+
 	// Worker
 	go func() {
 		defer close(workDone)
-		
+
 		for {
 			select {
 			case <-shutdown:
 				fmt.Println("Received shutdown signal, cleaning up...")
-				time.Sleep(50 * time.Millisecond) // Simulate cleanup
+				clock.Sleep(50 * time.Millisecond) // Simulate cleanup
 				fmt.Println("Cleanup completed")
 				return
 			default:
 				// Do work
-				time.Sleep(30 * time.Millisecond)
+				clock.Sleep(30 * time.Millisecond)
 				fmt.Println("Working...")
 			}
 		}
 	}()
-	
+
 	// Let worker run for a bit
-	time.Sleep(100 * time.Millisecond)
-	
+	clock.Sleep(100 * time.Millisecond)
+
 	// Initiate shutdown with timeout
 	close(shutdown)
-	
+
 	select {
 	case <-workDone:
 		fmt.Println("Graceful shutdown completed")
-	case <-time.After(200 * time.Millisecond):
+	case <-clock.After(200 * time.Millisecond):
 		fmt.Println("Forceful shutdown (timeout)")
 	}
+}
 
-	// 14. Timeout with resource management
+// 14. Timeout with resource management
+func timeoutWithResourceManagement() {
 	fmt.Println("\n14. Timeout with resource management:")
-	
+
 	acquireResource := func() (string, func(), error) {
 		ch := make(chan string)
 		release := make(chan func())
-		
+
 		// Resource manager
 		go func() {
 			resourceID := "resource-123"
 			fmt.Printf("Acquiring resource %s...\n", resourceID)
-			time.Sleep(50 * time.Millisecond)
-			
+			clock.Sleep(50 * time.Millisecond)
+
 			ch <- resourceID
 			release <- func() {
 				fmt.Printf("Releasing resource %s\n", resourceID)
 			}
 		}()
-		
+
 		select {
 		case id := <-ch:
 			releaseFunc := <-release
 			return id, releaseFunc, nil
-		case <-time.After(100 * time.Millisecond):
+		case <-clock.After(100 * time.Millisecond):
 			return "", nil, fmt.Errorf("resource acquisition timeout")
 		}
 	}
-	
+
 	resourceID, release, err := acquireResource()
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	} else {
 		fmt.Printf("Using resource %s\n", resourceID)
-		time.Sleep(30 * time.Millisecond)
+		clock.Sleep(30 * time.Millisecond)
 		release()
 	}
+}
 
-	fmt.Println("All timeout examples completed!")
+// 12. Timeout pattern: Circuit breaker
+type CircuitBreaker struct {
+	calls       int
+	failures    int
+	maxFailures int
+	timeout     time.Duration
+	lastFailure time.Time
+}
+
+func (cb *CircuitBreaker) Call() (string, error) {
+	// Check if circuit is open
+	if cb.failures >= cb.maxFailures {
+		if clock.Since(cb.lastFailure) < cb.timeout {
+			return "", fmt.Errorf("circuit breaker is open")
+		}
+		// Reset after timeout
+		cb.failures = 0
+	}
+
+	cb.calls++
+	failing := cb.calls <= 2 // The backend hangs for the first two calls
+
+	ch := make(chan string)
+	go func() {
+		// Simulate operation
+		if failing {
+			clock.Sleep(150 * time.Millisecond)
+			return
+		}
+		clock.Sleep(50 * time.Millisecond)
+		ch <- "success"
+	}()
+
+	select {
+	case result := <-ch:
+		cb.failures = 0
+		return result, nil
+	case <-clock.After(100 * time.Millisecond):
+		cb.failures++
+		cb.lastFailure = clock.Now()
+		return "", fmt.Errorf("operation timed out, failures: %d", cb.failures)
+	}
+}
+
+// 6. Timeout with context cancellation
+type Result struct {
+	Data  string
+	Error error
 }
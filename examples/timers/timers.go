@@ -1,63 +1,119 @@
-package main
+package timers
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "timers",
+		Category:    examples.Expert,
+		Description: "Timer Operations",
+		Icon:        "⏰",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic timer", Run: basicTimer},
+			{Number: 2, Title: "Timer with select", Run: timerWithSelect},
+			{Number: 3, Title: "Timer reset", Run: timerReset},
+			{Number: 4, Title: "Multiple timers", Run: multipleTimers},
+			{Number: 5, Title: "Timer with duration calculation", Run: timerWithDurationCalculation},
+			{Number: 6, Title: "Timer in loop", Run: timerInLoop},
+			{Number: 7, Title: "Timer with timeout pattern", Run: timerWithTimeoutPattern},
+			{Number: 8, Title: "Timer for periodic tasks", Run: timerForPeriodicTasks},
+			{Number: 9, Title: "Timer with cancellation", Run: timerWithCancellation},
+			{Number: 10, Title: "Timer for debouncing", Run: timerForDebouncing},
+			{Number: 11, Title: "Timer for heartbeat", Run: timerForHeartbeat},
+			{Number: 12, Title: "Timer with multiple durations", Run: timerWithMultipleDurations},
+			{Number: 13, Title: "Timer for retry mechanism", Run: timerForRetryMechanism},
+			{Number: 14, Title: "Timer with statistics", Run: timerWithStatistics},
+			{Number: 15, Title: "Timer with resource cleanup", Run: timerWithResourceCleanup},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Timers Examples ===")
 
-	// 1. Basic timer
+	basicTimer()
+	timerWithSelect()
+	timerReset()
+	multipleTimers()
+	timerWithDurationCalculation()
+	timerInLoop()
+	timerWithTimeoutPattern()
+	timerForPeriodicTasks()
+	timerWithCancellation()
+	timerForDebouncing()
+	timerForHeartbeat()
+	timerWithMultipleDurations()
+	timerForRetryMechanism()
+	timerWithStatistics()
+	timerWithResourceCleanup()
+
+	fmt.Println("All timer examples completed!")
+}
+
+// 1. Basic timer
+func basicTimer() {
 	fmt.Println("\n1. Basic timer:")
-	timer1 := time.NewTimer(2 * time.Second)
+	timer1 := clock.NewTimer(2 * time.Second)
 	defer timer1.Stop()
-	
+
 	<-timer1.C
 	fmt.Println("Timer 1 fired!")
+}
 
-	// 2. Timer with select
+// 2. Timer with select
+func timerWithSelect() {
 	fmt.Println("\n2. Timer with select:")
-	timer2 := time.NewTimer(1 * time.Second)
+	timer2 := clock.NewTimer(1 * time.Second)
 	defer timer2.Stop()
-	
+
 	ch := make(chan string)
-	
+
 	go func() {
-		time.Sleep(500 * time.Millisecond)
+		clock.Sleep(500 * time.Millisecond)
 		ch <- "message"
 	}()
-	
+
 	select {
 	case <-ch:
 		fmt.Println("Received message first")
 	case <-timer2.C:
 		fmt.Println("Timer fired first")
 	}
+}
 
-	// 3. Timer reset
+// 3. Timer reset
+func timerReset() {
 	fmt.Println("\n3. Timer reset:")
-	timer3 := time.NewTimer(2 * time.Second)
-	
+	timer3 := clock.NewTimer(2 * time.Second)
+
 	go func() {
-		time.Sleep(1 * time.Second)
+		clock.Sleep(1 * time.Second)
 		fmt.Println("Resetting timer...")
 		if !timer3.Stop() {
 			fmt.Println("Timer already fired")
 		}
 		timer3.Reset(1 * time.Second)
 	}()
-	
+
 	<-timer3.C
 	fmt.Println("Timer fired after reset")
+}
 
-	// 4. Multiple timers
+// 4. Multiple timers
+func multipleTimers() {
 	fmt.Println("\n4. Multiple timers:")
-	timerA := time.NewTimer(1 * time.Second)
-	timerB := time.NewTimer(2 * time.Second)
+	timerA := clock.NewTimer(1 * time.Second)
+	timerB := clock.NewTimer(2 * time.Second)
 	defer timerA.Stop()
 	defer timerB.Stop()
-	
+
 	for i := 0; i < 2; i++ {
 		select {
 		case <-timerA.C:
@@ -66,51 +122,57 @@ func main() {
 			fmt.Println("Timer B fired (2s)")
 		}
 	}
+}
 
-	// 5. Timer with duration calculation
+// 5. Timer with duration calculation
+func timerWithDurationCalculation() {
 	fmt.Println("\n5. Timer with duration calculation:")
-	start := time.Now()
-	timer4 := time.NewTimer(1500 * time.Millisecond)
+	start := clock.Now()
+	timer4 := clock.NewTimer(1500 * time.Millisecond)
 	defer timer4.Stop()
-	
+
 	<-timer4.C
-	elapsed := time.Since(start)
+	elapsed := clock.Since(start)
 	fmt.Printf("Timer fired after: %v\n", elapsed)
+}
 
-	// 6. Timer in loop
+// 6. Timer in loop
+func timerInLoop() {
 	fmt.Println("\n6. Timer in loop:")
-	ticker := time.NewTicker(500 * time.Millisecond)
+	ticker := clock.NewTicker(500 * time.Millisecond)
 	defer ticker.Stop()
-	
+
 	count := 0
 	for count < 3 {
 		select {
 		case <-ticker.C:
 			count++
-			fmt.Printf("Tick %d at %v\n", count, time.Now().Format("15:04:05.000"))
+			fmt.Printf("Tick %d at %v\n", count, clock.Now().Format("15:04:05.000"))
 		}
 	}
+}
 
-	// 7. Timer with timeout pattern
+// 7. Timer with timeout pattern
+func timerWithTimeoutPattern() {
 	fmt.Println("\n7. Timer with timeout pattern:")
-	
+
 	operation := func(timeout time.Duration) (string, error) {
 		ch := make(chan string)
-		
+
 		go func() {
 			// Simulate work
-			time.Sleep(800 * time.Millisecond)
+			clock.Sleep(800 * time.Millisecond)
 			ch <- "operation completed"
 		}()
-		
+
 		select {
 		case result := <-ch:
 			return result, nil
-		case <-time.After(timeout):
+		case <-clock.After(timeout):
 			return "", fmt.Errorf("operation timed out after %v", timeout)
 		}
 	}
-	
+
 	// Test with short timeout
 	result, err := operation(500 * time.Millisecond)
 	if err != nil {
@@ -118,7 +180,7 @@ func main() {
 	} else {
 		fmt.Printf("Result: %s\n", result)
 	}
-	
+
 	// Test with longer timeout
 	result, err = operation(1 * time.Second)
 	if err != nil {
@@ -126,110 +188,120 @@ func main() {
 	} else {
 		fmt.Printf("Result: %s\n", result)
 	}
+}
 
-	// 8. Timer for periodic tasks
+// 8. Timer for periodic tasks
+func timerForPeriodicTasks() {
 	fmt.Println("\n8. Timer for periodic tasks:")
-	
+
 	periodicTask := func(interval time.Duration, iterations int) {
-		timer := time.NewTimer(0) // Start immediately
+		timer := clock.NewTimer(0) // Start immediately
 		defer timer.Stop()
-		
+
+		// readme:begin
 		for i := 0; i < iterations; i++ {
 			<-timer.C
-			fmt.Printf("Task iteration %d at %v\n", i+1, time.Now().Format("15:04:05.000"))
+			fmt.Printf("Task iteration %d at %v\n", i+1, clock.Now().Format("15:04:05.000"))
 			timer.Reset(interval)
 		}
+		// readme:end
 	}
-	
+
 	go periodicTask(300*time.Millisecond, 3)
-	time.Sleep(1100 * time.Millisecond)
+	clock.Sleep(1100 * time.Millisecond)
+}
 
-	// 9. Timer with cancellation
+// 9. Timer with cancellation
+func timerWithCancellation() {
 	fmt.Println("\n9. Timer with cancellation:")
-	
+
 	cancellableOperation := func() {
-		timer := time.NewTimer(2 * time.Second)
+		timer := clock.NewTimer(2 * time.Second)
 		defer timer.Stop()
-		
+
 		cancel := make(chan struct{})
-		
+
 		// Cancel after 1 second
 		go func() {
-			time.Sleep(1 * time.Second)
+			clock.Sleep(1 * time.Second)
 			close(cancel)
 		}()
-		
+
 		select {
 		case <-timer.C:
 			fmt.Println("Operation completed normally")
 		case <-cancel:
 			fmt.Println("Operation cancelled")
-			if !timer.Stop() {
-				<-timer.C // Drain if already fired
-			}
+			timer.Stop()
 		}
 	}
-	
+
 	cancellableOperation()
+}
 
-	// 10. Timer for debouncing
+// 10. Timer for debouncing
+func timerForDebouncing() {
 	fmt.Println("\n10. Timer for debouncing:")
-	
+
 	debouncer := func() {
 		input := make(chan string)
-		timer := time.NewTimer(0)
+		timer := clock.NewTimer(300 * time.Millisecond)
+		timer.Stop() // armed only once input arrives
 		defer timer.Stop()
-		
+
 		// Input generator
 		go func() {
 			defer close(input)
 			inputs := []string{"a", "b", "c", "d", "e"}
 			for _, item := range inputs {
 				input <- item
-				time.Sleep(100 * time.Millisecond)
+				clock.Sleep(100 * time.Millisecond)
 			}
 		}()
-		
+
 		for {
 			select {
 			case item, ok := <-input:
 				if !ok {
+					// Flush whatever is still pending before giving up
+					<-timer.C
+					fmt.Printf("Debounced output at %v\n", clock.Now().Format("15:04:05.000"))
 					return
 				}
 				fmt.Printf("Received: %s (resetting timer)\n", item)
-				if !timer.Stop() {
-					<-timer.C // Drain
-				}
+				// Since Go 1.23 Reset discards any stale tick, no drain needed
 				timer.Reset(300 * time.Millisecond)
 			case <-timer.C:
-				fmt.Printf("Debounced output at %v\n", time.Now().Format("15:04:05.000"))
+				fmt.Printf("Debounced output at %v\n", clock.Now().Format("15:04:05.000"))
 			}
 		}
 	}
-	
+
 	debouncer()
+}
 
-	// 11. Timer for heartbeat
+// 11. Timer for heartbeat
+func timerForHeartbeat() {
 	fmt.Println("\n11. Timer for heartbeat:")
-	
+
 	heartbeat := func() {
-		timer := time.NewTimer(0)
+		timer := clock.NewTimer(0)
 		defer timer.Stop()
-		
+
 		stop := make(chan struct{})
-		
+
 		// Stop after 2 seconds
 		go func() {
-			time.Sleep(2 * time.Second)
+			clock.Sleep(2 * time.Second)
 			close(stop)
 		}()
-		
+
 		count := 0
 		for {
 			select {
 			case <-timer.C:
 				count++
-				fmt.Printf("Heartbeat %d at %v\n", count, time.Now().Format("15:04:05.000"))
+				fmt.Printf("Heartbeat %d at %v\n", count, clock.Now().Format("15:04:05.000"))
 				timer.Reset(500 * time.Millisecond)
 			case <-stop:
 				fmt.Println("Heartbeat stopped")
@@ -237,57 +309,61 @@ func main() {
 			}
 		}
 	}
-	
+
 	heartbeat()
+}
 
-	// 12. Timer with multiple durations
+// 12. Timer with multiple durations
+func timerWithMultipleDurations() {
 	fmt.Println("\n12. Timer with multiple durations:")
-	
+
 	multiTimer := func() {
-		shortTimer := time.NewTimer(500 * time.Millisecond)
-		mediumTimer := time.NewTimer(1 * time.Second)
-		longTimer := time.NewTimer(2 * time.Second)
-		
+		shortTimer := clock.NewTimer(500 * time.Millisecond)
+		mediumTimer := clock.NewTimer(1 * time.Second)
+		longTimer := clock.NewTimer(2 * time.Second)
+
 		defer shortTimer.Stop()
 		defer mediumTimer.Stop()
 		defer longTimer.Stop()
-		
+
 		for {
 			select {
 			case <-shortTimer.C:
-				fmt.Printf("Short timer fired at %v\n", time.Now().Format("15:04:05.000"))
+				fmt.Printf("Short timer fired at %v\n", clock.Now().Format("15:04:05.000"))
 				shortTimer.Reset(500 * time.Millisecond)
 			case <-mediumTimer.C:
-				fmt.Printf("Medium timer fired at %v\n", time.Now().Format("15:04:05.000"))
+				fmt.Printf("Medium timer fired at %v\n", clock.Now().Format("15:04:05.000"))
 				mediumTimer.Reset(1 * time.Second)
 			case <-longTimer.C:
-				fmt.Printf("Long timer fired at %v\n", time.Now().Format("15:04:05.000"))
+				fmt.Printf("Long timer fired at %v\n", clock.Now().Format("15:04:05.000"))
 				longTimer.Reset(2 * time.Second)
 				return // Stop after long timer
 			}
 		}
 	}
-	
+
 	multiTimer()
+}
 
-	// 13. Timer for retry mechanism
+// 13. Timer for retry mechanism
+func timerForRetryMechanism() {
 	fmt.Println("\n13. Timer for retry mechanism:")
-	
+
 	retryOperation := func(maxRetries int) error {
-		timer := time.NewTimer(0)
+		timer := clock.NewTimer(0)
 		defer timer.Stop()
-		
+
 		for attempt := 1; attempt <= maxRetries; attempt++ {
 			fmt.Printf("Attempt %d\n", attempt)
-			
+
 			// Simulate operation
 			go func() {
-				time.Sleep(300 * time.Millisecond)
+				clock.Sleep(300 * time.Millisecond)
 				if attempt == 3 { // Success on 3rd attempt
 					timer.Stop() // Stop the timer
 				}
 			}()
-			
+
 			select {
 			case <-timer.C:
 				fmt.Printf("Attempt %d timed out\n", attempt)
@@ -295,7 +371,7 @@ func main() {
 					return fmt.Errorf("failed after %d attempts", maxRetries)
 				}
 				timer.Reset(500 * time.Millisecond)
-			case <-time.After(100 * time.Millisecond):
+			case <-clock.After(100 * time.Millisecond):
 				// Timer was stopped (success)
 				fmt.Printf("Attempt %d succeeded\n", attempt)
 				return nil
@@ -303,37 +379,39 @@ func main() {
 		}
 		return nil
 	}
-	
+
 	err := retryOperation(5)
 	if err != nil {
 		fmt.Printf("Retry failed: %v\n", err)
 	} else {
 		fmt.Println("Retry succeeded")
 	}
+}
 
-	// 14. Timer with statistics
+// 14. Timer with statistics
+func timerWithStatistics() {
 	fmt.Println("\n14. Timer with statistics:")
-	
+
 	timerStats := func() {
 		var durations []time.Duration
-		
+
 		for i := 0; i < 3; i++ {
-			start := time.Now()
-			timer := time.NewTimer(time.Duration(100+i*50) * time.Millisecond)
-			
+			start := clock.Now()
+			timer := clock.NewTimer(time.Duration(100+i*50) * time.Millisecond)
+
 			<-timer.C
-			elapsed := time.Since(start)
+			elapsed := clock.Since(start)
 			durations = append(durations, elapsed)
-			
-			fmt.Printf("Timer %d: expected %v, actual %v\n", 
+
+			fmt.Printf("Timer %d: expected %v, actual %v\n",
 				i+1, time.Duration(100+i*50)*time.Millisecond, elapsed)
 		}
-		
+
 		// Calculate statistics
 		var total time.Duration
 		min := durations[0]
 		max := durations[0]
-		
+
 		for _, d := range durations {
 			total += d
 			if d < min {
@@ -343,35 +421,35 @@ func main() {
 				max = d
 			}
 		}
-		
+
 		avg := total / time.Duration(len(durations))
-		
+
 		fmt.Printf("Statistics: Min=%v, Max=%v, Avg=%v\n", min, max, avg)
 	}
-	
+
 	timerStats()
+}
 
-	// 15. Timer with resource cleanup
+// 15. Timer with resource cleanup
+func timerWithResourceCleanup() {
 	fmt.Println("\n15. Timer with resource cleanup:")
-	
+
 	resourceWithTimer := func() {
-		timer := time.NewTimer(1 * time.Second)
+		timer := clock.NewTimer(1 * time.Second)
 		defer timer.Stop()
-		
+
 		// Simulate resource
 		resource := "shared-resource"
 		fmt.Printf("Acquired resource: %s\n", resource)
-		
+
 		// Work with timeout
 		select {
 		case <-timer.C:
 			fmt.Printf("Work completed, releasing resource: %s\n", resource)
-		case <-time.After(500 * time.Millisecond):
+		case <-clock.After(500 * time.Millisecond):
 			fmt.Printf("Work timed out, force cleanup: %s\n", resource)
 		}
 	}
-	
-	resourceWithTimer()
 
-	fmt.Println("All timer examples completed!")
+	resourceWithTimer()
 }
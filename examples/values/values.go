@@ -0,0 +1,29 @@
+package values
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "values",
+		Category:    examples.Beginner,
+		Description: "Values and Basic Types",
+		Icon:        "🧮",
+		Run:         Run,
+	})
+}
+
+func Run() {
+	// readme:begin
+	fmt.Println("Hello World!")
+	fmt.Println("1+1 =", 1+1)
+	fmt.Println("7.0/3.0 =", 7.0/3.0)
+
+	fmt.Println(true && false)
+	fmt.Println(true || false)
+	fmt.Println(!true)
+	// readme:end
+}
@@ -1,11 +1,27 @@
-package main
-
-import "fmt"
+package variables
+
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+func init() {
+	examples.Register(examples.Example{
+		Name:        "variables",
+		Category:    examples.Beginner,
+		Description: "Variable Declarations and Usage",
+		Icon:        "📋",
+		Run:         Run,
+	})
+}
 
-func main() {
+func Run() {
 	// Variable declarations with var keyword
+	// readme:begin
 	var name string = "John"
 	var age int = 25
+	// readme:end
 	var height float64 = 5.9
 	var isStudent bool = true
 
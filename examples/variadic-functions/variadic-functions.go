@@ -1,6 +1,10 @@
-package main
+package variadicfunctions
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
 
 // Basic variadic function
 func sum(numbers ...int) int {
@@ -100,7 +104,7 @@ func divideNumbers(divisor float64, numbers ...float64) ([]float64, error) {
 // Variadic function that builds a map
 func buildMap(pairs ...string) map[string]string {
 	result := make(map[string]string)
-	
+
 	for i := 0; i < len(pairs); i += 2 {
 		if i+1 < len(pairs) {
 			result[pairs[i]] = pairs[i+1]
@@ -115,7 +119,7 @@ func printNumbers(prefix string, numbers ...int) {
 		fmt.Printf("%s: No numbers provided\n", prefix)
 		return
 	}
-	
+
 	fmt.Printf("%s: ", prefix)
 	for i, num := range numbers {
 		if i > 0 {
@@ -131,7 +135,7 @@ func concatenate(sep string, parts ...string) string {
 	if len(parts) == 0 {
 		return ""
 	}
-	
+
 	result := parts[0]
 	for i := 1; i < len(parts); i++ {
 		result += sep + parts[i]
@@ -142,37 +146,82 @@ func concatenate(sep string, parts ...string) string {
 // Variadic function with validation
 func validateAndProcess(min, max int, numbers ...int) ([]int, error) {
 	var valid []int
-	
+
 	for _, num := range numbers {
 		if num < min || num > max {
 			return nil, fmt.Errorf("number %d is out of range [%d, %d]", num, min, max)
 		}
 		valid = append(valid, num)
 	}
-	
+
 	return valid, nil
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "variadic-functions",
+		Category:    examples.Beginner,
+		Description: "Variadic Functions",
+		Icon:        "📝",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic variadic function", Run: basicVariadicFunction},
+			{Number: 2, Title: "Variadic function with other parameters", Run: variadicFunctionWithOtherParameters},
+			{Number: 3, Title: "Variadic function returning multiple values", Run: variadicFunctionReturningMultipleValues},
+			{Number: 4, Title: "Passing slice to variadic function", Run: passingSliceToVariadicFunction},
+			{Number: 5, Title: "Variadic function with predicate", Run: variadicFunctionWithPredicate},
+			{Number: 6, Title: "Variadic function with interface{}", Run: variadicFunctionWithInterface},
+			{Number: 7, Title: "Variadic function returning slice", Run: variadicFunctionReturningSlice},
+			{Number: 8, Title: "Variadic function with error handling", Run: variadicFunctionWithErrorHandling},
+			{Number: 9, Title: "Variadic function building map", Run: variadicFunctionBuildingMap},
+			{Number: 10, Title: "Variadic function with default behavior", Run: variadicFunctionWithDefaultBehavior},
+			{Number: 11, Title: "Variadic function for string concatenation", Run: variadicFunctionForStringConcatenation},
+			{Number: 12, Title: "Variadic function with validation", Run: variadicFunctionWithValidation},
+			{Number: 13, Title: "Mixing regular and variadic parameters", Run: mixingRegularAndVariadicParameters},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Variadic Functions Examples ===")
 
-	// 1. Basic variadic function
+	basicVariadicFunction()
+	variadicFunctionWithOtherParameters()
+	variadicFunctionReturningMultipleValues()
+	passingSliceToVariadicFunction()
+	variadicFunctionWithPredicate()
+	variadicFunctionWithInterface()
+	variadicFunctionReturningSlice()
+	variadicFunctionWithErrorHandling()
+	variadicFunctionBuildingMap()
+	variadicFunctionWithDefaultBehavior()
+	variadicFunctionForStringConcatenation()
+	variadicFunctionWithValidation()
+	mixingRegularAndVariadicParameters()
+}
+
+// 1. Basic variadic function
+func basicVariadicFunction() {
 	fmt.Println("\n1. Basic variadic function:")
 	total := sum(1, 2, 3, 4, 5)
 	fmt.Printf("Sum of 1,2,3,4,5: %d\n", total)
-	
+
 	total = sum(10, 20, 30)
 	fmt.Printf("Sum of 10,20,30: %d\n", total)
-	
+
 	total = sum() // No arguments
 	fmt.Printf("Sum of no numbers: %d\n", total)
+}
 
-	// 2. Variadic function with other parameters
+// 2. Variadic function with other parameters
+func variadicFunctionWithOtherParameters() {
 	fmt.Println("\n2. Variadic with other parameters:")
 	greet("Hello", "Alice", "Bob", "Charlie")
 	greet("Hi", "David")
+}
 
-	// 3. Variadic function returning multiple values
+// 3. Variadic function returning multiple values
+func variadicFunctionReturningMultipleValues() {
 	fmt.Println("\n3. Variadic returning multiple values:")
 	result, err := calculate("sum", 1.5, 2.5, 3.5)
 	if err != nil {
@@ -180,46 +229,58 @@ func main() {
 	} else {
 		fmt.Printf("Sum: %.1f\n", result)
 	}
-	
+
 	result, err = calculate("average", 10, 20, 30, 40, 50)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	} else {
 		fmt.Printf("Average: %.1f\n", result)
 	}
-	
+
 	result, err = calculate("max", 5, 2, 8, 1, 9)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	} else {
 		fmt.Printf("Max: %.1f\n", result)
 	}
+}
 
-	// 4. Passing slice to variadic function
+// 4. Passing slice to variadic function
+func passingSliceToVariadicFunction() {
 	fmt.Println("\n4. Passing slice to variadic function:")
 	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	total = sum(numbers...)
+	// readme:begin
+	total := sum(numbers...)
 	fmt.Printf("Sum of slice %v: %d\n", numbers, total)
+	// readme:end
+}
 
-	// 5. Variadic function with predicate
+// 5. Variadic function with predicate
+func variadicFunctionWithPredicate() {
 	fmt.Println("\n5. Variadic with predicate function:")
 	even := filter(func(n int) bool { return n%2 == 0 }, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
 	fmt.Printf("Even numbers: %v\n", even)
-	
+
 	positive := filter(func(n int) bool { return n > 0 }, -5, -3, 0, 2, 4, 6)
 	fmt.Printf("Positive numbers: %v\n", positive)
+}
 
-	// 6. Variadic function with interface{}
+// 6. Variadic function with interface{}
+func variadicFunctionWithInterface() {
 	fmt.Println("\n6. Variadic with interface{}:")
 	message := formatMessage("Hello %s, you are %d years old and scored %.1f%%", "Alice", 25, 95.5)
 	fmt.Printf("Formatted message: %s\n", message)
+}
 
-	// 7. Variadic function returning slice
+// 7. Variadic function returning slice
+func variadicFunctionReturningSlice() {
 	fmt.Println("\n7. Variadic returning slice:")
 	collection := collectStrings("apple", "banana", "orange", "grape")
 	fmt.Printf("Collected strings: %v\n", collection)
+}
 
-	// 8. Variadic function with error handling
+// 8. Variadic function with error handling
+func variadicFunctionWithErrorHandling() {
 	fmt.Println("\n8. Variadic with error handling:")
 	results, err := divideNumbers(2, 10, 20, 30, 40)
 	if err != nil {
@@ -227,31 +288,39 @@ func main() {
 	} else {
 		fmt.Printf("Division results: %v\n", results)
 	}
-	
+
 	_, err = divideNumbers(0, 10, 20)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	}
+}
 
-	// 9. Variadic function building map
+// 9. Variadic function building map
+func variadicFunctionBuildingMap() {
 	fmt.Println("\n9. Variadic building map:")
 	config := buildMap("host", "localhost", "port", "8080", "debug", "true")
 	fmt.Printf("Config map: %v\n", config)
+}
 
-	// 10. Variadic function with default behavior
+// 10. Variadic function with default behavior
+func variadicFunctionWithDefaultBehavior() {
 	fmt.Println("\n10. Variadic with default behavior:")
 	printNumbers("Numbers", 1, 2, 3)
 	printNumbers("Empty")
+}
 
-	// 11. Variadic function for string concatenation
+// 11. Variadic function for string concatenation
+func variadicFunctionForStringConcatenation() {
 	fmt.Println("\n11. String concatenation:")
 	joined := concatenate("-", "2023", "12", "25")
 	fmt.Printf("Joined with '-': %s\n", joined)
-	
+
 	joined = concatenate(" ", "Hello", "beautiful", "world")
 	fmt.Printf("Joined with ' ': %s\n", joined)
+}
 
-	// 12. Variadic function with validation
+// 12. Variadic function with validation
+func variadicFunctionWithValidation() {
 	fmt.Println("\n12. Variadic with validation:")
 	valid, err := validateAndProcess(1, 100, 5, 10, 15, 20)
 	if err != nil {
@@ -259,13 +328,15 @@ func main() {
 	} else {
 		fmt.Printf("Valid numbers: %v\n", valid)
 	}
-	
+
 	_, err = validateAndProcess(1, 10, 5, 15, 0, 8) // 0 is out of range
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	}
+}
 
-	// 13. Mixing regular and variadic parameters
+// 13. Mixing regular and variadic parameters
+func mixingRegularAndVariadicParameters() {
 	fmt.Println("\n13. Mixed parameters:")
 	process := func(op string, x int, nums ...int) int {
 		total := x
@@ -278,10 +349,10 @@ func main() {
 		}
 		return total
 	}
-	
-	result = process("add", 10, 5, 3, 2)
-	fmt.Printf("Add 10 + 5 + 3 + 2 = %d\n", result)
-	
-	result = process("multiply", 2, 3, 4)
-	fmt.Printf("Multiply 2 * 3 * 4 = %d\n", result)
+
+	combined := process("add", 10, 5, 3, 2)
+	fmt.Printf("Add 10 + 5 + 3 + 2 = %d\n", combined)
+
+	combined = process("multiply", 2, 3, 4)
+	fmt.Printf("Multiply 2 * 3 * 4 = %d\n", combined)
 }
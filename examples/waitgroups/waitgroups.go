@@ -1,98 +1,156 @@
-package main
+package waitgroups
 
 import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "waitgroups",
+		Category:    examples.Expert,
+		Description: "WaitGroup Synchronization",
+		Icon:        "⏳",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic WaitGroup", Run: basicWaitGroup},
+			{Number: 2, Title: "WaitGroup with return values", Run: waitgroupWithReturnValues},
+			{Number: 3, Title: "Nested WaitGroups", Run: nestedWaitGroups},
+			{Number: 4, Title: "WaitGroup with error handling", Run: waitgroupWithErrorHandling},
+			{Number: 5, Title: "WaitGroup with timeout", Run: waitgroupWithTimeout},
+			{Number: 6, Title: "WaitGroup with counter", Run: waitgroupWithCounter},
+			{Number: 7, Title: "WaitGroup with dynamic addition", Run: waitgroupWithDynamicAddition},
+			{Number: 8, Title: "WaitGroup with pipeline", Run: waitgroupWithPipeline},
+			{Number: 9, Title: "WaitGroup with resource pool", Run: waitgroupWithResourcePool},
+			{Number: 10, Title: "WaitGroup with fan-out/fan-in", Run: waitgroupWithFanOutFanIn},
+			{Number: 11, Title: "WaitGroup with batch processing", Run: waitgroupWithBatchProcessing},
+			{Number: 12, Title: "WaitGroup with progress tracking", Run: waitgroupWithProgressTracking},
+			{Number: 13, Title: "WaitGroup with cancellation", Run: waitgroupWithCancellation},
+			{Number: 14, Title: "WaitGroup with retry mechanism", Run: waitgroupWithRetryMechanism},
+			{Number: 15, Title: "WaitGroup with resource cleanup", Run: waitgroupWithResourceCleanup},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== WaitGroups Examples ===")
 
-	// 1. Basic WaitGroup
+	basicWaitGroup()
+	waitgroupWithReturnValues()
+	nestedWaitGroups()
+	waitgroupWithErrorHandling()
+	waitgroupWithTimeout()
+	waitgroupWithCounter()
+	waitgroupWithDynamicAddition()
+	waitgroupWithPipeline()
+	waitgroupWithResourcePool()
+	waitgroupWithFanOutFanIn()
+	waitgroupWithBatchProcessing()
+	waitgroupWithProgressTracking()
+	waitgroupWithCancellation()
+	waitgroupWithRetryMechanism()
+	waitgroupWithResourceCleanup()
+
+	fmt.Println("All WaitGroup examples completed!")
+}
+
+// 1. Basic WaitGroup
+func basicWaitGroup() {
 	fmt.Println("\n1. Basic WaitGroup:")
 	var wg sync.WaitGroup
-	
+
 	for i := 1; i <= 3; i++ {
+		// readme:begin
 		wg.Add(1)
 		go func(id int) {
 			defer wg.Done()
 			fmt.Printf("Goroutine %d started\n", id)
-			time.Sleep(100 * time.Millisecond)
+			clock.Sleep(100 * time.Millisecond)
 			fmt.Printf("Goroutine %d finished\n", id)
 		}(i)
+		// readme:end
 	}
-	
+
 	fmt.Println("Waiting for goroutines...")
 	wg.Wait()
 	fmt.Println("All goroutines completed")
+}
 
-	// 2. WaitGroup with return values
+// 2. WaitGroup with return values
+func waitgroupWithReturnValues() {
 	fmt.Println("\n2. WaitGroup with return values:")
 	var wg2 sync.WaitGroup
 	results := make(chan int, 3)
-	
+
 	for i := 1; i <= 3; i++ {
 		wg2.Add(1)
 		go func(id int) {
 			defer wg2.Done()
-			time.Sleep(50 * time.Millisecond)
+			clock.Sleep(50 * time.Millisecond)
 			results <- id * 10
 		}(i)
 	}
-	
+
 	// Wait for completion
 	go func() {
 		wg2.Wait()
 		close(results)
 	}()
-	
+
 	// Collect results
 	for result := range results {
 		fmt.Printf("Received result: %d\n", result)
 	}
+}
 
-	// 3. Nested WaitGroups
+// 3. Nested WaitGroups
+func nestedWaitGroups() {
 	fmt.Println("\n3. Nested WaitGroups:")
 	var outerWG sync.WaitGroup
-	
+
 	for i := 1; i <= 2; i++ {
 		outerWG.Add(1)
 		go func(outerID int) {
 			defer outerWG.Done()
-			
+
 			var innerWG sync.WaitGroup
 			fmt.Printf("Outer goroutine %d started\n", outerID)
-			
+
 			for j := 1; j <= 2; j++ {
 				innerWG.Add(1)
 				go func(innerID int) {
 					defer innerWG.Done()
 					fmt.Printf("  Inner goroutine %d.%d started\n", outerID, innerID)
-					time.Sleep(50 * time.Millisecond)
+					clock.Sleep(50 * time.Millisecond)
 					fmt.Printf("  Inner goroutine %d.%d finished\n", outerID, innerID)
 				}(j)
 			}
-			
+
 			innerWG.Wait()
 			fmt.Printf("Outer goroutine %d finished\n", outerID)
 		}(i)
 	}
-	
+
 	outerWG.Wait()
 	fmt.Println("All nested goroutines completed")
+}
 
-	// 4. WaitGroup with error handling
+// 4. WaitGroup with error handling
+func waitgroupWithErrorHandling() {
 	fmt.Println("\n4. WaitGroup with error handling:")
 	var wg3 sync.WaitGroup
 	errors := make(chan error, 3)
-	
+
 	for i := 1; i <= 3; i++ {
 		wg3.Add(1)
 		go func(id int) {
 			defer wg3.Done()
-			time.Sleep(50 * time.Millisecond)
-			
+			clock.Sleep(50 * time.Millisecond)
+
 			// Simulate error for goroutine 2
 			if id == 2 {
 				errors <- fmt.Errorf("error in goroutine %d", id)
@@ -101,13 +159,13 @@ func main() {
 			}
 		}(i)
 	}
-	
+
 	// Wait and collect errors
 	go func() {
 		wg3.Wait()
 		close(errors)
 	}()
-	
+
 	for err := range errors {
 		if err != nil {
 			fmt.Printf("Error: %v\n", err)
@@ -115,95 +173,103 @@ func main() {
 			fmt.Println("Success")
 		}
 	}
+}
 
-	// 5. WaitGroup with timeout
+// 5. WaitGroup with timeout
+func waitgroupWithTimeout() {
 	fmt.Println("\n5. WaitGroup with timeout:")
 	var wg4 sync.WaitGroup
-	
+
 	for i := 1; i <= 3; i++ {
 		wg4.Add(1)
 		go func(id int) {
 			defer wg4.Done()
-			time.Sleep(200 * time.Millisecond)
+			clock.Sleep(200 * time.Millisecond)
 			fmt.Printf("Goroutine %d completed\n", id)
 		}(i)
 	}
-	
+
 	// Wait with timeout
 	done := make(chan struct{})
 	go func() {
 		wg4.Wait()
 		close(done)
 	}()
-	
+
 	select {
 	case <-done:
 		fmt.Println("All goroutines completed normally")
-	case <-time.After(100 * time.Millisecond):
+	case <-clock.After(100 * time.Millisecond):
 		fmt.Println("Timeout reached")
 	}
+}
 
-	// 6. WaitGroup with counter
+// 6. WaitGroup with counter
+func waitgroupWithCounter() {
 	fmt.Println("\n6. WaitGroup with counter:")
 	var wg5 sync.WaitGroup
 	counter := 0
 	var mu sync.Mutex
-	
+
 	for i := 1; i <= 5; i++ {
 		wg5.Add(1)
 		go func(id int) {
 			defer wg5.Done()
-			time.Sleep(50 * time.Millisecond)
-			
+			clock.Sleep(50 * time.Millisecond)
+
 			mu.Lock()
 			counter++
 			fmt.Printf("Goroutine %d completed (total: %d)\n", id, counter)
 			mu.Unlock()
 		}(i)
 	}
-	
+
 	wg5.Wait()
 	fmt.Printf("Final counter: %d\n", counter)
+}
 
-	// 7. WaitGroup with dynamic addition
+// 7. WaitGroup with dynamic addition
+func waitgroupWithDynamicAddition() {
 	fmt.Println("\n7. WaitGroup with dynamic addition:")
 	var wg6 sync.WaitGroup
 	work := make(chan int, 10)
-	
+
 	// Worker
 	worker := func() {
 		for job := range work {
 			wg6.Add(1)
 			go func(j int) {
 				defer wg6.Done()
-				time.Sleep(50 * time.Millisecond)
+				clock.Sleep(50 * time.Millisecond)
 				fmt.Printf("Processed job %d\n", j)
 			}(job)
 		}
 	}
-	
+
 	// Start 3 workers
 	for i := 0; i < 3; i++ {
 		go worker()
 	}
-	
+
 	// Send work
 	for j := 1; j <= 9; j++ {
 		work <- j
 	}
 	close(work)
-	
+
 	wg6.Wait()
 	fmt.Println("All jobs processed")
+}
 
-	// 8. WaitGroup with pipeline
+// 8. WaitGroup with pipeline
+func waitgroupWithPipeline() {
 	fmt.Println("\n8. WaitGroup with pipeline:")
 	var wg7 sync.WaitGroup
-	
+
 	stage1 := make(chan int, 10)
 	stage2 := make(chan int, 10)
 	stage3 := make(chan int, 10)
-	
+
 	// Stage 1
 	wg7.Add(1)
 	go func() {
@@ -214,7 +280,7 @@ func main() {
 			fmt.Printf("Stage 1 produced: %d\n", i)
 		}
 	}()
-	
+
 	// Stage 2
 	wg7.Add(1)
 	go func() {
@@ -226,7 +292,7 @@ func main() {
 			fmt.Printf("Stage 2 processed: %d -> %d\n", num, result)
 		}
 	}()
-	
+
 	// Stage 3
 	wg7.Add(1)
 	go func() {
@@ -238,60 +304,64 @@ func main() {
 			fmt.Printf("Stage 3 processed: %d -> %d\n", num, result)
 		}
 	}()
-	
+
 	// Wait for all stages and collect results
 	go func() {
 		wg7.Wait()
 	}()
-	
+
 	for result := range stage3 {
 		fmt.Printf("Final result: %d\n", result)
 	}
+}
 
-	// 9. WaitGroup with resource pool
+// 9. WaitGroup with resource pool
+func waitgroupWithResourcePool() {
 	fmt.Println("\n9. WaitGroup with resource pool:")
 	var wg8 sync.WaitGroup
 	resources := make(chan string, 3)
-	
+
 	// Initialize resources
 	go func() {
 		resources <- "resource-1"
 		resources <- "resource-2"
 		resources <- "resource-3"
 	}()
-	
+
 	// Use resources
 	for i := 1; i <= 5; i++ {
 		wg8.Add(1)
 		go func(id int) {
 			defer wg8.Done()
-			
+
 			// Acquire resource
 			resource := <-resources
 			fmt.Printf("Goroutine %d acquired %s\n", id, resource)
-			
-			time.Sleep(50 * time.Millisecond)
-			
+
+			clock.Sleep(50 * time.Millisecond)
+
 			// Release resource
 			resources <- resource
 			fmt.Printf("Goroutine %d released %s\n", id, resource)
 		}(i)
 	}
-	
+
 	wg8.Wait()
 	fmt.Println("All resource operations completed")
+}
 
-	// 10. WaitGroup with fan-out/fan-in
+// 10. WaitGroup with fan-out/fan-in
+func waitgroupWithFanOutFanIn() {
 	fmt.Println("\n10. WaitGroup with fan-out/fan-in:")
 	var wg9 sync.WaitGroup
-	
+
 	input := make(chan int, 20)
 	outputs := []chan int{
 		make(chan int, 10),
 		make(chan int, 10),
 		make(chan int, 10),
 	}
-	
+
 	// Distributor
 	wg9.Add(1)
 	go func() {
@@ -301,7 +371,7 @@ func main() {
 			input <- i
 		}
 	}()
-	
+
 	// Workers (fan-out)
 	for i, output := range outputs {
 		wg9.Add(1)
@@ -315,13 +385,13 @@ func main() {
 			}
 		}(i, output)
 	}
-	
+
 	// Collector (fan-in)
 	wg9.Add(1)
 	go func() {
 		defer wg9.Done()
 		var wgCollect sync.WaitGroup
-		
+
 		for i, output := range outputs {
 			wgCollect.Add(1)
 			go func(id int, ch <-chan int) {
@@ -331,57 +401,61 @@ func main() {
 				}
 			}(i, output)
 		}
-		
+
 		wgCollect.Wait()
 	}()
-	
+
 	wg9.Wait()
 	fmt.Println("Fan-out/fan-in completed")
+}
 
-	// 11. WaitGroup with batch processing
+// 11. WaitGroup with batch processing
+func waitgroupWithBatchProcessing() {
 	fmt.Println("\n11. WaitGroup with batch processing:")
 	var wg10 sync.WaitGroup
-	
+
 	batches := [][]int{
 		{1, 2, 3},
 		{4, 5},
 		{6, 7, 8, 9},
 	}
-	
+
 	for i, batch := range batches {
 		wg10.Add(1)
 		go func(batchID int, b []int) {
 			defer wg10.Done()
 			fmt.Printf("Processing batch %d: %v\n", batchID+1, b)
-			
+
 			sum := 0
 			for _, num := range b {
 				sum += num
 			}
-			
-			time.Sleep(100 * time.Millisecond)
+
+			clock.Sleep(100 * time.Millisecond)
 			fmt.Printf("Batch %d sum: %d\n", batchID+1, sum)
 		}(i, batch)
 	}
-	
+
 	wg10.Wait()
 	fmt.Println("All batches processed")
+}
 
-	// 12. WaitGroup with progress tracking
+// 12. WaitGroup with progress tracking
+func waitgroupWithProgressTracking() {
 	fmt.Println("\n12. WaitGroup with progress tracking:")
 	var wg11 sync.WaitGroup
 	totalTasks := 10
 	completed := make(chan int, totalTasks)
-	
+
 	for i := 1; i <= totalTasks; i++ {
 		wg11.Add(1)
 		go func(id int) {
 			defer wg11.Done()
-			time.Sleep(time.Duration(id*50) * time.Millisecond)
+			clock.Sleep(time.Duration(id*50) * time.Millisecond)
 			completed <- id
 		}(i)
 	}
-	
+
 	// Progress tracker
 	go func() {
 		count := 0
@@ -391,15 +465,17 @@ func main() {
 			fmt.Printf("Task %d completed (%.1f%%)\n", id, percentage)
 		}
 	}()
-	
+
 	wg11.Wait()
 	fmt.Println("All tasks completed")
+}
 
-	// 13. WaitGroup with cancellation
+// 13. WaitGroup with cancellation
+func waitgroupWithCancellation() {
 	fmt.Println("\n13. WaitGroup with cancellation:")
 	var wg12 sync.WaitGroup
 	stop := make(chan struct{})
-	
+
 	for i := 1; i <= 5; i++ {
 		wg12.Add(1)
 		go func(id int) {
@@ -411,34 +487,36 @@ func main() {
 					return
 				default:
 					fmt.Printf("Goroutine %d working on step %d\n", id, j)
-					time.Sleep(50 * time.Millisecond)
+					clock.Sleep(50 * time.Millisecond)
 				}
 			}
 		}(i)
 	}
-	
+
 	// Stop after 300ms
-	time.Sleep(300 * time.Millisecond)
+	clock.Sleep(300 * time.Millisecond)
 	close(stop)
-	
+
 	wg12.Wait()
 	fmt.Println("All goroutines stopped")
+}
 
-	// 14. WaitGroup with retry mechanism
+// 14. WaitGroup with retry mechanism
+func waitgroupWithRetryMechanism() {
 	fmt.Println("\n14. WaitGroup with retry mechanism:")
 	var wg13 sync.WaitGroup
-	results := make(chan string, 15)
-	
+	retryResults := make(chan string, 15)
+
 	for i := 1; i <= 5; i++ {
 		wg13.Add(1)
 		go func(id int) {
 			defer wg13.Done()
-			
+
 			var err error
 			for attempt := 1; attempt <= 3; attempt++ {
 				// Simulate work
-				time.Sleep(30 * time.Millisecond)
-				
+				clock.Sleep(30 * time.Millisecond)
+
 				// Simulate success on attempt 2 or 3
 				if attempt >= 2 {
 					err = nil
@@ -446,48 +524,48 @@ func main() {
 				}
 				err = fmt.Errorf("attempt %d failed", attempt)
 			}
-			
+
 			if err != nil {
-				results <- fmt.Sprintf("Task %d failed: %v", id, err)
+				retryResults <- fmt.Sprintf("Task %d failed: %v", id, err)
 			} else {
-				results <- fmt.Sprintf("Task %d succeeded", id)
+				retryResults <- fmt.Sprintf("Task %d succeeded", id)
 			}
 		}(i)
 	}
-	
+
 	// Wait and collect
 	go func() {
 		wg13.Wait()
-		close(results)
+		close(retryResults)
 	}()
-	
-	for result := range results {
+
+	for result := range retryResults {
 		fmt.Printf("Result: %s\n", result)
 	}
+}
 
-	// 15. WaitGroup with resource cleanup
+// 15. WaitGroup with resource cleanup
+func waitgroupWithResourceCleanup() {
 	fmt.Println("\n15. WaitGroup with resource cleanup:")
 	var wg14 sync.WaitGroup
-	
+
 	for i := 1; i <= 3; i++ {
 		wg14.Add(1)
 		go func(id int) {
 			defer wg14.Done()
-			
+
 			// Acquire resource
 			resource := fmt.Sprintf("resource-%d", id)
 			fmt.Printf("Goroutine %d acquired %s\n", id, resource)
-			
+
 			// Simulate work
-			time.Sleep(100 * time.Millisecond)
-			
+			clock.Sleep(100 * time.Millisecond)
+
 			// Cleanup
 			fmt.Printf("Goroutine %d cleaning up %s\n", id, resource)
 		}(i)
 	}
-	
+
 	wg14.Wait()
 	fmt.Println("All resources cleaned up")
-
-	fmt.Println("All WaitGroup examples completed!")
 }
@@ -1,80 +1,130 @@
-package main
+package workerpools
 
 import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/saqib77official/go-by-example/examples"
+	"github.com/saqib77official/go-by-example/internal/clock"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "worker-pools",
+		Category:    examples.Expert,
+		Description: "Worker Pools",
+		Icon:        "👥",
+		Run:         Run,
+		Sections: []examples.Section{
+			{Number: 1, Title: "Basic worker pool", Run: basicWorkerPool},
+			{Number: 2, Title: "Worker pool with WaitGroup", Run: workerPoolWithWaitGroup},
+			{Number: 3, Title: "Dynamic worker pool", Run: dynamicWorkerPool},
+			{Number: 4, Title: "Worker pool with timeout", Run: workerPoolWithTimeout},
+			{Number: 5, Title: "Worker pool with load balancing", Run: workerPoolWithLoadBalancing},
+			{Number: 6, Title: "Worker pool with priority queue", Run: workerPoolWithPriorityQueue},
+			{Number: 7, Title: "Worker pool with retry mechanism", Run: workerPoolWithRetryMechanism},
+			{Number: 8, Title: "Worker pool with graceful shutdown", Run: workerPoolWithGracefulShutdown},
+			{Number: 9, Title: "Worker pool with statistics", Run: workerPoolWithStatistics},
+			{Number: 10, Title: "Worker pool with batch processing", Run: workerPoolWithBatchProcessing},
+			{Number: 11, Title: "Worker pool with backpressure", Run: workerPoolWithBackpressure},
+			{Number: 12, Title: "Worker pool with circuit breaker", Run: workerPoolWithCircuitBreaker},
+		},
+	})
+}
+
+func Run() {
 	fmt.Println("=== Worker Pools Examples ===")
 
-	// 1. Basic worker pool
+	basicWorkerPool()
+	workerPoolWithWaitGroup()
+	dynamicWorkerPool()
+	workerPoolWithTimeout()
+	workerPoolWithLoadBalancing()
+	workerPoolWithPriorityQueue()
+	workerPoolWithRetryMechanism()
+	workerPoolWithGracefulShutdown()
+	workerPoolWithStatistics()
+	workerPoolWithBatchProcessing()
+	workerPoolWithBackpressure()
+	workerPoolWithCircuitBreaker()
+
+	fmt.Println("All worker pool examples completed!")
+}
+
+// 1. Basic worker pool
+func basicWorkerPool() {
 	fmt.Println("\n1. Basic worker pool:")
+	// readme:begin
 	jobs := make(chan int, 10)
 	results := make(chan int, 10)
-	
+
 	// Start workers
 	for w := 1; w <= 3; w++ {
 		go worker(w, jobs, results)
 	}
-	
+	// readme:end
+
 	// Send jobs
 	for j := 1; j <= 5; j++ {
 		jobs <- j
 		fmt.Printf("Sent job %d\n", j)
 	}
 	close(jobs)
-	
+
 	// Collect results
 	for a := 1; a <= 5; a++ {
 		result := <-results
 		fmt.Printf("Received result %d\n", result)
 	}
+}
 
-	// 2. Worker pool with WaitGroup
+// 2. Worker pool with WaitGroup
+func workerPoolWithWaitGroup() {
 	fmt.Println("\n2. Worker pool with WaitGroup:")
 	var wg sync.WaitGroup
 	jobs2 := make(chan int, 20)
 	results2 := make(chan int, 20)
-	
+
 	// Start workers
 	for w := 1; w <= 4; w++ {
 		wg.Add(1)
 		go workerWithWaitGroup(w, jobs2, results2, &wg)
 	}
-	
+
 	// Send jobs
 	for j := 1; j <= 8; j++ {
 		jobs2 <- j
 	}
 	close(jobs2)
-	
+
 	// Start result collector
 	go func() {
 		wg.Wait()
 		close(results2)
 	}()
-	
+
 	// Collect results
 	for result := range results2 {
 		fmt.Printf("Result: %d\n", result)
 	}
+}
 
-	// 3. Dynamic worker pool
+// 3. Dynamic worker pool
+func dynamicWorkerPool() {
 	fmt.Println("\n3. Dynamic worker pool:")
-	
+
 	dynamicPool := func(numWorkers, numJobs int) {
 		jobs := make(chan int, numJobs)
 		results := make(chan int, numJobs)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for i := 1; i <= numWorkers; i++ {
 			wg.Add(1)
 			go workerWithWaitGroup(i, jobs, results, &wg)
 		}
-		
+
 		// Send jobs
 		go func() {
 			defer close(jobs)
@@ -82,66 +132,70 @@ func main() {
 				jobs <- j
 			}
 		}()
-		
+
 		// Wait for completion
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		// Collect results
 		var allResults []int
 		for result := range results {
 			allResults = append(allResults, result)
 		}
-		
+
 		fmt.Printf("Processed %d jobs with %d workers\n", len(allResults), numWorkers)
 		fmt.Printf("Results: %v\n", allResults)
 	}
-	
+
 	dynamicPool(3, 6)
+}
 
-	// 4. Worker pool with timeout
+// 4. Worker pool with timeout
+func workerPoolWithTimeout() {
 	fmt.Println("\n4. Worker pool with timeout:")
-	
+
 	poolWithTimeout := func() {
 		jobs := make(chan int, 10)
 		results := make(chan string, 10)
-		
+
 		// Start workers with timeout
 		for w := 1; w <= 3; w++ {
 			go timeoutWorker(w, jobs, results)
 		}
-		
+
 		// Send jobs
 		for j := 1; j <= 5; j++ {
 			jobs <- j
 		}
 		close(jobs)
-		
+
 		// Collect results
 		for i := 0; i < 5; i++ {
 			result := <-results
 			fmt.Printf("Result: %s\n", result)
 		}
 	}
-	
+
 	poolWithTimeout()
+}
 
-	// 5. Worker pool with load balancing
+// 5. Worker pool with load balancing
+func workerPoolWithLoadBalancing() {
 	fmt.Println("\n5. Worker pool with load balancing:")
-	
+
 	loadBalancedPool := func() {
 		jobs := make(chan int, 20)
 		results := make(chan string, 20)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 4; w++ {
 			wg.Add(1)
 			go loadBalancedWorker(w, jobs, results, &wg)
 		}
-		
+
 		// Send jobs with different complexities
 		go func() {
 			defer close(jobs)
@@ -150,39 +204,36 @@ func main() {
 				jobs <- complexity
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		for result := range results {
 			fmt.Printf("Completed: %s\n", result)
 		}
 	}
-	
+
 	loadBalancedPool()
+}
 
-	// 6. Worker pool with priority queue
+// 6. Worker pool with priority queue
+func workerPoolWithPriorityQueue() {
 	fmt.Println("\n6. Worker pool with priority queue:")
-	
+
 	priorityPool := func() {
-		type Job struct {
-			priority int
-			id       int
-		}
-		
 		jobs := make(chan Job, 20)
 		results := make(chan string, 20)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 2; w++ {
 			wg.Add(1)
 			go priorityWorker(w, jobs, results, &wg)
 		}
-		
+
 		// Send priority jobs
 		go func() {
 			defer close(jobs)
@@ -193,39 +244,41 @@ func main() {
 				{priority: 3, id: 4},
 				{priority: 1, id: 5},
 			}
-			
+
 			for _, job := range priorityJobs {
 				jobs <- job
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		for result := range results {
 			fmt.Printf("Completed: %s\n", result)
 		}
 	}
-	
+
 	priorityPool()
+}
 
-	// 7. Worker pool with retry mechanism
+// 7. Worker pool with retry mechanism
+func workerPoolWithRetryMechanism() {
 	fmt.Println("\n7. Worker pool with retry mechanism:")
-	
+
 	retryPool := func() {
 		jobs := make(chan int, 20)
 		results := make(chan string, 20)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 2; w++ {
 			wg.Add(1)
 			go retryWorker(w, jobs, results, &wg)
 		}
-		
+
 		// Send jobs
 		go func() {
 			defer close(jobs)
@@ -233,34 +286,36 @@ func main() {
 				jobs <- j
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		for result := range results {
 			fmt.Printf("Result: %s\n", result)
 		}
 	}
-	
+
 	retryPool()
+}
 
-	// 8. Worker pool with graceful shutdown
+// 8. Worker pool with graceful shutdown
+func workerPoolWithGracefulShutdown() {
 	fmt.Println("\n8. Worker pool with graceful shutdown:")
-	
+
 	gracefulPool := func() {
 		jobs := make(chan int, 20)
 		results := make(chan string, 20)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 3; w++ {
 			wg.Add(1)
 			go gracefulWorker(w, jobs, results, &wg)
 		}
-		
+
 		// Send jobs
 		go func() {
 			defer close(jobs)
@@ -268,39 +323,36 @@ func main() {
 				jobs <- j
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		for result := range results {
 			fmt.Printf("Result: %s\n", result)
 		}
 	}
-	
+
 	gracefulPool()
+}
 
-	// 9. Worker pool with statistics
+// 9. Worker pool with statistics
+func workerPoolWithStatistics() {
 	fmt.Println("\n9. Worker pool with statistics:")
-	
+
 	poolWithStats := func() {
-		type Stats struct {
-			jobsProcessed int
-			totalTime     time.Duration
-		}
-		
 		jobs := make(chan int, 20)
 		results := make(chan Stats, 20)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 3; w++ {
 			wg.Add(1)
 			go statsWorker(w, jobs, results, &wg)
 		}
-		
+
 		// Send jobs
 		go func() {
 			defer close(jobs)
@@ -308,22 +360,22 @@ func main() {
 				jobs <- j
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		// Aggregate statistics
 		var totalJobs int
 		var totalTime time.Duration
-		
+
 		for stat := range results {
 			totalJobs += stat.jobsProcessed
 			totalTime += stat.totalTime
 		}
-		
+
 		fmt.Printf("Total jobs processed: %d\n", totalJobs)
 		fmt.Printf("Total time: %v\n", totalTime)
 		if totalJobs > 0 {
@@ -331,23 +383,25 @@ func main() {
 			fmt.Printf("Average time per job: %v\n", avgTime)
 		}
 	}
-	
+
 	poolWithStats()
+}
 
-	// 10. Worker pool with batch processing
+// 10. Worker pool with batch processing
+func workerPoolWithBatchProcessing() {
 	fmt.Println("\n10. Worker pool with batch processing:")
-	
+
 	batchPool := func() {
 		jobs := make(chan []int, 10)
 		results := make(chan string, 10)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 2; w++ {
 			wg.Add(1)
 			go batchWorker(w, jobs, results, &wg)
 		}
-		
+
 		// Send batch jobs
 		go func() {
 			defer close(jobs)
@@ -356,39 +410,41 @@ func main() {
 				{4, 5},
 				{6, 7, 8, 9},
 			}
-			
+
 			for _, batch := range batches {
 				jobs <- batch
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		for result := range results {
 			fmt.Printf("Batch result: %s\n", result)
 		}
 	}
-	
+
 	batchPool()
+}
 
-	// 11. Worker pool with backpressure
+// 11. Worker pool with backpressure
+func workerPoolWithBackpressure() {
 	fmt.Println("\n11. Worker pool with backpressure:")
-	
+
 	backpressurePool := func() {
 		jobs := make(chan int, 5) // Small buffer for backpressure
 		results := make(chan string, 20)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 2; w++ {
 			wg.Add(1)
 			go backpressureWorker(w, jobs, results, &wg)
 		}
-		
+
 		// Send jobs (may block due to backpressure)
 		go func() {
 			defer close(jobs)
@@ -396,47 +452,43 @@ func main() {
 				select {
 				case jobs <- j:
 					fmt.Printf("Sent job %d\n", j)
-				case <-time.After(100 * time.Millisecond):
+				case <-clock.After(100 * time.Millisecond):
 					fmt.Printf("Job %d dropped (backpressure)\n", j)
 				}
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		for result := range results {
 			fmt.Printf("Result: %s\n", result)
 		}
 	}
-	
+
 	backpressurePool()
+}
 
-	// 12. Worker pool with circuit breaker
+// 12. Worker pool with circuit breaker
+func workerPoolWithCircuitBreaker() {
 	fmt.Println("\n12. Worker pool with circuit breaker:")
-	
+
 	circuitBreakerPool := func() {
-		type CircuitBreaker struct {
-			failures    int
-			maxFailures int
-			open        bool
-		}
-		
 		cb := &CircuitBreaker{maxFailures: 3}
-		
+
 		jobs := make(chan int, 20)
 		results := make(chan string, 20)
 		var wg sync.WaitGroup
-		
+
 		// Start workers
 		for w := 1; w <= 2; w++ {
 			wg.Add(1)
 			go circuitBreakerWorker(w, jobs, results, &wg, cb)
 		}
-		
+
 		// Send jobs
 		go func() {
 			defer close(jobs)
@@ -444,21 +496,19 @@ func main() {
 				jobs <- j
 			}
 		}()
-		
+
 		// Wait and collect
 		go func() {
 			wg.Wait()
 			close(results)
 		}()
-		
+
 		for result := range results {
 			fmt.Printf("Result: %s\n", result)
 		}
 	}
-	
-	circuitBreakerPool()
 
-	fmt.Println("All worker pool examples completed!")
+	circuitBreakerPool()
 }
 
 // Worker functions
@@ -466,7 +516,7 @@ func main() {
 func worker(id int, jobs <-chan int, results chan<- int) {
 	for j := range jobs {
 		fmt.Printf("Worker %d processing job %d\n", id, j)
-		time.Sleep(100 * time.Millisecond) // Simulate work
+		clock.Sleep(100 * time.Millisecond) // Simulate work
 		results <- j * 2
 	}
 }
@@ -475,7 +525,7 @@ func workerWithWaitGroup(id int, jobs <-chan int, results chan<- int, wg *sync.W
 	defer wg.Done()
 	for j := range jobs {
 		fmt.Printf("Worker %d processing job %d\n", id, j)
-		time.Sleep(50 * time.Millisecond)
+		clock.Sleep(50 * time.Millisecond)
 		results <- j * 3
 	}
 }
@@ -483,10 +533,10 @@ func workerWithWaitGroup(id int, jobs <-chan int, results chan<- int, wg *sync.W
 func timeoutWorker(id int, jobs <-chan int, results chan<- string) {
 	for j := range jobs {
 		fmt.Printf("Worker %d processing job %d\n", id, j)
-		
+
 		// Simulate work with potential timeout
-		time.Sleep(time.Duration(j*50) * time.Millisecond)
-		
+		clock.Sleep(time.Duration(j*50) * time.Millisecond)
+
 		if j > 3 {
 			results <- fmt.Sprintf("Worker %d: Job %d timed out", id, j)
 		} else {
@@ -498,19 +548,24 @@ func timeoutWorker(id int, jobs <-chan int, results chan<- string) {
 func loadBalancedWorker(id int, jobs <-chan int, results chan<- string, wg *sync.WaitGroup) {
 	defer wg.Done()
 	for j := range jobs {
-		start := time.Now()
+		start := clock.Now()
 		fmt.Printf("Worker %d processing job %d (complexity: %d)\n", id, j, j)
-		time.Sleep(time.Duration(j*100) * time.Millisecond)
-		elapsed := time.Since(start)
+		clock.Sleep(time.Duration(j*100) * time.Millisecond)
+		elapsed := clock.Since(start)
 		results <- fmt.Sprintf("Worker %d: Job %d done in %v", id, j, elapsed)
 	}
 }
 
-func priorityWorker(id int, jobs <-chan struct{priority int; id int}, results chan<- string, wg *sync.WaitGroup) {
+type Job struct {
+	priority int
+	id       int
+}
+
+func priorityWorker(id int, jobs <-chan Job, results chan<- string, wg *sync.WaitGroup) {
 	defer wg.Done()
 	for job := range jobs {
 		fmt.Printf("Worker %d processing job %d (priority: %d)\n", id, job.id, job.priority)
-		time.Sleep(100 * time.Millisecond)
+		clock.Sleep(100 * time.Millisecond)
 		results <- fmt.Sprintf("Worker %d: Job %d (priority %d) completed", id, job.id, job.priority)
 	}
 }
@@ -521,8 +576,8 @@ func retryWorker(id int, jobs <-chan int, results chan<- string, wg *sync.WaitGr
 		var result string
 		for attempt := 1; attempt <= 3; attempt++ {
 			fmt.Printf("Worker %d attempting job %d (attempt %d)\n", id, j, attempt)
-			time.Sleep(50 * time.Millisecond)
-			
+			clock.Sleep(50 * time.Millisecond)
+
 			// Simulate success on attempt 2 or 3
 			if attempt >= 2 {
 				result = fmt.Sprintf("Worker %d: Job %d succeeded on attempt %d", id, j, attempt)
@@ -537,25 +592,30 @@ func gracefulWorker(id int, jobs <-chan int, results chan<- string, wg *sync.Wai
 	defer wg.Done()
 	for j := range jobs {
 		fmt.Printf("Worker %d gracefully processing job %d\n", id, j)
-		time.Sleep(80 * time.Millisecond)
+		clock.Sleep(80 * time.Millisecond)
 		results <- fmt.Sprintf("Worker %d: Job %d completed gracefully", id, j)
 	}
 }
 
-func statsWorker(id int, jobs <-chan int, results chan<- struct{jobsProcessed int; totalTime time.Duration}, wg *sync.WaitGroup) {
+type Stats struct {
+	jobsProcessed int
+	totalTime     time.Duration
+}
+
+func statsWorker(id int, jobs <-chan int, results chan<- Stats, wg *sync.WaitGroup) {
 	defer wg.Done()
-	var stats struct{jobsProcessed int; totalTime time.Duration}
-	
+	var stats Stats
+
 	for j := range jobs {
-		start := time.Now()
+		start := clock.Now()
 		fmt.Printf("Worker %d processing job %d\n", id, j)
-		time.Sleep(time.Duration(j*30) * time.Millisecond)
-		elapsed := time.Since(start)
-		
+		clock.Sleep(time.Duration(j*30) * time.Millisecond)
+		elapsed := clock.Since(start)
+
 		stats.jobsProcessed++
 		stats.totalTime += elapsed
 	}
-	
+
 	results <- stats
 }
 
@@ -563,7 +623,7 @@ func batchWorker(id int, jobs <-chan []int, results chan<- string, wg *sync.Wait
 	defer wg.Done()
 	for batch := range jobs {
 		fmt.Printf("Worker %d processing batch %v\n", id, batch)
-		time.Sleep(150 * time.Millisecond)
+		clock.Sleep(150 * time.Millisecond)
 		sum := 0
 		for _, num := range batch {
 			sum += num
@@ -576,22 +636,28 @@ func backpressureWorker(id int, jobs <-chan int, results chan<- string, wg *sync
 	defer wg.Done()
 	for j := range jobs {
 		fmt.Printf("Worker %d processing job %d\n", id, j)
-		time.Sleep(200 * time.Millisecond) // Slow processing
+		clock.Sleep(200 * time.Millisecond) // Slow processing
 		results <- fmt.Sprintf("Worker %d: Job %d completed", id, j)
 	}
 }
 
-func circuitBreakerWorker(id int, jobs <-chan int, results chan<- string, wg *sync.WaitGroup, cb *struct{failures int; maxFailures int; open bool}) {
+type CircuitBreaker struct {
+	failures    int
+	maxFailures int
+	open        bool
+}
+
+func circuitBreakerWorker(id int, jobs <-chan int, results chan<- string, wg *sync.WaitGroup, cb *CircuitBreaker) {
 	defer wg.Done()
 	for j := range jobs {
 		if cb.open {
 			results <- fmt.Sprintf("Worker %d: Circuit breaker OPEN, job %d rejected", id, j)
 			continue
 		}
-		
+
 		fmt.Printf("Worker %d processing job %d\n", id, j)
-		time.Sleep(50 * time.Millisecond)
-		
+		clock.Sleep(50 * time.Millisecond)
+
 		// Simulate failure for jobs 4, 5, 6
 		if j >= 4 && j <= 6 {
 			cb.failures++
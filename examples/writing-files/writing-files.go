@@ -1,17 +1,31 @@
-package main
+package writingfiles
 
 import (
 	"bufio"
 	"fmt"
 	"os"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "writing-files",
+		Category:    examples.Practical,
+		Description: "Writing Files",
+		Icon:        "✍️",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== Writing Files ===")
 
 	// Write entire file
+	// readme:begin
 	content := "Hello, World!\nThis is a test file."
 	err := os.WriteFile("output.txt", []byte(content), 0644)
+	// readme:end
 	if err != nil {
 		panic(err)
 	}
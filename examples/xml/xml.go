@@ -1,8 +1,10 @@
-package main
+package xml
 
 import (
 	"encoding/xml"
 	"fmt"
+
+	"github.com/saqib77official/go-by-example/examples"
 )
 
 type Person struct {
@@ -12,7 +14,17 @@ type Person struct {
 	Email   string   `xml:"email"`
 }
 
-func main() {
+func init() {
+	examples.Register(examples.Example{
+		Name:        "xml",
+		Category:    examples.Practical,
+		Description: "XML Operations",
+		Icon:        "🏷️",
+		Run:         Run,
+	})
+}
+
+func Run() {
 	fmt.Println("=== XML ===")
 
 	// Marshal (Go to XML)
@@ -37,8 +49,10 @@ func main() {
 
 	// Unmarshal (XML to Go)
 	xmlStr := `<person><name>Bob</name><age>30</age><email>bob@example.com</email></person>`
+	// readme:begin
 	var decoded Person
 	err = xml.Unmarshal([]byte(xmlStr), &decoded)
+	// readme:end
 	if err != nil {
 		panic(err)
 	}
@@ -46,9 +60,9 @@ func main() {
 
 	// Working with nested structures
 	type Company struct {
-		XMLName xml.Name  `xml:"company"`
-		Name    string    `xml:"name"`
-		People []Person  `xml:"people>person"`
+		XMLName xml.Name `xml:"company"`
+		Name    string   `xml:"name"`
+		People  []Person `xml:"people>person"`
 	}
 
 	company := Company{
@@ -0,0 +1,3 @@
+module github.com/saqib77official/go-by-example
+
+go 1.23
@@ -0,0 +1,271 @@
+// Package certs gives the server examples a TLS configuration, either from
+// a certificate and key on disk or from a throwaway certificate authority
+// generated in memory, so TLS and mutual TLS can be tried out without
+// openssl or any other tooling.
+package certs
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// validFor is how long generated certificates last. They are made afresh
+// on every run, so a day is plenty.
+const validFor = 24 * time.Hour
+
+// Config says how a server gets its certificate and whether it asks
+// clients for theirs.
+type Config struct {
+	Enabled      bool   // serve TLS at all
+	CertFile     string // PEM certificate chain; generated if empty
+	KeyFile      string // PEM private key for CertFile
+	ClientCAFile string // PEM CAs that sign client certificates; the generated CA if empty
+	MutualTLS    bool   // require a client certificate signed by a trusted CA
+}
+
+// RegisterFlags adds --tls, --cert, --key, --client-ca and --mtls to fs.
+// Giving any of the others implies --tls.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "tls", false, "Serve TLS, with a generated certificate unless --cert and --key are given")
+	fs.StringVar(&c.CertFile, "cert", "", "PEM certificate file for TLS")
+	fs.StringVar(&c.KeyFile, "key", "", "PEM private key file for TLS")
+	fs.StringVar(&c.ClientCAFile, "client-ca", "", "PEM file of CAs trusted to sign client certificates")
+	fs.BoolVar(&c.MutualTLS, "mtls", false, "Require clients to present a certificate (mutual TLS)")
+}
+
+// Generated lists the PEM files written for a generated certificate, so
+// clients can be pointed at them. ClientCert and ClientKey are only set
+// for mutual TLS. The files include private keys, so call Remove once the
+// server has stopped.
+type Generated struct {
+	Dir        string
+	CA         string
+	ClientCert string
+	ClientKey  string
+}
+
+// Remove deletes the generated files. It does nothing on a nil Generated,
+// so it can be deferred whether or not anything was generated.
+func (g *Generated) Remove() error {
+	if g == nil {
+		return nil
+	}
+	return os.RemoveAll(g.Dir)
+}
+
+// ServerConfig builds the TLS configuration described by c, or returns nil
+// if TLS is not enabled. If it had to generate certificates, the CA (and,
+// for mutual TLS, a client certificate) are written to a new temporary
+// directory and described by the returned Generated, which the caller
+// removes when done.
+func (c *Config) ServerConfig(hosts ...string) (*tls.Config, *Generated, error) {
+	if !c.Enabled && c.CertFile == "" && c.KeyFile == "" && c.ClientCAFile == "" && !c.MutualTLS {
+		return nil, nil, nil
+	}
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return nil, nil, errors.New("--cert and --key must be given together")
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	var ca *Authority
+	var gen *Generated
+
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	} else {
+		var err error
+		if ca, err = NewAuthority("Go by Example Test CA"); err != nil {
+			return nil, nil, err
+		}
+		cert, err := ca.Issue("localhost", hosts, x509.ExtKeyUsageServerAuth)
+		if err != nil {
+			return nil, nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	// A client certificate is optional but checked if sent, unless mutual
+	// TLS makes it required
+	if c.ClientCAFile != "" {
+		pemCerts, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		cfg.ClientCAs = x509.NewCertPool()
+		if !cfg.ClientCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, nil, fmt.Errorf("no certificates found in %s", c.ClientCAFile)
+		}
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	if c.MutualTLS {
+		if cfg.ClientCAs == nil {
+			if ca == nil {
+				return nil, nil, errors.New("--mtls with --cert needs --client-ca to verify clients")
+			}
+			cfg.ClientCAs = x509.NewCertPool()
+			cfg.ClientCAs.AddCert(ca.Cert)
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	if ca != nil {
+		var err error
+		if gen, err = ca.writeFiles(c.MutualTLS); err != nil {
+			return nil, nil, err
+		}
+	}
+	return cfg, gen, nil
+}
+
+// Authority is a self-signed certificate authority that issues leaf
+// certificates.
+type Authority struct {
+	Cert *x509.Certificate
+	Key  crypto.Signer
+}
+
+// NewAuthority generates a CA certificate and key.
+func NewAuthority(name string) (*Authority, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template, err := newTemplate(name)
+	if err != nil {
+		return nil, err
+	}
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &Authority{Cert: cert, Key: key}, nil
+}
+
+// Issue signs a certificate for commonName. Host names and IP addresses in
+// hosts become its subject alternative names; usage says whether it is for
+// a server or a client.
+func (ca *Authority) Issue(commonName string, hosts []string, usage x509.ExtKeyUsage) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template, err := newTemplate(commonName)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Cert, key.Public(), ca.Key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der, ca.Cert.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+func newTemplate(commonName string) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"Go by Example"}},
+		NotBefore:    now.Add(-time.Minute), // allow for clock skew
+		NotAfter:     now.Add(validFor),
+	}, nil
+}
+
+// writeFiles saves the CA certificate, and with client a client
+// certificate and key, for clients of the server to use.
+func (ca *Authority) writeFiles(client bool) (_ *Generated, err error) {
+	dir, err := os.MkdirTemp("", "gobyexample-tls-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+	gen := &Generated{Dir: dir, CA: filepath.Join(dir, "ca.pem")}
+	if err := writePEM(gen.CA, "CERTIFICATE", ca.Cert.Raw); err != nil {
+		return nil, err
+	}
+	if !client {
+		return gen, nil
+	}
+
+	cert, err := ca.Issue("client", nil, x509.ExtKeyUsageClientAuth)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	gen.ClientCert = filepath.Join(dir, "client.pem")
+	gen.ClientKey = filepath.Join(dir, "client-key.pem")
+	if err := writePEM(gen.ClientCert, "CERTIFICATE", cert.Certificate[0]); err != nil {
+		return nil, err
+	}
+	if err := writePEM(gen.ClientKey, "PRIVATE KEY", keyDER); err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
+
+func writePEM(path, blockType string, der []byte) error {
+	return os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+}
+
+// PeerSubject describes the verified client certificate of a connection,
+// or returns "" if the client did not send one.
+func PeerSubject(state *tls.ConnectionState) string {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return ""
+	}
+	return state.PeerCertificates[0].Subject.String()
+}
@@ -0,0 +1,102 @@
+// Package clock lets examples that wait on time run against either the
+// real clock or a fake one.
+//
+// Examples call the package-level functions (clock.Now, clock.Sleep,
+// clock.NewTimer and so on) in place of their time package namesakes, and
+// clock.WithTimeout and clock.WithDeadline in place of the context ones. They
+// use Default, which is the real clock unless the runner was started with
+// --fake-clock. Under the fake clock every wait completes as soon as the
+// program has nothing else to do, so an example that sleeps for seconds
+// finishes instantly and prints the same times on every run.
+package clock
+
+import "time"
+
+// Clock is the part of the time package the examples depend on.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	NewTimer(d time.Duration) *Timer
+	NewTicker(d time.Duration) *Ticker
+	AfterFunc(d time.Duration, f func()) *Timer
+}
+
+// Default is the clock used by the package-level functions. Replace it
+// before any example starts, not while one is running.
+var Default Clock = Real{}
+
+// Timer mirrors time.Timer: a single event delivered on C, unless the
+// timer was created by AfterFunc.
+type Timer struct {
+	C     <-chan time.Time
+	stop  func() bool
+	reset func(d time.Duration) bool
+}
+
+// Stop prevents the timer from firing. It reports whether the call stopped
+// the timer, as opposed to it having already fired or been stopped.
+func (t *Timer) Stop() bool { return t.stop() }
+
+// Reset changes the timer to fire after d. It reports whether the timer
+// had been active.
+func (t *Timer) Reset(d time.Duration) bool { return t.reset(d) }
+
+// Ticker mirrors time.Ticker: ticks delivered on C every period.
+type Ticker struct {
+	C     <-chan time.Time
+	stop  func()
+	reset func(d time.Duration)
+}
+
+// Stop turns off the ticker. No more ticks are sent.
+func (t *Ticker) Stop() { t.stop() }
+
+// Reset stops the ticker and restarts it with period d.
+func (t *Ticker) Reset(d time.Duration) { t.reset(d) }
+
+// Real is the Clock backed by the time package.
+type Real struct{}
+
+func (Real) Now() time.Time { return time.Now() }
+
+func (Real) Sleep(d time.Duration) { time.Sleep(d) }
+
+func (Real) NewTimer(d time.Duration) *Timer {
+	t := time.NewTimer(d)
+	return &Timer{C: t.C, stop: t.Stop, reset: t.Reset}
+}
+
+func (Real) NewTicker(d time.Duration) *Ticker {
+	t := time.NewTicker(d)
+	return &Ticker{C: t.C, stop: t.Stop, reset: t.Reset}
+}
+
+func (Real) AfterFunc(d time.Duration, f func()) *Timer {
+	t := time.AfterFunc(d, f)
+	return &Timer{stop: t.Stop, reset: t.Reset}
+}
+
+// Now returns the current time on Default.
+func Now() time.Time { return Default.Now() }
+
+// Since returns the time elapsed on Default since t.
+func Since(t time.Time) time.Duration { return Default.Now().Sub(t) }
+
+// Until returns the duration on Default until t.
+func Until(t time.Time) time.Duration { return t.Sub(Default.Now()) }
+
+// Sleep pauses the current goroutine for d on Default.
+func Sleep(d time.Duration) { Default.Sleep(d) }
+
+// After waits for d on Default and then sends the current time on the
+// returned channel.
+func After(d time.Duration) <-chan time.Time { return Default.NewTimer(d).C }
+
+// NewTimer creates a Timer on Default that fires after d.
+func NewTimer(d time.Duration) *Timer { return Default.NewTimer(d) }
+
+// NewTicker creates a Ticker on Default that ticks every d.
+func NewTicker(d time.Duration) *Ticker { return Default.NewTicker(d) }
+
+// AfterFunc calls f in its own goroutine after d on Default.
+func AfterFunc(d time.Duration, f func()) *Timer { return Default.AfterFunc(d, f) }
@@ -0,0 +1,57 @@
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithTimeout is context.WithTimeout with the timeout measured on Default.
+func WithTimeout(parent context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	return WithDeadline(parent, Now().Add(d))
+}
+
+// WithDeadline is context.WithDeadline with the deadline kept on Default.
+// The context's Err is context.DeadlineExceeded once Default reaches
+// deadline, as it would be for one made by the context package.
+func WithDeadline(parent context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	c := &deadlineCtx{Context: parent, deadline: deadline, done: make(chan struct{})}
+	timer := AfterFunc(Until(deadline), func() { c.cancel(context.DeadlineExceeded) })
+	stopParent := context.AfterFunc(parent, func() { c.cancel(parent.Err()) })
+	return c, func() {
+		timer.Stop()
+		stopParent()
+		c.cancel(context.Canceled)
+	}
+}
+
+// deadlineCtx is a context cancelled by a timer on Default rather than the
+// runtime's own timers, which would not follow a fake clock.
+type deadlineCtx struct {
+	context.Context // the parent, for Value
+	deadline        time.Time
+	done            chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func (c *deadlineCtx) Deadline() (time.Time, bool) { return c.deadline, true }
+
+func (c *deadlineCtx) Done() <-chan struct{} { return c.done }
+
+func (c *deadlineCtx) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// cancel ends the context with err, unless it has already ended.
+func (c *deadlineCtx) cancel(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = err
+		close(c.done)
+	}
+}
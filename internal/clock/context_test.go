@@ -0,0 +1,53 @@
+package clock
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	fake := NewFake(Epoch)
+	defer func(c Clock) { Default = c }(Default)
+	Default = fake
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		cancel  bool
+		parent  bool // cancel the parent instead
+		wantErr error
+	}{
+		{"before the deadline", 999 * time.Millisecond, false, false, nil},
+		{"at the deadline", time.Second, false, false, context.DeadlineExceeded},
+		{"cancelled", 0, true, false, context.Canceled},
+		{"parent cancelled", 0, false, true, context.Canceled},
+	}
+	for _, tt := range tests {
+		parent, cancelParent := context.WithCancel(context.Background())
+		ctx, cancel := WithTimeout(parent, time.Second)
+		if d, ok := ctx.Deadline(); !ok || !d.Equal(fake.Now().Add(time.Second)) {
+			t.Errorf("%s: Deadline() = %v, %t", tt.name, d, ok)
+		}
+
+		fake.Advance(tt.advance)
+		if tt.cancel {
+			cancel()
+		}
+		if tt.parent {
+			cancelParent()
+		}
+		if tt.wantErr != nil {
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+				t.Errorf("%s: context not done", tt.name)
+			}
+		}
+		if err := ctx.Err(); err != tt.wantErr {
+			t.Errorf("%s: Err() = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		cancel()
+		cancelParent()
+	}
+}
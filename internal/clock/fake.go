@@ -0,0 +1,247 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Epoch is where a Fake clock starts: the time shown by the Go playground.
+var Epoch = time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)
+
+// idleWait is how long AutoAdvance waits for the program to stop using the
+// clock before moving it on.
+const idleWait = time.Millisecond
+
+// Fake is a Clock that only moves when it is advanced. Timers, tickers and
+// sleepers wake up in deadline order as Advance passes their deadlines.
+type Fake struct {
+	mu       sync.Mutex
+	now      time.Time
+	waiters  []*waiter
+	seq      int // orders waiters with the same deadline
+	activity int // counts calls, so AutoAdvance can tell when it is idle
+}
+
+// waiter is a pending timer, ticker or sleeper.
+type waiter struct {
+	when   time.Time
+	seq    int
+	period time.Duration // non-zero for tickers
+	fire   func(now time.Time)
+}
+
+// NewFake returns a Fake clock set to start.
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start}
+}
+
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.activity++
+	return f.now
+}
+
+func (f *Fake) Sleep(d time.Duration) {
+	if d <= 0 {
+		f.Now()
+		return
+	}
+	done := make(chan struct{})
+	f.mu.Lock()
+	f.add(&waiter{when: f.now.Add(d), fire: func(time.Time) { close(done) }})
+	f.mu.Unlock()
+	<-done
+}
+
+func (f *Fake) NewTimer(d time.Duration) *Timer {
+	c := make(chan time.Time, 1)
+	w := &waiter{fire: func(now time.Time) { send(c, now) }}
+	f.schedule(w, d)
+	return &Timer{
+		C:     c,
+		stop:  func() bool { drain(c); return f.cancel(w) },
+		reset: func(d time.Duration) bool { drain(c); return f.schedule(w, d) },
+	}
+}
+
+func (f *Fake) NewTicker(d time.Duration) *Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	c := make(chan time.Time, 1)
+	w := &waiter{period: d, fire: func(now time.Time) { send(c, now) }}
+	f.schedule(w, d)
+	return &Ticker{
+		C:    c,
+		stop: func() { f.cancel(w); drain(c) },
+		reset: func(d time.Duration) {
+			f.mu.Lock()
+			w.period = d
+			f.mu.Unlock()
+			drain(c)
+			f.schedule(w, d)
+		},
+	}
+}
+
+func (f *Fake) AfterFunc(d time.Duration, fn func()) *Timer {
+	w := &waiter{fire: func(time.Time) { go fn() }}
+	f.schedule(w, d)
+	return &Timer{
+		stop:  func() bool { return f.cancel(w) },
+		reset: func(d time.Duration) bool { return f.schedule(w, d) },
+	}
+}
+
+// Advance moves the clock forward by d, firing everything due on the way.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	target := f.now.Add(d)
+	f.mu.Unlock()
+	f.advanceTo(target)
+}
+
+// Next returns the earliest pending deadline, if there is one.
+func (f *Fake) Next() (time.Time, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if w := f.first(); w != nil {
+		return w.when, true
+	}
+	return time.Time{}, false
+}
+
+// AutoAdvance moves the clock on its own: whenever the program has not
+// used the clock for a moment of real time, it jumps straight to the next
+// deadline. Code written against Clock then runs in simulated time without
+// anyone calling Advance. Waiters due at the same instant are woken one at
+// a time, each once the program has settled after the last, so the order
+// they are seen in does not depend on the scheduler. The returned function
+// stops it.
+func (f *Fake) AutoAdvance() (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		last := -1
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(idleWait):
+			}
+
+			f.mu.Lock()
+			idle := f.activity == last
+			last = f.activity
+			if idle {
+				f.fire(f.first())
+			}
+			f.mu.Unlock()
+		}
+	}()
+	return func() { close(done) }
+}
+
+func (f *Fake) advanceTo(target time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for {
+		w := f.first()
+		if w == nil || w.when.After(target) {
+			break
+		}
+		f.fire(w)
+	}
+	if target.After(f.now) {
+		f.now = target
+	}
+	f.activity++
+}
+
+// fire moves the clock to w's deadline and wakes it. It is called with
+// f.mu held and does nothing if w is nil.
+func (f *Fake) fire(w *waiter) {
+	if w == nil {
+		return
+	}
+	if w.when.After(f.now) {
+		f.now = w.when
+	}
+	if w.period > 0 {
+		w.when = w.when.Add(w.period)
+	} else {
+		f.remove(w)
+	}
+
+	// Fire without the lock: waking a goroutine may let it call back in
+	now := f.now
+	f.mu.Unlock()
+	w.fire(now)
+	f.mu.Lock()
+	f.activity++
+}
+
+// schedule (re)arms w to fire after d and reports whether it was pending.
+func (f *Fake) schedule(w *waiter, d time.Duration) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	pending := f.remove(w)
+	w.when = f.now.Add(d)
+	f.add(w)
+	return pending
+}
+
+// cancel removes w and reports whether it was pending.
+func (f *Fake) cancel(w *waiter) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.remove(w)
+}
+
+func (f *Fake) add(w *waiter) {
+	f.seq++
+	f.activity++
+	w.seq = f.seq
+	f.waiters = append(f.waiters, w)
+}
+
+func (f *Fake) remove(w *waiter) bool {
+	f.activity++
+	for i, x := range f.waiters {
+		if x == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// first returns the waiter due soonest. There are only ever a handful, so
+// a linear scan is fine.
+func (f *Fake) first() *waiter {
+	var next *waiter
+	for _, w := range f.waiters {
+		if next == nil || w.when.Before(next.when) || (w.when.Equal(next.when) && w.seq < next.seq) {
+			next = w
+		}
+	}
+	return next
+}
+
+// send delivers a tick without blocking, dropping it if the last one has
+// not been received yet, as the time package does.
+func send(c chan time.Time, now time.Time) {
+	select {
+	case c <- now:
+	default:
+	}
+}
+
+// drain discards a pending tick, so that a stopped or reset timer never
+// delivers a stale value, matching time.Timer since Go 1.23.
+func drain(c chan time.Time) {
+	select {
+	case <-c:
+	default:
+	}
+}
@@ -0,0 +1,211 @@
+// Package golden runs examples and compares their output against
+// checked-in golden files.
+//
+// Each example runs in its own process, started from the gobyexample binary
+// with "run <name>", so examples that exit, panic or change global state
+// cannot affect each other. The process gets a fresh working directory that
+// is also its HOME and TMPDIR, and a fixed environment, so the output does
+// not depend on the machine. It also runs on the fake clock with a fixed
+// random seed, so examples written against package clock and package
+// random print the same thing every time.
+//
+// Before comparing, the output is masked: wall-clock times, durations,
+// addresses, PIDs and temp paths are replaced with placeholders such as
+// <TIME>. See Rules and Config.
+package golden
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Runner runs examples through a gobyexample binary.
+type Runner struct {
+	Binary  string        // path of the gobyexample binary
+	Dir     string        // directory holding the <name>.golden files
+	Timeout time.Duration // how long a single example may run
+}
+
+// Result is the outcome of checking or updating one example.
+type Result struct {
+	Name    string
+	Skipped string // reason the example was skipped, if it was
+	Diff    string // unified diff against the golden file, empty if equal
+	Updated bool   // the golden file was written
+	Err     error
+}
+
+// OK reports whether the example matched its golden file or was skipped.
+func (r Result) OK() bool {
+	return r.Err == nil && r.Diff == ""
+}
+
+// Path returns the golden file for the named example.
+func (r Runner) Path(name string) string {
+	return filepath.Join(r.Dir, name+".golden")
+}
+
+// Check runs the example and diffs its masked output against the golden
+// file.
+func (r Runner) Check(name string) Result {
+	res := Result{Name: name}
+	if reason := Config[name].Skip; reason != "" {
+		res.Skipped = reason
+		return res
+	}
+
+	want, err := os.ReadFile(r.Path(name))
+	if err != nil {
+		res.Err = fmt.Errorf("%w (run with --update to create it)", err)
+		return res
+	}
+
+	got, err := r.Output(name)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	res.Diff = Diff(r.Path(name), "output", string(want), got)
+	return res
+}
+
+// Update runs the example and writes its masked output to the golden file.
+func (r Runner) Update(name string) Result {
+	res := Result{Name: name}
+	if reason := Config[name].Skip; reason != "" {
+		res.Skipped = reason
+		return res
+	}
+
+	got, err := r.Output(name)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	if old, err := os.ReadFile(r.Path(name)); err == nil && string(old) == got {
+		return res
+	}
+	if err := os.MkdirAll(r.Dir, 0755); err != nil {
+		res.Err = err
+		return res
+	}
+	if err := os.WriteFile(r.Path(name), []byte(got), 0644); err != nil {
+		res.Err = err
+		return res
+	}
+	res.Updated = true
+	return res
+}
+
+// Output runs the example once and returns its masked output. Stdout and
+// stderr are captured together, in the order they were written.
+func (r Runner) Output(name string) (string, error) {
+	opts := Config[name]
+
+	dir, err := os.MkdirTemp("", "golden-"+name+"-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	args := append([]string{"run", "--fake-clock", "--seed=1", name}, opts.Args...)
+	cmd := exec.CommandContext(ctx, r.Binary, args...)
+	cmd.Dir = dir
+	cmd.Env = []string{
+		"HOME=" + dir,
+		"PATH=/usr/local/bin:/usr/bin:/bin",
+		"PWD=" + dir,
+		"SHELL=/bin/sh",
+		"TMPDIR=" + dir,
+		"USER=gopher",
+	}
+	cmd.Stdin = strings.NewReader(opts.Stdin)
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("timed out after %v", r.Timeout)
+	}
+
+	// A non-zero exit is part of the behaviour being recorded
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		fmt.Fprintf(&out, "[exit status %d]\n", exitErr.ExitCode())
+	case err != nil:
+		return "", err
+	}
+
+	text := strings.ReplaceAll(out.String(), dir, "<TMP>")
+	return Normalize(name, text), nil
+}
+
+// Normalize applies the default masking rules and the example's own
+// options to raw output.
+func Normalize(name, out string) string {
+	opts := Config[name]
+
+	for _, rule := range opts.Rules {
+		out = rule.Apply(out)
+	}
+	for _, rule := range Rules {
+		out = rule.Apply(out)
+	}
+
+	if opts.Racy {
+		out = headers(out)
+	}
+	if opts.Unordered {
+		out = sortBlocks(out)
+	}
+	return out
+}
+
+// header matches the lines every example prints around its sections:
+// "=== Worker Pools ===", "3. Worker pool with results:" and the closing
+// "All worker pool examples completed!".
+var header = regexp.MustCompile(`^(=== .* ===|\d+\. .*|All .*|\[exit status \d+\])$`)
+
+// headers keeps only the header lines of out, so an example whose output
+// depends on scheduling is still checked for running every section to
+// completion.
+func headers(out string) string {
+	var kept []string
+	for _, line := range strings.Split(out, "\n") {
+		if header.MatchString(line) {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n") + "\n"
+}
+
+// sortBlocks sorts the lines of every blank-line separated block except
+// the first, which is the section header. Output from goroutines then
+// compares equal whatever order it arrived in.
+func sortBlocks(out string) string {
+	trimmed := strings.TrimSuffix(out, "\n")
+	blocks := strings.Split(trimmed, "\n\n")
+	for i, block := range blocks {
+		lines := strings.Split(block, "\n")
+		sort.Strings(lines[1:])
+		blocks[i] = strings.Join(lines, "\n")
+	}
+	return strings.Join(blocks, "\n\n") + out[len(trimmed):]
+}
@@ -0,0 +1,122 @@
+package golden
+
+import "regexp"
+
+// Rule replaces every match of Pattern with Replace, which may refer to
+// submatches as $1.
+type Rule struct {
+	Pattern *regexp.Regexp
+	Replace string
+}
+
+// Apply masks out with the rule.
+func (r Rule) Apply(out string) string {
+	return r.Pattern.ReplaceAllString(out, r.Replace)
+}
+
+func mask(pattern, replace string) Rule {
+	return Rule{Pattern: regexp.MustCompile(pattern), Replace: replace}
+}
+
+// Rules mask output that changes from run to run in any example. They are
+// applied in order, after the example's own rules.
+var Rules = []Rule{
+	// time.Time.String(): 2024-01-02 15:04:05.999 +0000 UTC m=+0.001
+	mask(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(\.\d+)? [+-]\d{4} [A-Z]+( m=[+-]\d+\.\d+)?`, "<TIME>"),
+	// RFC 3339: 2024-01-02T15:04:05Z
+	mask(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`, "<TIME>"),
+	// log package prefix: 2024/01/02 15:04:05.000000
+	mask(`\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}(\.\d+)?`, "<TIME>"),
+	// Wall clock: 15:04:05 and 15:04:05.000
+	mask(`\b\d{2}:\d{2}:\d{2}(\.\d+)?\b`, "<TIME>"),
+	// Measured durations: 1.500174614s, 27.319553ms, 1m2.5s
+	mask(`\b(\d+h)?(\d+m)?\d+\.\d+(ns|µs|us|ms|s)\b`, "<DURATION>"),
+	// Pointers and other addresses: 0xc000012345
+	mask(`\b0x[0-9a-f]{6,}\b`, "<ADDR>"),
+	// Process IDs: "PID: 1234", "pid 1234"
+	mask(`(?i)\b(pid:?[ \t]*)\d+`, "${1}<PID>"),
+	// Source positions from log and runtime.Caller, which depend on where
+	// the binary was built: /home/me/go-by-example/examples/logging/logging.go:56
+	mask(`[^\s:]*/((examples|cmd|internal)/[\w-]+/[\w-]+\.go):\d+`, "$1:<LINE>"),
+	// Short source positions, which move whenever the example is edited:
+	// logging.go:52
+	mask(`\b([\w-]+\.go):\d+\b`, "$1:<LINE>"),
+}
+
+// Options tune how a single example is run and compared.
+type Options struct {
+	Args      []string // command-line arguments for the example
+	Stdin     string   // standard input for the example
+	Rules     []Rule   // masking rules applied before the default Rules
+	Unordered bool     // output comes from goroutines; compare blocks sorted
+	Racy      bool     // what is printed depends on scheduling; compare headers only
+	Skip      string   // reason the example cannot be checked, if any
+}
+
+// Config holds the options for examples that need any. Examples not listed
+// run with no arguments and the default rules.
+var Config = map[string]Options{
+	// Examples that read arguments or standard input
+	"command-line-arguments": {
+		Args: []string{"-v", "--file", "input.txt", "extra"},
+	},
+	"reading-files": {
+		Stdin: "hello from stdin\n",
+	},
+
+	// Temp file names end in random digits
+	"directories": {
+		Rules: []Rule{mask(`\b([a-z]+-?)\d{6,}\b`, "${1}<RANDOM>")},
+	},
+	"temporary-files-and-directories": {
+		Rules: []Rule{mask(`\b([a-z]+-?)\d{6,}\b`, "${1}<RANDOM>")},
+	},
+
+	// Prints where Go and other commands live on this machine
+	"custom-errors": {
+		Rules: []Rule{mask(`\S*/src/((internal/)?runtime/[\w/]+\.(go|s)):\d+`, "<GOROOT>/src/$1:<LINE>")},
+	},
+	"execing-processes": {
+		Rules: []Rule{mask(`(/usr)?/bin/`, "<BIN>/")},
+	},
+
+	// The stand-in server listens on a random port
+	"http-client": {
+		Rules: []Rule{mask(`127\.0\.0\.1:\d+`, "127.0.0.1:<PORT>")},
+	},
+
+	// Output comes from several goroutines at once
+	"channel-synchronization": {Unordered: true},
+	"mutexes":                 {Unordered: true},
+	"stateful-goroutines":     {Unordered: true},
+	"waitgroups": {
+		Unordered: true,
+		Rules: []Rule{
+			mask(`\(total: \d+\)`, "(total: <N>)"),
+			mask(`(acquired|released) resource-\d+`, "$1 resource-<N>"),
+		},
+	},
+
+	// Which worker gets which job, or which select case wins, is up to
+	// the scheduler
+	"channel-buffering":               {Racy: true},
+	"channels":                        {Racy: true},
+	"closing-channels":                {Racy: true},
+	"goroutines":                      {Racy: true},
+	"non-blocking-channel-operations": {Racy: true},
+	"range-over-channels":             {Racy: true},
+	"select":                          {Racy: true},
+	"worker-pools":                    {Racy: true},
+
+	// Map iteration order is random
+	"for":                       {Unordered: true},
+	"maps":                      {Unordered: true},
+	"range-over-built-in-types": {Unordered: true},
+	"range-over-iterators":      {Unordered: true},
+
+	// Long-running servers and examples that depend on the machine
+	"http-server":        {Skip: "serves HTTP until interrupted"},
+	"tcp-server":         {Skip: "serves TCP until interrupted"},
+	"signals":            {Skip: "waits for a signal"},
+	"spawning-processes": {Skip: "lists /tmp, prints the environment and pings localhost"},
+}
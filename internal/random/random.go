@@ -0,0 +1,32 @@
+// Package random seeds the random number generators used by the examples,
+// so that a run can be repeated exactly with --seed.
+package random
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+var (
+	mu     sync.Mutex
+	seed   uint64
+	seeded bool
+)
+
+// SetSeed fixes the seed returned by Seed from now on.
+func SetSeed(s uint64) {
+	mu.Lock()
+	defer mu.Unlock()
+	seed, seeded = s, true
+}
+
+// Seed returns the seed set with SetSeed, or a fresh random one if none
+// was set.
+func Seed() uint64 {
+	mu.Lock()
+	defer mu.Unlock()
+	if !seeded {
+		return rand.Uint64()
+	}
+	return seed
+}
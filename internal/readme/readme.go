@@ -0,0 +1,264 @@
+// Package readme generates the parts of README.md that describe the
+// examples, so they are derived from the code instead of maintained by
+// hand.
+//
+// Generated parts sit between marker comments and everything outside them
+// is left alone:
+//
+//	<!-- readme:begin overview -->
+//	...
+//	<!-- readme:end overview -->
+//
+// An example's entry in the overview takes its icon, description and
+// category from its Register call. The bullet list comes from its main
+// source file: the "// N. Title" comments on section functions, or else the
+// "N. Title" headers the example prints, or else the comments opening each
+// block of Run and each package-level declaration. The Key Concepts snippet
+// is the code between a "// readme:begin" and a "// readme:end" comment.
+package readme
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/saqib77official/go-by-example/examples"
+)
+
+// Snippet markers in example sources
+const (
+	beginMarker = "// readme:begin"
+	endMarker   = "// readme:end"
+)
+
+// categoryIcons decorate the category headings of the overview.
+var categoryIcons = map[examples.Category]string{
+	examples.Beginner:     "🌱",
+	examples.Intermediate: "🚀",
+	examples.Advanced:     "🔥",
+	examples.Expert:       "🔧",
+	examples.Practical:    "🌐",
+}
+
+// Update returns readme with every generated region rewritten from all.
+func Update(readme string, all []examples.Example) (string, error) {
+	overview, err := Overview(all)
+	if err != nil {
+		return "", err
+	}
+
+	readme, err = replace(readme, "overview", overview)
+	if err != nil {
+		return "", err
+	}
+	return replace(readme, "counts", Counts(all))
+}
+
+// replace swaps the content between the begin and end markers of the named
+// region.
+func replace(readme, region, content string) (string, error) {
+	begin := "<!-- readme:begin " + region + " -->\n"
+	end := "<!-- readme:end " + region + " -->"
+
+	i := strings.Index(readme, begin)
+	if i < 0 {
+		return "", fmt.Errorf("README has no %q marker", strings.TrimSpace(begin))
+	}
+	i += len(begin)
+	j := strings.Index(readme[i:], end)
+	if j < 0 {
+		return "", fmt.Errorf("README has no %q marker", end)
+	}
+	return readme[:i] + content + readme[i+j:], nil
+}
+
+// Overview renders an entry for every example, grouped by category.
+func Overview(all []examples.Example) (string, error) {
+	var sb strings.Builder
+	for _, c := range examples.Categories {
+		var group []examples.Example
+		for _, e := range all {
+			if e.Category == c {
+				group = append(group, e)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n### %s **%s Examples (%d files)**\n", categoryIcons[c], c, len(group))
+		for _, e := range group {
+			entry, err := Entry(e)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", e.File(), err)
+			}
+			sb.WriteString("\n" + entry)
+		}
+	}
+	sb.WriteString("\n")
+	return sb.String(), nil
+}
+
+// Entry renders the overview entry for a single example.
+func Entry(e examples.Example) (string, error) {
+	// Only the main file: the others hold the plumbing, not the topics
+	src, err := examples.ReadFile(e.File())
+	if err != nil {
+		return "", err
+	}
+	snippet, err := Snippet(src)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "### %s [%s.go](./examples/%s)\n", e.Icon, e.Name, e.File())
+	fmt.Fprintf(&sb, "**%s**\n", e.Description)
+	for _, topic := range Topics(src) {
+		fmt.Fprintf(&sb, "- %s\n", topic)
+	}
+	if snippet != "" {
+		fmt.Fprintf(&sb, "\n**Key Concepts:**\n```go\n%s\n```\n", snippet)
+	}
+	sb.WriteString("\n---\n")
+	return sb.String(), nil
+}
+
+// Counts renders the number of examples in total and per category.
+func Counts(all []examples.Example) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "- **Total Examples:** %d files\n", len(all))
+	for _, c := range examples.Categories {
+		n := 0
+		for _, e := range all {
+			if e.Category == c {
+				n++
+			}
+		}
+		fmt.Fprintf(&sb, "- **%s:** %d files\n", c, n)
+	}
+	return sb.String()
+}
+
+var (
+	// "// 3. Worker pool with results" on a section function
+	sectionComment = regexp.MustCompile(`(?m)^// \d+\. (.+)$`)
+	// fmt.Println("\n3. Worker pool with results:")
+	sectionHeader = regexp.MustCompile(`(?m)^\s*fmt\.Println\("(?:\\n)*\d+\. (.+?):?"\)$`)
+	// fmt.Println("\n--- Worker pool with results ---")
+	sectionBanner = regexp.MustCompile(`(?m)^\s*fmt\.Println\("(?:\\n)*--- (.+) ---"\)$`)
+)
+
+// Topics lists what an example covers, one line per section. Examples
+// without numbered sections fall back to their block comments.
+func Topics(src string) []string {
+	for _, re := range []*regexp.Regexp{sectionComment, sectionHeader, sectionBanner} {
+		if matches := re.FindAllStringSubmatch(src, -1); len(matches) > 0 {
+			topics := make([]string, len(matches))
+			for i, m := range matches {
+				topics[i] = m[1]
+			}
+			return topics
+		}
+	}
+	return blockComments(src)
+}
+
+// blockComments returns the first line of every comment that starts a
+// block in Run, or that documents a package-level const, var or type.
+func blockComments(src string) []string {
+	lines := strings.Split(src, "\n")
+
+	var topics []string
+	inRun := false
+	blockStart := true
+	for i, line := range lines {
+		switch {
+		case line == "func Run() {":
+			inRun = true
+		case line == "}":
+			inRun = false
+		}
+
+		prefix := "// "
+		if inRun {
+			prefix = "\t// "
+		}
+		text, isComment := strings.CutPrefix(line, prefix)
+		if isComment && blockStart && !strings.HasPrefix(text, "readme:") &&
+			(inRun || documentsDecl(lines[i+1:])) {
+			topics = append(topics, text)
+		}
+		blockStart = strings.TrimSpace(line) == "" || line == "func Run() {"
+	}
+	return topics
+}
+
+// documentsDecl reports whether the comment continuing into lines is
+// attached to a const, var or type declaration.
+func documentsDecl(lines []string) bool {
+	for _, line := range lines {
+		if strings.HasPrefix(line, "//") {
+			continue
+		}
+		return strings.HasPrefix(line, "const ") || strings.HasPrefix(line, "var ") ||
+			strings.HasPrefix(line, "type ")
+	}
+	return false
+}
+
+// Snippet returns the code between the readme markers in src, without its
+// common indentation. It returns "" if src has no markers.
+func Snippet(src string) (string, error) {
+	lines := strings.Split(src, "\n")
+	begin, end := -1, -1
+	for i, line := range lines {
+		switch strings.TrimSpace(line) {
+		case beginMarker:
+			if begin >= 0 {
+				return "", fmt.Errorf("line %d: second %q", i+1, beginMarker)
+			}
+			begin = i
+		case endMarker:
+			if begin < 0 || end >= 0 {
+				return "", fmt.Errorf("line %d: unexpected %q", i+1, endMarker)
+			}
+			end = i
+		}
+	}
+	switch {
+	case begin < 0:
+		return "", nil
+	case end < 0:
+		return "", fmt.Errorf("line %d: %q without %q", begin+1, beginMarker, endMarker)
+	}
+
+	code := lines[begin+1 : end]
+	for len(code) > 0 && strings.TrimSpace(code[0]) == "" {
+		code = code[1:]
+	}
+	for len(code) > 0 && strings.TrimSpace(code[len(code)-1]) == "" {
+		code = code[:len(code)-1]
+	}
+	if len(code) == 0 {
+		return "", fmt.Errorf("line %d: empty snippet", begin+1)
+	}
+
+	// Strip the indentation every non-blank line shares
+	indent := -1
+	for _, line := range code {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, "\t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	for i, line := range code {
+		if len(line) >= indent {
+			code[i] = line[indent:]
+		}
+	}
+	return strings.Join(code, "\n"), nil
+}
@@ -1,13 +0,0 @@
-package main
-
-import "fmt"
-
-func main() {
-	fmt.Println("Hello World!")
-	fmt.Println("1+1 =", 1+1)
-	fmt.Println("7.0/3.0 =", 7.0/3.0)
-
-	fmt.Println(true && false)
-	fmt.Println(true || false)
-	fmt.Println(!true)
-}